@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"log"
-	"time"
+	"os"
+	"os/signal"
+	"syscall"
 
 	_ "github.com/OrkhanNajaf1i/booking-service/docs"
 	"github.com/OrkhanNajaf1i/booking-service/internal/app/api"
@@ -37,18 +40,23 @@ func main() {
 		log.Fatalf("Failled no initialize logger: %v", err)
 	}
 
-	if err := postgres.RunMigrations(*cfg, appLogger); err != nil {
-		log.Fatalf("migrations failed: %v", err)
+	if cfg.AutoMigrate {
+		if err := postgres.RunMigrations(*cfg, appLogger); err != nil {
+			log.Fatalf("migrations failed: %v", err)
+		}
+	} else {
+		appLogger.Info("Auto-migration disabled, run cmd/migrate before deploying")
 	}
 	app, err := api.New(cfg, appLogger)
 	if err != nil {
 		log.Fatalf("failed to init api app: %v", err)
 	}
-	if err := app.Run(); err != nil {
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := app.Run(ctx); err != nil {
 		log.Fatalf("API server error: %v", err)
 	}
-
-	appLogger.Info("API server starting", logger.Field{Key: "port", Value: cfg.Port})
-	time.Sleep(time.Second * 1)
 	appLogger.Info("API server stopped")
 }
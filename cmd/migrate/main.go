@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/config"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/postgres"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage: migrate <command> [arg]
+
+Commands:
+  up           apply all pending migrations
+  down N       roll back the last N migrations
+  goto V       migrate up or down to version V
+  status       print current version, dirty flag and pending migrations
+  force V      set version V without running migrations (fixes dirty state)
+  verify       check that the latest migration is applied and not dirty`
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Println("MIGRATE: .env file not found, using system envs")
+	}
+
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	// Yalnız server və DB konfiqurasiyası lazımdır (JWT/SMTP tələb olunmur)
+	cfg := &config.AppConfig{}
+	if err := config.LoadServerConfig(cfg); err != nil {
+		log.Fatalf("server config error: %v", err)
+	}
+	if err := config.LoadDatabaseConfig(cfg); err != nil {
+		log.Fatalf("database config error: %v", err)
+	}
+
+	appLogger, err := logger.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, *cfg, appLogger, os.Args[1], os.Args[2:]); err != nil {
+		log.Fatalf("migrate %s: %v", os.Args[1], err)
+	}
+}
+
+func run(ctx context.Context, cfg config.AppConfig, appLogger logger.Logger, command string, args []string) error {
+	if command == "verify" {
+		db, err := postgres.New(cfg)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		if err := postgres.VerifySchema(ctx, db); err != nil {
+			return err
+		}
+		fmt.Println("schema OK")
+		return nil
+	}
+
+	migrator, err := postgres.NewMigrator(cfg, appLogger)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	switch command {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		n, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		return migrator.Down(ctx, n)
+	case "goto":
+		v, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		if v < 0 {
+			return fmt.Errorf("version must not be negative")
+		}
+		return migrator.Goto(ctx, uint(v))
+	case "force":
+		v, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		return migrator.Force(ctx, v)
+	case "status":
+		status, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		printStatus(status)
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q\n\n%s", command, usage)
+	}
+}
+
+func intArg(args []string) (int, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("expected exactly one numeric argument")
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("argument must be a number: %w", err)
+	}
+	return n, nil
+}
+
+func printStatus(status *postgres.MigrationStatus) {
+	if status.Applied {
+		fmt.Printf("version: %d\n", status.Version)
+	} else {
+		fmt.Println("version: none")
+	}
+	fmt.Printf("dirty:   %t\n", status.Dirty)
+	fmt.Printf("latest:  %d\n", status.Latest)
+	if len(status.Pending) == 0 {
+		fmt.Println("pending: none")
+		return
+	}
+	fmt.Printf("pending: %v\n", status.Pending)
+}
@@ -2,27 +2,55 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/OrkhanNajaf1i/booking-service/internal/config"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/location"
+	notificationDomain "github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/onboarding"
+	ratelimitDomain "github.com/OrkhanNajaf1i/booking-service/internal/domain/ratelimit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/service"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
+	webhookDomain "github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+	"github.com/OrkhanNajaf1i/booking-service/internal/health"
 	httpapi "github.com/OrkhanNajaf1i/booking-service/internal/http"
 
+	auditHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/audit"
 	authHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/auth"
 	businessHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/business"
+	healthHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/health"
+	locationHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/location"
+	notificationHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/notification"
+	serviceHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/service"
+	staffHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/staff"
+	webhookHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/webhook"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
 	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/crypto"
-	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/email"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/metrics"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/notification"
 	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/postgres"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/ratelimit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/tracing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/webhook"
 	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/jmoiron/sqlx"
 )
 
 type App struct {
-	cfg    *config.AppConfig
-	logger logger.Logger
-	server *http.Server
+	cfg           *config.AppConfig
+	logger        logger.Logger
+	db            *sqlx.DB
+	server        *http.Server
+	metricsServer *http.Server
+	shutdownTrace func(context.Context) error
 }
 
 func New(cfg *config.AppConfig, appLogger logger.Logger) (*App, error) {
@@ -34,54 +62,183 @@ func New(cfg *config.AppConfig, appLogger logger.Logger) (*App, error) {
 		}
 	}
 
+	shutdownTrace, err := tracing.Setup(context.Background(), cfg, "booking-api")
+	if err != nil {
+		return nil, fmt.Errorf("tracing init failed: %w", err)
+	}
+
 	db, err := postgres.New(*cfg)
 	if err != nil {
 		return nil, fmt.Errorf("postgres init failed: %w", err)
 	}
 
-	businessRepo := postgres.NewBusinessRepository(db)
-	businessSvc := business.NewService(businessRepo)
-
-	// Auth repo + service
-	authRepo := postgres.NewAuthRepository(db)
+	fieldCipher, err := crypto.NewAESFieldCipher(cfg.EncryptionKeyVersion, cfg.EncryptionKey, cfg.EncryptionOldKeys)
+	if err != nil {
+		return nil, fmt.Errorf("field cipher init failed: %w", err)
+	}
+	templates, err := notification.NewTemplateRenderer()
+	if err != nil {
+		return nil, fmt.Errorf("notification templates init failed: %w", err)
+	}
 	passwordHasher := crypto.NewBcryptPasswordHasher()
 	tokenManager := crypto.NewJWTSigner(cfg.JWTSecret)
-	emailService := email.NewSMTPService(
-		cfg.SMTPHost,
-		cfg.SMTPPort,
-		cfg.SMTPUser,
-		cfg.SMTPPass,
-		cfg.SMTPFrom,
-	)
+	txManager := postgres.NewTxManager(db)
+	metricsRegistry := metrics.New()
+	metricsRegistry.RegisterDB("postgres", db.DB)
+
+	// Repositories
+	authRepo := postgres.NewAuthRepository(db, fieldCipher)
+	businessRepo := postgres.NewBusinessRepository(db)
+	locationRepo := postgres.NewLocationRepository(db)
+	staffRepo := postgres.NewStaffRepository(db, fieldCipher)
+	serviceRepo := postgres.NewServiceRepository(db)
+	idempotencyRepo := postgres.NewIdempotencyRepository(db, fieldCipher)
+	auditRepo := postgres.NewAuditRepository(db)
+	outboxRepo := postgres.NewOutboxRepository(db)
+	webhookRepo := postgres.NewWebhookRepository(db, fieldCipher)
+	notificationRepo := postgres.NewNotificationRepository(db, fieldCipher)
+	rateLimiter := newRateLimiter(cfg, db, appLogger)
+
+	// Domain services
+	auditSvc := audit.NewService(auditRepo, appLogger)
+	// API bildirişləri yalnız növbəyə qoyur, göndərmə worker-dədir
+	notificationSvc := notificationDomain.NewService(notificationRepo, templates, appLogger)
 	authSvc := auth.NewAuthService(
 		authRepo,
+		txManager,
 		passwordHasher,
-		emailService,
+		notificationSvc,
 		tokenManager,
+		appLogger,
+		metricsRegistry,
 	)
+	businessSvc := business.NewService(businessRepo, txManager, staffRepo, notificationSvc, cfg.FrontendURL, appLogger, auditSvc, outboxRepo)
+	locationSvc := location.NewService(locationRepo, txManager, appLogger, auditSvc, outboxRepo)
+	staffSvc := staff.NewService(
+		staffRepo,
+		txManager,
+		authSvc,
+		notificationSvc,
+		cfg.FrontendURL,
+		appLogger,
+		metricsRegistry,
+		auditSvc,
+		outboxRepo,
+	)
+	serviceSvc := service.NewServiceUseCase(serviceRepo, txManager, appLogger, auditSvc, outboxRepo)
+	webhookSvc := webhookDomain.NewService(
+		webhookRepo,
+		txManager,
+		webhook.NewHTTPSender(cfg.WebhookTimeout, cfg.WebhookAllowPrivateNetworks),
+		appLogger,
+		auditSvc,
+	)
+	onboardingSvc := onboarding.NewService(txManager, businessSvc, locationSvc, staffSvc, authSvc, appLogger, metricsRegistry)
 
-	businessH := businessHandler.NewBusinessHandler(businessSvc)
-	authH := authHandler.NewAuthHandler(authSvc, appLogger)
-
+	problem.SetLogger(appLogger)
 	router := httpapi.NewRouter(httpapi.Handlers{
-		Business: businessH,
-		Auth:     authH,
-	}, tokenManager)
+		Business:     businessHandler.NewBusinessHandler(businessSvc, onboardingSvc, appLogger),
+		Auth:         authHandler.NewAuthHandler(authSvc, appLogger),
+		Location:     locationHandler.NewHandler(locationSvc),
+		Staff:        staffHandler.NewHandler(staffSvc),
+		Service:      serviceHandler.NewHandler(serviceSvc),
+		Audit:        auditHandler.NewHandler(auditSvc),
+		Webhook:      webhookHandler.NewHandler(webhookSvc),
+		Notification: notificationHandler.NewHandler(notificationSvc),
+		// Worker-in heartbeat-i öz health endpoint-ində yoxlanır: worker dayananda API trafikdən çıxmamalıdır
+		Health: healthHandler.NewHandler(appLogger, health.NewCheck("postgres", db.PingContext)),
+	}, tokenManager, businessSvc, idempotencyRepo, rateLimiter, appLogger)
+
+	check := &selfCheck{
+		db:           db,
+		cipher:       fieldCipher,
+		tokenManager: tokenManager,
+		router:       router,
+		logger:       appLogger,
+	}
+	if err := check.run(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("startup self-check failed: %w", err)
+	}
+	appLogger.Info("Startup self-check passed")
 
 	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 	server := &http.Server{
 		Addr:    addr,
-		Handler: router,
+		Handler: httpapi.WithMiddleware(router, appLogger, metricsRegistry, cfg.TrustProxyHeaders),
+	}
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("GET /metrics", metricsRegistry.Handler())
+	metricsServer := &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", cfg.Host, cfg.MetricsPort),
+		Handler: metricsMux,
 	}
 
 	return &App{
-		cfg:    cfg,
-		logger: appLogger,
-		server: server,
+		cfg:           cfg,
+		logger:        appLogger,
+		db:            db,
+		server:        server,
+		metricsServer: metricsServer,
+		shutdownTrace: shutdownTrace,
 	}, nil
 }
 
-func (a *App) Run() error {
-	a.logger.Info("API server starting", logger.Field{Key: "addr", Value: a.server.Addr})
-	return a.server.ListenAndServe()
+// newRateLimiter - APP_RATE_LIMIT_BACKEND-ə görə store seçir; "none" limiti söndürür
+func newRateLimiter(cfg *config.AppConfig, db *sqlx.DB, appLogger logger.Logger) *middleware.RateLimiter {
+	var store ratelimitDomain.Store
+	switch cfg.RateLimitBackend {
+	case "postgres":
+		store = postgres.NewRateLimitRepository(db)
+	case "memory":
+		store = ratelimit.NewMemoryStore()
+	}
+
+	overrides := make(map[string]ratelimitDomain.Limit, len(cfg.RateLimits))
+	for name, limit := range cfg.RateLimits {
+		overrides[name] = ratelimitDomain.Limit{Requests: limit.Requests, Per: limit.Per}
+	}
+	return middleware.NewRateLimiter(store, overrides, appLogger)
+}
+
+// Run - ctx ləğv olunana qədər serveri işlədir, sonra aktiv sorğuların bitməsini
+// cfg.ShutdownTimeout qədər gözləyir.
+func (a *App) Run(ctx context.Context) error {
+	defer a.db.Close()
+
+	go func() {
+		a.logger.Info("Metrics server starting", logger.Field{Key: "addr", Value: a.metricsServer.Addr})
+		if err := a.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.logger.Error("Metrics server failed", logger.Field{Key: "error", Value: err.Error()})
+		}
+	}()
+	defer a.metricsServer.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		a.logger.Info("API server starting", logger.Field{Key: "addr", Value: a.server.Addr})
+		if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+		close(serverErr)
+	}()
+
+	select {
+	case err := <-serverErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	a.logger.Info("API server shutting down", logger.Field{Key: "timeout", Value: a.cfg.ShutdownTimeout.String()})
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := a.server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("graceful shutdown failed: %w", err)
+	}
+	if err := a.shutdownTrace(shutdownCtx); err != nil {
+		a.logger.Warn("Trace exporter shutdown failed", logger.Field{Key: "error", Value: err.Error()})
+	}
+	return <-serverErr
 }
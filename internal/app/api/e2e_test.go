@@ -0,0 +1,219 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/config"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/postgres"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/tracing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+// TestOnboardingFlow - miqrasiya olunmuş bazada API-ni startup self-check daxil olmaqla qurur və
+// router-i httptest ilə sürür: qeydiyyat → biznes → filial → xidmət → işçi.
+// TEST_DATABASE_URL qurulmayıbsa keçirilir.
+func TestOnboardingFlow(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	cfg := &config.AppConfig{
+		DbDsn:                dsn,
+		LogLevel:             "error",
+		JWTSecret:            "e2e-test-jwt-secret-0123456789abcdef",
+		FrontendURL:          "http://localhost:3000",
+		ShutdownTimeout:      time.Second,
+		TracingExporter:      tracing.ExporterNone,
+		EncryptionKey:        "e2e-test-encryption-key",
+		EncryptionKeyVersion: 1,
+		RateLimitBackend:     "none",
+		WebhookTimeout:       time.Second,
+	}
+	appLogger, err := logger.New(cfg)
+	if err != nil {
+		t.Fatalf("logger: %v", err)
+	}
+	if err := postgres.RunMigrations(*cfg, appLogger); err != nil {
+		t.Fatalf("migrations: %v", err)
+	}
+
+	app, err := New(cfg, appLogger)
+	if err != nil {
+		t.Fatalf("app: %v", err)
+	}
+	t.Cleanup(func() { app.db.Close() })
+
+	server := httptest.NewServer(app.server.Handler)
+	t.Cleanup(server.Close)
+	client := &e2eClient{t: t, baseURL: server.URL}
+
+	// Qeydiyyat
+	var owner authResponse
+	client.do(http.MethodPost, "/api/v1/auth/register", "", map[string]string{
+		"email":     uniqueEmail("owner"),
+		"password":  "StrongPass123!",
+		"full_name": "Owner Example",
+		"phone":     "+994501234567",
+	}, http.StatusCreated, &owner)
+	if owner.AccessToken == "" {
+		t.Fatal("register returned no access token")
+	}
+
+	// Biznes: cavabdakı yeni token business_id daşıyır
+	var onboarded struct {
+		Business struct {
+			ID uuid.UUID `json:"id"`
+		} `json:"business"`
+		LocationID uuid.UUID    `json:"location_id"`
+		StaffID    uuid.UUID    `json:"staff_id"`
+		Auth       authResponse `json:"auth"`
+	}
+	client.do(http.MethodPost, "/api/v1/businesses/multi", owner.AccessToken, map[string]string{
+		"name":     "E2E Studio",
+		"industry": "beauty",
+		"phone":    "+994501234568",
+	}, http.StatusCreated, &onboarded)
+	if onboarded.Business.ID == uuid.Nil || onboarded.LocationID == uuid.Nil || onboarded.StaffID == uuid.Nil {
+		t.Fatalf("onboarding returned incomplete result: %+v", onboarded)
+	}
+	token := onboarded.Auth.AccessToken
+	if token == "" {
+		t.Fatal("onboarding returned no access token")
+	}
+
+	// Filial
+	var location struct {
+		Data struct {
+			ID         uuid.UUID `json:"id"`
+			BusinessID uuid.UUID `json:"business_id"`
+		} `json:"data"`
+	}
+	client.do(http.MethodPost, "/api/v1/locations", token, map[string]string{
+		"name": "Second Branch",
+		"city": "Baku",
+	}, http.StatusCreated, &location)
+	if location.Data.BusinessID != onboarded.Business.ID {
+		t.Fatalf("location belongs to %s, want %s", location.Data.BusinessID, onboarded.Business.ID)
+	}
+
+	// Xidmət
+	var service struct {
+		Data struct {
+			ID uuid.UUID `json:"id"`
+		} `json:"data"`
+	}
+	client.do(http.MethodPost, "/api/v1/services", token, map[string]interface{}{
+		"name":             "Haircut",
+		"description":      "Classic haircut",
+		"duration_minutes": 45,
+		"price":            25.5,
+	}, http.StatusCreated, &service)
+	if service.Data.ID == uuid.Nil {
+		t.Fatal("service was not created")
+	}
+
+	// İşçi: ayrıca qeydiyyatdan keçmiş istifadəçi yeni filiala təyin olunur
+	var member authResponse
+	client.do(http.MethodPost, "/api/v1/auth/register", "", map[string]string{
+		"email":     uniqueEmail("staff"),
+		"password":  "StrongPass123!",
+		"full_name": "Staff Example",
+		"phone":     "+994501234569",
+	}, http.StatusCreated, &member)
+
+	var staff struct {
+		Data struct {
+			ID         uuid.UUID  `json:"id"`
+			UserID     uuid.UUID  `json:"user_id"`
+			LocationID *uuid.UUID `json:"location_id"`
+		} `json:"data"`
+	}
+	client.do(http.MethodPost, "/api/v1/staff", token, map[string]string{
+		"user_id":     member.User.ID.String(),
+		"role":        "staff",
+		"title":       "Stylist",
+		"location_id": location.Data.ID.String(),
+	}, http.StatusCreated, &staff)
+	if staff.Data.UserID != member.User.ID {
+		t.Fatalf("staff profile user = %s, want %s", staff.Data.UserID, member.User.ID)
+	}
+
+	// Siyahıda sahib və yeni işçi görünür
+	var list struct {
+		Data []struct {
+			ID uuid.UUID `json:"id"`
+		} `json:"data"`
+	}
+	client.do(http.MethodGet, "/api/v1/staff", token, nil, http.StatusOK, &list)
+	found := map[uuid.UUID]bool{}
+	for _, item := range list.Data {
+		found[item.ID] = true
+	}
+	if !found[onboarded.StaffID] || !found[staff.Data.ID] {
+		t.Fatalf("staff list %v misses owner %s or staff %s", list.Data, onboarded.StaffID, staff.Data.ID)
+	}
+}
+
+type authResponse struct {
+	AccessToken string `json:"access_token"`
+	User        struct {
+		ID uuid.UUID `json:"id"`
+	} `json:"user"`
+}
+
+type e2eClient struct {
+	t       *testing.T
+	baseURL string
+}
+
+// do - sorğunu göndərir, status kodunu yoxlayır və cavabı out-a açır
+func (c *e2eClient) do(method, path, token string, body interface{}, wantStatus int, out interface{}) {
+	c.t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			c.t.Fatalf("%s %s: encode body: %v", method, path, err)
+		}
+	}
+	req, err := http.NewRequest(method, c.baseURL+path, &payload)
+	if err != nil {
+		c.t.Fatalf("%s %s: %v", method, path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	var raw bytes.Buffer
+	if _, err := raw.ReadFrom(resp.Body); err != nil {
+		c.t.Fatalf("%s %s: read body: %v", method, path, err)
+	}
+	if resp.StatusCode != wantStatus {
+		c.t.Fatalf("%s %s: status %d, want %d: %s", method, path, resp.StatusCode, wantStatus, raw.String())
+	}
+	if out != nil {
+		if err := json.Unmarshal(raw.Bytes(), out); err != nil {
+			c.t.Fatalf("%s %s: decode response: %v: %s", method, path, err, raw.String())
+		}
+	}
+}
+
+func uniqueEmail(prefix string) string {
+	return fmt.Sprintf("%s-%s@example.com", prefix, uuid.NewString())
+}
@@ -0,0 +1,131 @@
+// File: internal/app/api/selfcheck.go
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/postgres"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// requiredRoutes - hər domen üçün ən azı bir route qeydiyyatda olmalıdır
+var requiredRoutes = []struct {
+	method string
+	path   string
+}{
+	{http.MethodGet, "/healthz"},
+	{http.MethodGet, "/readyz"},
+	{http.MethodPost, "/api/v1/auth/register"},
+	{http.MethodPost, "/api/v1/businesses/solo"},
+	{http.MethodPost, "/api/v1/locations"},
+	{http.MethodPost, "/api/v1/services"},
+	{http.MethodPost, "/api/v1/staff"},
+	{http.MethodPost, "/api/v1/staff/invites/accept"},
+}
+
+type fieldCipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(value string) (string, error)
+}
+
+// selfCheck - server dinləməyə başlamazdan əvvəl asılılıqların işlək olduğunu yoxlayır
+type selfCheck struct {
+	db           *sqlx.DB
+	cipher       fieldCipher
+	tokenManager auth.TokenManager
+	router       *http.ServeMux
+	logger       logger.Logger
+}
+
+func (c *selfCheck) run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	checks := []struct {
+		name string
+		fn   func(ctx context.Context) error
+	}{
+		{"database", c.db.PingContext},
+		{"schema", func(ctx context.Context) error { return postgres.VerifySchema(ctx, c.db) }},
+		{"row level security", c.checkRowSecurity},
+		{"field cipher", c.checkCipher},
+		{"token manager", c.checkTokens},
+		{"routes", c.checkRoutes},
+	}
+
+	for _, check := range checks {
+		if err := check.fn(ctx); err != nil {
+			return fmt.Errorf("%s: %w", check.name, err)
+		}
+	}
+	return nil
+}
+
+// checkRowSecurity - policy-lər olmalıdır; rol onları keçirsə (superuser, BYPASSRLS) yalnız xəbərdarlıq edilir,
+// çünki lokal mühitdə adətən postgres superuser istifadə olunur
+func (c *selfCheck) checkRowSecurity(ctx context.Context) error {
+	if err := postgres.VerifyRowSecurity(ctx, c.db); err != nil {
+		return err
+	}
+	bypassed, err := postgres.RowSecurityBypassed(ctx, c.db)
+	if err != nil {
+		return err
+	}
+	if bypassed {
+		c.logger.Warn("Database role bypasses row level security, tenant isolation relies on queries only")
+	}
+	return nil
+}
+
+func (c *selfCheck) checkCipher(context.Context) error {
+	const probe = "self-check"
+	encrypted, err := c.cipher.Encrypt(probe)
+	if err != nil {
+		return err
+	}
+	decrypted, err := c.cipher.Decrypt(encrypted)
+	if err != nil {
+		return err
+	}
+	if decrypted != probe {
+		return fmt.Errorf("round trip mismatch")
+	}
+	return nil
+}
+
+func (c *selfCheck) checkTokens(context.Context) error {
+	userID := uuid.New()
+	token, err := c.tokenManager.GenerateAccessToken(&auth.JWTClaims{
+		UserID:    userID,
+		Role:      auth.UserTypeCustomer,
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	claims, err := c.tokenManager.ValidateAccessToken(token)
+	if err != nil {
+		return err
+	}
+	if claims.UserID != userID {
+		return fmt.Errorf("round trip mismatch")
+	}
+	return nil
+}
+
+func (c *selfCheck) checkRoutes(context.Context) error {
+	for _, route := range requiredRoutes {
+		req := httptest.NewRequest(route.method, route.path, nil)
+		if _, pattern := c.router.Handler(req); pattern == "" {
+			return fmt.Errorf("%s %s is not registered", route.method, route.path)
+		}
+	}
+	return nil
+}
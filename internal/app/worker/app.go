@@ -3,25 +3,99 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/OrkhanNajaf1i/booking-service/internal/config"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	notificationDomain "github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	webhookDomain "github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+	"github.com/OrkhanNajaf1i/booking-service/internal/health"
+	healthHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/health"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/routes"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/crypto"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/metrics"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/notification"
 	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/postgres"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/tracing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/webhook"
 	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
+const (
+	reencryptBatchSize = 100
+	// rateLimitBucketMaxAge - ən uzun kvota pəncərəsindən (1 saat) xeyli böyük olmalıdır
+	rateLimitBucketMaxAge = 24 * time.Hour
+	tracerName            = "github.com/OrkhanNajaf1i/booking-service/internal/app/worker"
+	outboxBatchSize       = 100
+	// outboxLease - relay bu müddətdə batch-ı çatdırmalıdır, əks halda hadisələr yenidən götürülür
+	outboxLease = time.Minute
+	// outboxRetention - çatdırılmış hadisələr bu müddətdən sonra silinir
+	outboxRetention  = 7 * 24 * time.Hour
+	webhookBatchSize = 50
+	// webhookLeasePerDelivery - lease = batch × (timeout + ehtiyat): hər sorğu timeout olsa da batch lease bitmədən göndərilir
+	webhookLeasePerDelivery = 15 * time.Second
+	notificationBatchSize   = 50
+	// notificationLeasePerMessage - SMTP sessiyasının limiti üzərinə ehtiyat
+	notificationLeasePerMessage = notification.SendTimeout + 15*time.Second
+)
+
+// job - hər poll intervalında ardıcıl icra olunan iş.
+// pending (varsa) növbədə gözləyən elementlərin sayını qaytarır (job_queue_depth metriki).
+type job struct {
+	name    string
+	run     func(ctx context.Context) error
+	pending func(ctx context.Context) (int, error)
+}
+
 type App struct {
-	config       *config.AppConfig
-	logger       logger.Logger
-	db           *sqlx.DB
-	pollInterval time.Duration
+	config        *config.AppConfig
+	logger        logger.Logger
+	db            *sqlx.DB
+	reencryptor   *postgres.FieldReencryptor
+	heartbeats    *postgres.HeartbeatRepository
+	idempotency   *postgres.IdempotencyRepository
+	rateLimits    *postgres.RateLimitRepository
+	outbox        *postgres.OutboxRepository
+	bus           *events.Bus
+	webhooks      *webhookDomain.WebhookService
+	notifications *notificationDomain.NotificationService
+	metrics       *metrics.Prometheus
+	healthServer  *http.Server
+	shutdownTrace func(context.Context) error
+	pollInterval  time.Duration
+	jobs          []job
+
+	heartbeat    postgres.WorkerHeartbeat
+	inFlight     sync.WaitGroup
+	jobsInFlight atomic.Int32
+	lastTick     atomic.Int64
+	stopping     atomic.Bool
 }
 
-func New(cfg *config.AppConfig) (*App, error) {
-	logg, err := logger.New(cfg)
+func New(cfg *config.AppConfig, appLogger logger.Logger) (*App, error) {
+	if appLogger == nil {
+		var err error
+		appLogger, err = logger.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	shutdownTrace, err := tracing.Setup(context.Background(), cfg, "booking-worker")
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("tracing init failed: %w", err)
 	}
 
 	db, err := postgres.New(*cfg)
@@ -29,27 +103,378 @@ func New(cfg *config.AppConfig) (*App, error) {
 		return nil, err
 	}
 
-	return &App{
-		config:       cfg,
-		logger:       logg,
-		db:           db,
-		pollInterval: time.Second * 10,
-	}, nil
+	fieldCipher, err := crypto.NewAESFieldCipher(cfg.EncryptionKeyVersion, cfg.EncryptionKey, cfg.EncryptionOldKeys)
+	if err != nil {
+		return nil, fmt.Errorf("field cipher init failed: %w", err)
+	}
+
+	templates, err := notification.NewTemplateRenderer()
+	if err != nil {
+		return nil, fmt.Errorf("notification templates init failed: %w", err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	workerID := fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+	metricsRegistry := metrics.New()
+	metricsRegistry.RegisterDB("postgres", db.DB)
+
+	a := &App{
+		config:      cfg,
+		logger:      appLogger.WithFields(logger.Field{Key: "worker_id", Value: workerID}),
+		db:          db,
+		reencryptor: postgres.NewFieldReencryptor(db, fieldCipher),
+		heartbeats:  postgres.NewHeartbeatRepository(db),
+		idempotency: postgres.NewIdempotencyRepository(db, fieldCipher),
+		rateLimits:  postgres.NewRateLimitRepository(db),
+		outbox:      postgres.NewOutboxRepository(db),
+		bus:         events.NewBus(),
+		webhooks: webhookDomain.NewService(
+			postgres.NewWebhookRepository(db, fieldCipher),
+			postgres.NewTxManager(db),
+			webhook.NewHTTPSender(cfg.WebhookTimeout, cfg.WebhookAllowPrivateNetworks),
+			appLogger,
+			audit.Nop(),
+		),
+		notifications: notificationDomain.NewService(
+			postgres.NewNotificationRepository(db, fieldCipher),
+			templates,
+			appLogger,
+			notification.NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPass, cfg.SMTPFrom),
+			notification.NewLogSMSSender(appLogger),
+		),
+		metrics:       metricsRegistry,
+		shutdownTrace: shutdownTrace,
+		pollInterval:  time.Second * 10,
+		heartbeat: postgres.WorkerHeartbeat{
+			WorkerID:  workerID,
+			Hostname:  hostname,
+			StartedAt: time.Now().UTC(),
+		},
+	}
+	a.jobs = []job{
+		{name: "field_reencryption", run: a.reencryptFields, pending: a.reencryptor.Pending},
+		{name: "idempotency_cleanup", run: a.cleanupIdempotencyKeys},
+		{name: "rate_limit_cleanup", run: a.cleanupRateLimitBuckets},
+		{name: "outbox_relay", run: a.relayOutbox, pending: a.outbox.Pending},
+		{name: "outbox_cleanup", run: a.cleanupOutbox},
+		{name: "webhook_delivery", run: a.deliverWebhooks, pending: a.webhooks.PendingDeliveries},
+		{name: "notification_delivery", run: a.deliverNotifications, pending: a.notifications.PendingNotifications},
+	}
+	a.bus.Subscribe("event_log", a.logEvent)
+	a.bus.Subscribe("webhooks", a.webhooks.HandleEvent)
+
+	mux := http.NewServeMux()
+	routes.RegisterHealthRoutes(mux, healthHandler.NewHandler(
+		a.logger,
+		health.NewCheck("postgres", db.PingContext),
+		health.NewCheck("job_queue", a.checkLoop),
+	))
+	mux.Handle("GET /metrics", metricsRegistry.Handler())
+	a.healthServer = &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", cfg.Host, cfg.WorkerHealthPort),
+		Handler: mux,
+	}
+
+	return a, nil
 }
 
+// Run - ctx ləğv olunana qədər job-ları işlədir. Dayandırıldıqda yeni job başlamır,
+// işləyən job-lar cfg.ShutdownTimeout qədər gözlənilir, sonra onların context-i ləğv olunur.
 func (a *App) Run(ctx context.Context) error {
 	a.logger.Info("Worker starting", logger.Field{Key: "pollInterval", Value: a.pollInterval.String()})
+	defer a.db.Close()
+
+	go func() {
+		a.logger.Info("Worker health server starting", logger.Field{Key: "addr", Value: a.healthServer.Addr})
+		if err := a.healthServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.logger.Error("Worker health server failed", logger.Field{Key: "error", Value: err.Error()})
+		}
+	}()
+
+	// Job-lar siqnaldan təsirlənməyən ayrıca context ilə işləyir ki, yarımçıq tranzaksiya qalmasın.
+	// Job-lar bütün bizneslər üzrə işləyir, row-level security açıq şəkildə keçilir.
+	jobCtx, cancelJobs := context.WithCancel(tenant.Unscoped(context.WithoutCancel(ctx)))
+	defer cancelJobs()
 
 	ticker := time.NewTicker(a.pollInterval)
 	defer ticker.Stop()
 
+	a.tick(jobCtx)
 	for {
 		select {
 		case <-ctx.Done():
-			a.logger.Info("Worker stopping")
+			a.shutdown(cancelJobs)
 			return ctx.Err()
 		case <-ticker.C:
-			a.logger.Info("Worker polling")
+			a.tick(jobCtx)
 		}
 	}
 }
+
+// tick - heartbeat göndərir və əvvəlki dövr bitibsə job-ları işə salır
+func (a *App) tick(ctx context.Context) {
+	a.lastTick.Store(time.Now().UnixNano())
+	a.sendHeartbeat(ctx)
+
+	if !a.jobsInFlight.CompareAndSwap(0, int32(len(a.jobs))) {
+		a.logger.Debug("Previous job cycle still running, skipping tick")
+		return
+	}
+
+	a.inFlight.Add(1)
+	a.metrics.SetJobsInFlight(len(a.jobs))
+	go func() {
+		defer a.inFlight.Done()
+		for _, j := range a.jobs {
+			if !a.stopping.Load() {
+				a.runJob(ctx, j)
+			}
+			a.metrics.SetJobsInFlight(int(a.jobsInFlight.Add(-1)))
+		}
+	}()
+}
+
+func (a *App) runJob(ctx context.Context, j job) {
+	if j.pending != nil {
+		if depth, err := j.pending(ctx); err == nil {
+			a.metrics.SetQueueDepth(j.name, depth)
+		}
+	}
+
+	ctx, span := tracing.StartLinkedSpan(ctx, tracerName, "worker.job "+j.name, nil)
+	defer span.End()
+
+	start := time.Now()
+	err := j.run(ctx)
+	a.metrics.ObserveJob(j.name, time.Since(start), err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.logger.Error("Job failed",
+			logger.Field{Key: "job", Value: j.name},
+			logger.Field{Key: "error", Value: err.Error()},
+		)
+	}
+}
+
+func (a *App) sendHeartbeat(ctx context.Context) {
+	hb := a.heartbeat
+	hb.JobsInFlight = int(a.jobsInFlight.Load())
+	if err := a.heartbeats.Beat(ctx, &hb); err != nil {
+		a.logger.Warn("Worker heartbeat failed", logger.Field{Key: "error", Value: err.Error()})
+	}
+}
+
+// checkLoop - worker-in öz readiness-i: əsas dövr son 3 interval ərzində işləməlidir
+func (a *App) checkLoop(ctx context.Context) error {
+	last := time.Unix(0, a.lastTick.Load())
+	if age := time.Since(last); age > 3*a.pollInterval {
+		return fmt.Errorf("worker loop stalled for %s", age.Round(time.Second))
+	}
+	return nil
+}
+
+func (a *App) shutdown(cancelJobs context.CancelFunc) {
+	a.stopping.Store(true)
+	a.logger.Info("Worker stopping, draining in-flight jobs",
+		logger.Field{Key: "jobsInFlight", Value: a.jobsInFlight.Load()},
+		logger.Field{Key: "timeout", Value: a.config.ShutdownTimeout.String()},
+	)
+
+	drained := make(chan struct{})
+	go func() {
+		a.inFlight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(a.config.ShutdownTimeout):
+		a.logger.Warn("Drain timeout exceeded, cancelling in-flight jobs")
+		cancelJobs()
+		<-drained
+	}
+
+	cleanupCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := a.heartbeats.Remove(cleanupCtx, a.heartbeat.WorkerID); err != nil {
+		a.logger.Warn("Failed to remove worker heartbeat", logger.Field{Key: "error", Value: err.Error()})
+	}
+	if err := a.healthServer.Shutdown(cleanupCtx); err != nil {
+		a.logger.Warn("Worker health server shutdown failed", logger.Field{Key: "error", Value: err.Error()})
+	}
+	if err := a.shutdownTrace(cleanupCtx); err != nil {
+		a.logger.Warn("Trace exporter shutdown failed", logger.Field{Key: "error", Value: err.Error()})
+	}
+}
+
+// reencryptFields - köhnə açarla şifrələnmiş sahələri bitənə qədər batch-larla cari açara keçirir
+func (a *App) reencryptFields(ctx context.Context) error {
+	total := 0
+	for ctx.Err() == nil && !a.stopping.Load() {
+		processed, err := a.reencryptor.ReencryptBatch(ctx, reencryptBatchSize)
+		if err != nil {
+			return fmt.Errorf("field re-encryption failed: %w", err)
+		}
+		total += processed
+		if processed == 0 {
+			break
+		}
+	}
+	if total > 0 {
+		a.logger.Info("Fields re-encrypted", logger.Field{Key: "rows", Value: total})
+	}
+	return nil
+}
+
+// cleanupIdempotencyKeys - TTL-i bitmiş Idempotency-Key qeydlərini silir
+func (a *App) cleanupIdempotencyKeys(ctx context.Context) error {
+	deleted, err := a.idempotency.DeleteExpired(ctx, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		a.logger.Info("Expired idempotency keys deleted", logger.Field{Key: "rows", Value: deleted})
+	}
+	return nil
+}
+
+// cleanupRateLimitBuckets - bir gündən çox toxunulmamış bucket-lər artıq tam doludur, silinə bilər
+func (a *App) cleanupRateLimitBuckets(ctx context.Context) error {
+	deleted, err := a.rateLimits.DeleteStale(ctx, time.Now().UTC().Add(-rateLimitBucketMaxAge))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		a.logger.Info("Stale rate limit buckets deleted", logger.Field{Key: "rows", Value: deleted})
+	}
+	return nil
+}
+
+// relayOutbox - outbox-dakı hadisələri in-process abunəçilərə çatdırır (ən azı bir dəfə).
+// Uğursuz hadisə backoff ilə yenidən cəhd olunur, events.MaxAttempts-dan sonra saxlanılır, amma göndərilmir.
+func (a *App) relayOutbox(ctx context.Context) error {
+	total := 0
+	for ctx.Err() == nil && !a.stopping.Load() {
+		claimed, err := a.outbox.Claim(ctx, outboxBatchSize, outboxLease, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		for _, event := range claimed {
+			a.deliverEvent(ctx, event)
+		}
+		total += len(claimed)
+		if len(claimed) < outboxBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		a.logger.Info("Outbox events relayed", logger.Field{Key: "events", Value: total})
+	}
+	return nil
+}
+
+// deliverEvent - hər hadisə öz span-ında çatdırılır, span hadisəni yaradan sorğuya link ilə bağlanır
+func (a *App) deliverEvent(ctx context.Context, event *events.Event) {
+	ctx, span := tracing.StartLinkedSpan(ctx, tracerName, "outbox.deliver "+string(event.Type), event.TraceContext)
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("event.id", event.ID.String()),
+		attribute.String("event.type", string(event.Type)),
+		attribute.Int("event.attempt", event.Attempts+1),
+	)
+
+	now := time.Now().UTC()
+	if err := a.bus.Dispatch(ctx, event); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		attempts := event.Attempts + 1
+		fields := []logger.Field{
+			{Key: "event_id", Value: event.ID.String()},
+			{Key: "event_type", Value: string(event.Type)},
+			{Key: "attempt", Value: attempts},
+			{Key: "error", Value: err.Error()},
+		}
+		if attempts >= events.MaxAttempts {
+			a.logger.Error("Outbox event delivery abandoned", fields...)
+		} else {
+			a.logger.Warn("Outbox event delivery failed, will retry", fields...)
+		}
+		if err := a.outbox.MarkFailed(ctx, event.ID, err.Error(), now.Add(events.Backoff(attempts))); err != nil {
+			a.logger.Error("Failed to reschedule outbox event", logger.Field{Key: "error", Value: err.Error()})
+		}
+		return
+	}
+
+	if err := a.outbox.MarkPublished(ctx, event.ID, now); err != nil {
+		// Lease bitdikdən sonra hadisə təkrar çatdırılacaq - abunəçilər buna hazırdır
+		a.logger.Error("Failed to mark outbox event published", logger.Field{Key: "error", Value: err.Error()})
+	}
+}
+
+func (a *App) logEvent(ctx context.Context, event *events.Event) error {
+	a.logger.WithContext(ctx).Debug("Domain event",
+		logger.Field{Key: "event_id", Value: event.ID.String()},
+		logger.Field{Key: "event_type", Value: string(event.Type)},
+		logger.Field{Key: "business_id", Value: event.BusinessID.String()},
+	)
+	return nil
+}
+
+// cleanupOutbox - çatdırılmış hadisələri saxlama müddəti bitdikdən sonra silir
+func (a *App) cleanupOutbox(ctx context.Context) error {
+	deleted, err := a.outbox.DeletePublished(ctx, time.Now().UTC().Add(-outboxRetention))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		a.logger.Info("Published outbox events deleted", logger.Field{Key: "rows", Value: deleted})
+	}
+	return nil
+}
+
+// deliverWebhooks - vaxtı çatmış webhook çatdırılmalarını batch-larla göndərir
+func (a *App) deliverWebhooks(ctx context.Context) error {
+	lease := webhookBatchSize * (a.config.WebhookTimeout + webhookLeasePerDelivery)
+	total := 0
+	for ctx.Err() == nil && !a.stopping.Load() {
+		sent, err := a.webhooks.DeliverDue(ctx, webhookBatchSize, lease)
+		if err != nil {
+			return err
+		}
+		total += sent
+		if sent < webhookBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		a.logger.Info("Webhook deliveries attempted", logger.Field{Key: "deliveries", Value: total})
+	}
+	return nil
+}
+
+// deliverNotifications - vaxtı çatmış email və SMS bildirişlərini batch-larla göndərir
+func (a *App) deliverNotifications(ctx context.Context) error {
+	lease := notificationBatchSize * notificationLeasePerMessage
+	total := 0
+	for ctx.Err() == nil && !a.stopping.Load() {
+		sent, err := a.notifications.DeliverDue(ctx, notificationBatchSize, lease)
+		if err != nil {
+			return err
+		}
+		total += sent
+		if sent < notificationBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		a.logger.Info("Notifications attempted", logger.Field{Key: "notifications", Value: total})
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type AppConfig struct {
@@ -15,21 +16,47 @@ type AppConfig struct {
 	JWTSecret string
 	DbDsn     string
 
+	FrontendURL string
+
+	ShutdownTimeout  time.Duration
+	WorkerHealthPort int
+	MetricsPort      int
+
+	TracingExporter    string
+	TracingSampleRatio float64
+
 	DBUser     string
 	DBPassword string
 	DBHost     string
 	DBPort     string
 	DBName     string
 
-	EncryptionKey  string
-	EnableDebug    bool
-	MaxConcurrency int
+	AutoMigrate bool
+
+	EncryptionKey        string
+	EncryptionKeyVersion int
+	EncryptionOldKeys    map[int]string
+	EnableDebug          bool
+	MaxConcurrency       int
 
 	SMTPHost string
 	SMTPPort int
 	SMTPUser string
 	SMTPPass string
 	SMTPFrom string
+
+	RateLimitBackend  string
+	RateLimits        map[string]RateLimit
+	TrustProxyHeaders bool
+
+	WebhookTimeout              time.Duration
+	WebhookAllowPrivateNetworks bool
+}
+
+// RateLimit - APP_RATE_LIMITS ilə ad üzrə dəyişdirilən kvota
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
 }
 
 func Load() (*AppConfig, error) {
@@ -48,6 +75,12 @@ func Load() (*AppConfig, error) {
 	if err = LoadEmailConfig(cfg); err != nil {
 		return nil, fmt.Errorf("email config error: %w", err)
 	}
+	if err = LoadRateLimitConfig(cfg); err != nil {
+		return nil, fmt.Errorf("rate limit config error: %w", err)
+	}
+	if err = LoadWebhookConfig(cfg); err != nil {
+		return nil, fmt.Errorf("webhook config error: %w", err)
+	}
 	return cfg, nil
 }
 
@@ -71,8 +104,61 @@ func LoadServerConfig(cfg *AppConfig) error {
 		cfg.Host = "0.0.0.0"
 	}
 
+	// Worker-in /healthz və /readyz endpoint-ləri üçün ayrıca port
+	cfg.WorkerHealthPort = 8081
+	if portStr := strings.TrimSpace(os.Getenv("APP_WORKER_HEALTH_PORT")); portStr != "" {
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return fmt.Errorf("APP_WORKER_HEALTH_PORT must be a number: %w", err)
+		}
+		cfg.WorkerHealthPort = port
+	}
+
+	// API /metrics ictimai portda deyil, ayrıca daxili portda verilir
+	cfg.MetricsPort = 9090
+	if portStr := strings.TrimSpace(os.Getenv("APP_METRICS_PORT")); portStr != "" {
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return fmt.Errorf("APP_METRICS_PORT must be a number: %w", err)
+		}
+		cfg.MetricsPort = port
+	}
+
+	// APP_TRACING_EXPORTER: none (default), stdout (lokal), otlp (OTEL_EXPORTER_OTLP_ENDPOINT)
+	cfg.TracingExporter = strings.ToLower(strings.TrimSpace(os.Getenv("APP_TRACING_EXPORTER")))
+	switch cfg.TracingExporter {
+	case "":
+		cfg.TracingExporter = "none"
+	case "none", "stdout", "otlp":
+	default:
+		return fmt.Errorf("APP_TRACING_EXPORTER must be one of none, stdout, otlp")
+	}
+	cfg.TracingSampleRatio = 1
+	if ratioStr := strings.TrimSpace(os.Getenv("APP_TRACING_SAMPLE_RATIO")); ratioStr != "" {
+		ratio, err := strconv.ParseFloat(ratioStr, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return fmt.Errorf("APP_TRACING_SAMPLE_RATIO must be a number between 0 and 1")
+		}
+		cfg.TracingSampleRatio = ratio
+	}
+
+	// SIGTERM-dən sonra HTTP sorğuları və işləyən job-lar üçün gözləmə müddəti
+	cfg.ShutdownTimeout = 15 * time.Second
+	if timeoutStr := strings.TrimSpace(os.Getenv("APP_SHUTDOWN_TIMEOUT")); timeoutStr != "" {
+		timeout, err := time.ParseDuration(timeoutStr)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("APP_SHUTDOWN_TIMEOUT must be a positive duration like 15s")
+		}
+		cfg.ShutdownTimeout = timeout
+	}
+
 	cfg.DbDsn = strings.TrimSpace(os.Getenv("APP_DB_DSN"))
 
+	cfg.FrontendURL = strings.TrimSpace(os.Getenv("APP_FRONTEND_URL"))
+	if cfg.FrontendURL == "" {
+		cfg.FrontendURL = "https://bronet.com"
+	}
+
 	cfg.LogLevel = strings.TrimSpace(os.Getenv("APP_LOG_LEVEL"))
 	if cfg.LogLevel == "" {
 		cfg.LogLevel = "info"
@@ -90,10 +176,45 @@ func LoadSecurityConfig(cfg *AppConfig) error {
 	if cfg.EncryptionKey == "" {
 		return errors.New("Encryption is required but not set")
 	}
+
+	cfg.EncryptionKeyVersion = 1
+	if versionStr := strings.TrimSpace(os.Getenv("APP_ENCRYPTION_KEY_VERSION")); versionStr != "" {
+		version, err := strconv.Atoi(versionStr)
+		if err != nil || version < 1 {
+			return fmt.Errorf("APP_ENCRYPTION_KEY_VERSION must be a positive number")
+		}
+		cfg.EncryptionKeyVersion = version
+	}
+
+	// APP_ENCRYPTION_OLD_KEYS formatı: "1:köhnə-açar,2:digər-açar"
+	cfg.EncryptionOldKeys = map[int]string{}
+	for _, entry := range strings.Split(os.Getenv("APP_ENCRYPTION_OLD_KEYS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		versionStr, key, found := strings.Cut(entry, ":")
+		version, err := strconv.Atoi(versionStr)
+		if !found || err != nil || version < 1 || key == "" {
+			return fmt.Errorf("APP_ENCRYPTION_OLD_KEYS entry must look like <version>:<key>")
+		}
+		cfg.EncryptionOldKeys[version] = key
+	}
 	return nil
 }
 
 func LoadDatabaseConfig(cfg *AppConfig) error {
+	// APP_AUTO_MIGRATE=false olduqda API/worker miqrasiya etmir, cmd/migrate istifadə olunur.
+	// Miqrasiya birbaşa (pooler-siz) DSN tələb edir - bax postgres.Migrator.
+	cfg.AutoMigrate = true
+	if autoStr := strings.TrimSpace(os.Getenv("APP_AUTO_MIGRATE")); autoStr != "" {
+		auto, err := strconv.ParseBool(autoStr)
+		if err != nil {
+			return fmt.Errorf("APP_AUTO_MIGRATE must be true or false: %w", err)
+		}
+		cfg.AutoMigrate = auto
+	}
+
 	if strings.TrimSpace(cfg.DbDsn) != "" {
 		return nil
 	}
@@ -138,3 +259,66 @@ func LoadEmailConfig(cfg *AppConfig) error {
 
 	return nil
 }
+
+func LoadRateLimitConfig(cfg *AppConfig) error {
+	// APP_RATE_LIMIT_BACKEND: memory (default, hər instansın öz kvotası), postgres (instanslar arası ortaq), none
+	cfg.RateLimitBackend = strings.ToLower(strings.TrimSpace(os.Getenv("APP_RATE_LIMIT_BACKEND")))
+	switch cfg.RateLimitBackend {
+	case "":
+		cfg.RateLimitBackend = "memory"
+	case "memory", "postgres", "none":
+	default:
+		return fmt.Errorf("APP_RATE_LIMIT_BACKEND must be one of memory, postgres, none")
+	}
+
+	// APP_RATE_LIMITS formatı: "auth_login=10/1m,business=2000/1m"
+	cfg.RateLimits = map[string]RateLimit{}
+	for _, entry := range strings.Split(os.Getenv("APP_RATE_LIMITS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, found := strings.Cut(entry, "=")
+		requestsStr, perStr, slash := strings.Cut(value, "/")
+		requests, err := strconv.Atoi(requestsStr)
+		if !found || !slash || err != nil || requests < 1 {
+			return fmt.Errorf("APP_RATE_LIMITS entry must look like <name>=<requests>/<duration>")
+		}
+		per, err := time.ParseDuration(perStr)
+		if err != nil || per <= 0 {
+			return fmt.Errorf("APP_RATE_LIMITS entry %q has an invalid duration", name)
+		}
+		cfg.RateLimits[strings.TrimSpace(name)] = RateLimit{Requests: requests, Per: per}
+	}
+
+	// Proxy (load balancer) arxasında klient IP-si X-Forwarded-For-un son elementindən götürülür
+	if trustStr := strings.TrimSpace(os.Getenv("APP_TRUST_PROXY_HEADERS")); trustStr != "" {
+		trust, err := strconv.ParseBool(trustStr)
+		if err != nil {
+			return fmt.Errorf("APP_TRUST_PROXY_HEADERS must be true or false: %w", err)
+		}
+		cfg.TrustProxyHeaders = trust
+	}
+	return nil
+}
+
+func LoadWebhookConfig(cfg *AppConfig) error {
+	cfg.WebhookTimeout = 10 * time.Second
+	if timeoutStr := strings.TrimSpace(os.Getenv("APP_WEBHOOK_TIMEOUT")); timeoutStr != "" {
+		timeout, err := time.ParseDuration(timeoutStr)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("APP_WEBHOOK_TIMEOUT must be a positive duration")
+		}
+		cfg.WebhookTimeout = timeout
+	}
+
+	// Default olaraq webhook-lar daxili şəbəkə ünvanlarına göndərilmir (SSRF); lokal inkişaf üçün açıla bilər
+	if allowStr := strings.TrimSpace(os.Getenv("APP_WEBHOOK_ALLOW_PRIVATE_NETWORKS")); allowStr != "" {
+		allow, err := strconv.ParseBool(allowStr)
+		if err != nil {
+			return fmt.Errorf("APP_WEBHOOK_ALLOW_PRIVATE_NETWORKS must be true or false: %w", err)
+		}
+		cfg.WebhookAllowPrivateNetworks = allow
+	}
+	return nil
+}
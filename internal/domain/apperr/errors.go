@@ -0,0 +1,130 @@
+// File: internal/domain/apperr/errors.go
+package apperr
+
+import "errors"
+
+// Kind - xətanın növü, HTTP qatı statusu buna görə seçir
+type Kind string
+
+const (
+	KindValidation   Kind = "validation"
+	KindNotFound     Kind = "not_found"
+	KindConflict     Kind = "conflict"
+	KindForbidden    Kind = "forbidden"
+	KindUnauthorized Kind = "unauthorized"
+	KindInternal     Kind = "internal"
+	// KindPreconditionFailed - If-Match versiyası resursun cari versiyası ilə uyğun gəlmir
+	KindPreconditionFailed Kind = "precondition_failed"
+	// KindPreconditionRequired - dəyişiklik sorğusunda If-Match göndərilməyib
+	KindPreconditionRequired Kind = "precondition_required"
+	// KindTooManyRequests - klient rate limit kvotasını aşıb
+	KindTooManyRequests Kind = "too_many_requests"
+)
+
+// ErrVersionMismatch - resurs oxunandan sonra başqa sorğu tərəfindən dəyişdirilib
+var ErrVersionMismatch = PreconditionFailed("VERSION_MISMATCH", "Resource has been modified by another request")
+
+// Error - bütün domain-lər üçün ortaq xəta tipi
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	// Field - validation xətasının aid olduğu sahə (JSON adı), yoxdursa boş
+	Field string
+	// Details - bir neçə sahə eyni anda səhvdirsə hər biri ayrıca
+	Details []*Error
+	Err     error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func Validation(code, message string) *Error {
+	return New(KindValidation, code, message)
+}
+
+// InvalidField - konkret sahəyə aid validation xətası
+func InvalidField(field, code, message string) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message, Field: field}
+}
+
+// InvalidFields - bir neçə sahə xətasını tək validation xətasında birləşdirir
+func InvalidFields(details ...*Error) *Error {
+	return &Error{
+		Kind:    KindValidation,
+		Code:    "VALIDATION_ERROR",
+		Message: "Request validation failed",
+		Details: details,
+	}
+}
+
+func NotFound(code, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+func Conflict(code, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+func Forbidden(code, message string) *Error {
+	return New(KindForbidden, code, message)
+}
+
+func Unauthorized(code, message string) *Error {
+	return New(KindUnauthorized, code, message)
+}
+
+func Internal(code, message string) *Error {
+	return New(KindInternal, code, message)
+}
+
+func PreconditionFailed(code, message string) *Error {
+	return New(KindPreconditionFailed, code, message)
+}
+
+func PreconditionRequired(code, message string) *Error {
+	return New(KindPreconditionRequired, code, message)
+}
+
+func TooManyRequests(code, message string) *Error {
+	return New(KindTooManyRequests, code, message)
+}
+
+// Wrap - səbəbi saxlayaraq xətanı qaytarır
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+	return &wrapped
+}
+
+// As - zəncirdə *Error axtarır
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
+
+// HasCode - xəta verilmiş koda malikdirsə true
+func HasCode(err error, code string) bool {
+	appErr, ok := As(err)
+	return ok && appErr.Code == code
+}
+
+// KindOf - *Error deyilsə KindInternal qaytarır
+func KindOf(err error) Kind {
+	if appErr, ok := As(err); ok {
+		return appErr.Kind
+	}
+	return KindInternal
+}
@@ -0,0 +1,41 @@
+// File: internal/domain/audit/context.go
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type (
+	originKey struct{}
+	actorKey  struct{}
+)
+
+// Origin - dəyişikliyin gəldiyi sorğu; HTTP qatı bütün sorğular üçün qurur
+type Origin struct {
+	IP        string
+	RequestID string
+}
+
+func WithOrigin(ctx context.Context, origin Origin) context.Context {
+	return context.WithValue(ctx, originKey{}, origin)
+}
+
+// WithActor - dəyişikliyi edən istifadəçi; autentifikasiyadan sonra və ya dəvət qəbul edildikdə qurulur
+func WithActor(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorKey{}, userID)
+}
+
+func OriginFrom(ctx context.Context) Origin {
+	origin, _ := ctx.Value(originKey{}).(Origin)
+	return origin
+}
+
+func ActorFrom(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(actorKey{}).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		return uuid.Nil, false
+	}
+	return userID, true
+}
@@ -0,0 +1,214 @@
+// File: internal/domain/audit/entity.go
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/google/uuid"
+)
+
+type EntityType string
+
+const (
+	EntityBusiness          EntityType = "business"
+	EntityBusinessOwner     EntityType = "business_owner"
+	EntityOwnershipTransfer EntityType = "ownership_transfer"
+	EntityLocation          EntityType = "location"
+	EntityService           EntityType = "service"
+	EntityStaffService      EntityType = "staff_service"
+	EntityStaff             EntityType = "staff"
+	EntityStaffInvite       EntityType = "staff_invite"
+	EntityWebhookEndpoint   EntityType = "webhook_endpoint"
+)
+
+var EntityTypes = []EntityType{
+	EntityBusiness,
+	EntityBusinessOwner,
+	EntityOwnershipTransfer,
+	EntityLocation,
+	EntityService,
+	EntityStaffService,
+	EntityStaff,
+	EntityStaffInvite,
+	EntityWebhookEndpoint,
+}
+
+type Action string
+
+const (
+	ActionCreated     Action = "created"
+	ActionUpdated     Action = "updated"
+	ActionDeactivated Action = "deactivated"
+	ActionAdded       Action = "added"
+	ActionRemoved     Action = "removed"
+	ActionAssigned    Action = "assigned"
+	ActionInitiated   Action = "initiated"
+	ActionConfirmed   Action = "confirmed"
+	ActionCancelled   Action = "cancelled"
+	ActionInvited     Action = "invited"
+	ActionResent      Action = "resent"
+	ActionRevoked     Action = "revoked"
+	ActionAccepted    Action = "accepted"
+	ActionDeleted     Action = "deleted"
+)
+
+var Actions = []Action{
+	ActionCreated,
+	ActionUpdated,
+	ActionDeactivated,
+	ActionAdded,
+	ActionRemoved,
+	ActionAssigned,
+	ActionInitiated,
+	ActionConfirmed,
+	ActionCancelled,
+	ActionInvited,
+	ActionResent,
+	ActionRevoked,
+	ActionAccepted,
+	ActionDeleted,
+}
+
+// RedactedValue - şifrələnmiş saxlanan sahələrin jurnalda göstərilən dəyəri; dəyişikliyin özü görünür
+const RedactedValue = "[redacted]"
+
+// ignoredFields - hər yazıda dəyişən texniki sahələr diff-ə düşmür
+var ignoredFields = map[string]bool{
+	"version":    true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// redactedFields - verilənlər bazasında şifrəli saxlanan sahələr jurnala açıq yazılmır
+var redactedFields = map[string]bool{
+	"hourly_rate": true,
+}
+
+// Change - sahənin əvvəlki və yeni dəyəri; yaradılmada Before, silinmədə After boşdur
+type Change struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+type Changes map[string]Change
+
+// Entry - servislərin qeyd etdiyi hadisə; aktor, IP və request ID kontekstdən götürülür
+type Entry struct {
+	BusinessID uuid.UUID
+	EntityType EntityType
+	EntityID   uuid.UUID
+	Action     Action
+	Changes    Changes
+}
+
+// Event - jurnaldakı dəyişməz qeyd. ActorID sistem tərəfindən edilən dəyişikliklərdə boşdur.
+type Event struct {
+	ID         uuid.UUID  `db:"id" json:"id"`
+	BusinessID uuid.UUID  `db:"business_id" json:"business_id"`
+	ActorID    *uuid.UUID `db:"actor_id" json:"actor_id,omitempty"`
+	EntityType EntityType `db:"entity_type" json:"entity_type"`
+	EntityID   uuid.UUID  `db:"entity_id" json:"entity_id"`
+	Action     Action     `db:"action" json:"action"`
+	Changes    Changes    `db:"-" json:"changes"`
+	IP         string     `db:"ip" json:"ip,omitempty"`
+	RequestID  string     `db:"request_id" json:"request_id,omitempty"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+}
+
+// Filter - siyahı sorğusunun əlavə şərtləri; boş sahələr nəzərə alınmır
+type Filter struct {
+	EntityType EntityType
+	EntityID   *uuid.UUID
+	Action     Action
+	ActorID    *uuid.UUID
+	From       *time.Time
+	To         *time.Time
+}
+
+func (f Filter) Validate() error {
+	var details []*apperr.Error
+	if f.EntityType != "" && !contains(EntityTypes, f.EntityType) {
+		details = append(details, apperr.InvalidField("entity_type", "INVALID_ENTITY_TYPE", "entity_type is not supported"))
+	}
+	if f.Action != "" && !contains(Actions, f.Action) {
+		details = append(details, apperr.InvalidField("action", "INVALID_AUDIT_ACTION", "action is not supported"))
+	}
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		details = append(details, apperr.InvalidField("from", "INVALID_TIME_RANGE", "from must be before to"))
+	}
+
+	if len(details) == 1 {
+		return details[0]
+	}
+	if len(details) > 1 {
+		return apperr.InvalidFields(details...)
+	}
+	return nil
+}
+
+// Diff - iki vəziyyətin JSON təsviri üzrə dəyişən sahələr. before nil-dirsə yaradılma,
+// after nil-dirsə silinmə kimi bütün sahələr qeyd olunur.
+func Diff(before, after interface{}) Changes {
+	beforeFields, afterFields := fields(before), fields(after)
+	changes := Changes{}
+	for name, value := range afterFields {
+		old, existed := beforeFields[name]
+		if existed && reflect.DeepEqual(old, value) {
+			continue
+		}
+		changes.set(name, old, value)
+	}
+	for name, old := range beforeFields {
+		if _, exists := afterFields[name]; !exists {
+			changes.set(name, old, nil)
+		}
+	}
+	return changes
+}
+
+// Field - tək sahənin dəyişməsi (məs. is_active: true → false)
+func Field(name string, before, after interface{}) Changes {
+	changes := Changes{}
+	changes.set(name, before, after)
+	return changes
+}
+
+func (c Changes) set(name string, before, after interface{}) {
+	if ignoredFields[name] {
+		return
+	}
+	if redactedFields[name] {
+		if before != nil {
+			before = RedactedValue
+		}
+		if after != nil {
+			after = RedactedValue
+		}
+	}
+	c[name] = Change{Before: before, After: after}
+}
+
+func fields(value interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	if value == nil || (reflect.ValueOf(value).Kind() == reflect.Ptr && reflect.ValueOf(value).IsNil()) {
+		return result
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return result
+	}
+	_ = json.Unmarshal(payload, &result)
+	return result
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
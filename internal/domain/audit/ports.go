@@ -0,0 +1,35 @@
+// File: internal/domain/audit/ports.go
+package audit
+
+import (
+	"context"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/google/uuid"
+)
+
+// Repository - jurnal yalnız əlavə olunur; yeniləmə və silmə verilənlər bazasında qadağandır
+type Repository interface {
+	Append(ctx context.Context, event *Event) error
+	ListByBusiness(ctx context.Context, businessID uuid.UUID, filter Filter, query listing.Query) ([]*Event, error)
+}
+
+// Recorder - domen servisləri dəyişikliyi bu port vasitəsilə qeyd edir. Record dəyişikliklə eyni
+// tranzaksiyada çağırılır, xəta tranzaksiyanı geri qaytarır.
+type Recorder interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+type Service interface {
+	Recorder
+	ListEvents(ctx context.Context, businessID uuid.UUID, filter Filter, query listing.Query) (*listing.Page[*Event], error)
+}
+
+type nopRecorder struct{}
+
+func (nopRecorder) Record(context.Context, Entry) error { return nil }
+
+// Nop - jurnal tələb olunmayan yerlərdə (worker, testlər)
+func Nop() Recorder {
+	return nopRecorder{}
+}
@@ -0,0 +1,90 @@
+// File: internal/domain/audit/service.go
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/audit")
+
+type AuditService struct {
+	repo   Repository
+	logger logger.Logger
+}
+
+func NewService(repo Repository, appLogger logger.Logger) *AuditService {
+	return &AuditService{repo: repo, logger: appLogger}
+}
+
+// Record - hadisəni kontekstdəki aktor və sorğu məlumatı ilə yazır. Dəyişikliklə eyni tranzaksiyada
+// çağırılmalıdır: yazı alınmasa xəta qaytarılır və dəyişiklik jurnalsız commit olunmur.
+func (s *AuditService) Record(ctx context.Context, entry Entry) error {
+	ctx, span := tracer.Start(ctx, "audit.Record")
+	defer span.End()
+
+	origin := OriginFrom(ctx)
+	event := &Event{
+		ID:         uuid.New(),
+		BusinessID: entry.BusinessID,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Action:     entry.Action,
+		Changes:    entry.Changes,
+		IP:         origin.IP,
+		RequestID:  origin.RequestID,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if actorID, ok := ActorFrom(ctx); ok {
+		event.ActorID = &actorID
+	}
+	if event.Changes == nil {
+		event.Changes = Changes{}
+	}
+
+	if err := s.repo.Append(ctx, event); err != nil {
+		return fmt.Errorf("failed to record %s %s audit event: %w", entry.EntityType, entry.Action, err)
+	}
+	return nil
+}
+
+func (s *AuditService) ListEvents(
+	ctx context.Context,
+	businessID uuid.UUID,
+	filter Filter,
+	query listing.Query,
+) (*listing.Page[*Event], error) {
+	ctx, span := tracer.Start(ctx, "audit.ListEvents")
+	defer span.End()
+
+	if businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
+	}
+	if query.Sort == listing.SortName {
+		return nil, apperr.InvalidField("sort", "INVALID_AUDIT_SORT", "sort must be created_at")
+	}
+	if err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc); err != nil {
+		return nil, err
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.ListByBusiness(ctx, businessID, filter, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	return listing.Paginate(events, query, eventKey), nil
+}
+
+func eventKey(event *Event) listing.Key {
+	return listing.Key{ID: event.ID, CreatedAt: event.CreatedAt}
+}
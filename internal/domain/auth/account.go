@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+// ChangePassword - cari parolu yoxlayır, yenisini yazır və digər sessiyaları ləğv edir
+func (s *Service) ChangePassword(ctx context.Context, userID uuid.UUID, req *ChangePasswordRequest) error {
+	ctx, span := tracer.Start(ctx, "auth.ChangePassword")
+	defer span.End()
+
+	if req == nil || req.CurrentPassword == "" {
+		return apperr.InvalidField("current_password", "CURRENT_PASSWORD_REQUIRED", "Current password is required")
+	}
+	if err := s.validatePassword(req.NewPassword); err != nil {
+		return err
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return apperr.InvalidField("new_password", "PASSWORD_UNCHANGED", "New password must differ from the current one")
+	}
+
+	user, err := s.requireActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.passwordHasher.VerifyPassword(user.PasswordHash, req.CurrentPassword); err != nil {
+		return apperr.Unauthorized("INVALID_CURRENT_PASSWORD", "Current password is incorrect")
+	}
+
+	var keepTokenID *uuid.UUID
+	if req.RefreshToken != "" {
+		rt, err := s.repo.GetRefreshToken(ctx, hashToken(req.RefreshToken))
+		if err != nil {
+			return fmt.Errorf("failed to get refresh token: %w", err)
+		}
+		if rt != nil && rt.UserID == user.ID && !rt.Revoked {
+			keepTokenID = &rt.ID
+		}
+	}
+
+	hashedPassword, err := s.passwordHasher.HashPassword(req.NewPassword)
+	if err != nil {
+		return apperr.Internal("PASSWORD_HASH_FAILED", "Password processing failed")
+	}
+
+	if err := s.repo.ChangePassword(ctx, user.ID, hashedPassword, keepTokenID); err != nil {
+		return fmt.Errorf("failed to change password: %w", err)
+	}
+	s.logger.WithContext(ctx).Info("Password changed", logger.Field{Key: "user_id", Value: user.ID.String()})
+	return nil
+}
+
+// UpdateLocale - istifadəçinin dil seçimini saxlayır; token-dəki locale yenilənsin deyə yeni token-lər qaytarır
+func (s *Service) UpdateLocale(ctx context.Context, userID uuid.UUID, req *UpdateLocaleRequest) (*AuthResponse, error) {
+	ctx, span := tracer.Start(ctx, "auth.UpdateLocale")
+	defer span.End()
+
+	if req == nil || req.Locale == "" {
+		return nil, apperr.InvalidField("locale", "INVALID_LOCALE", "locale must be one of: az, en, ru")
+	}
+	locale, err := parseLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.requireActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateUserLocale(ctx, user.ID, locale); err != nil {
+		return nil, fmt.Errorf("failed to update locale: %w", err)
+	}
+	user.Locale = locale
+
+	s.logger.WithContext(ctx).Info("User locale updated",
+		logger.Field{Key: "user_id", Value: user.ID.String()},
+		logger.Field{Key: "locale", Value: locale},
+	)
+	return s.generateAuthResponse(ctx, user)
+}
+
+// ExportUserData - GDPR məlumat ixracı: profil, sessiyalar və işçi profilləri
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (*UserDataExport, error) {
+	ctx, span := tracer.Start(ctx, "auth.ExportUserData")
+	defer span.End()
+	// İxrac istifadəçinin bütün bizneslərdəki profillərini əhatə edir
+	ctx = tenant.Unscoped(ctx)
+
+	user, err := s.requireActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.repo.ListRefreshTokensByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	sessions := make([]SessionExport, 0, len(tokens))
+	for _, rt := range tokens {
+		sessions = append(sessions, SessionExport{
+			ID:        rt.ID,
+			CreatedAt: rt.CreatedAt,
+			ExpiresAt: rt.ExpiresAt,
+			Revoked:   rt.Revoked,
+		})
+	}
+
+	staffProfiles, err := s.repo.ListStaffProfilesByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staff profiles: %w", err)
+	}
+	if staffProfiles == nil {
+		staffProfiles = []*StaffProfile{}
+	}
+
+	return &UserDataExport{
+		ExportedAt:    time.Now().UTC(),
+		Profile:       user,
+		Sessions:      sessions,
+		StaffProfiles: staffProfiles,
+	}, nil
+}
+
+// DeleteAccount - hesabı silmir, şəxsi məlumatları anonimləşdirir ki, tarixçə (FK-lar) qorunsun
+func (s *Service) DeleteAccount(ctx context.Context, userID uuid.UUID, req *DeleteAccountRequest) error {
+	ctx, span := tracer.Start(ctx, "auth.DeleteAccount")
+	defer span.End()
+	// Sahiblik yoxlaması və anonimləşdirmə istifadəçinin bütün bizneslərinə aiddir
+	ctx = tenant.Unscoped(ctx)
+
+	if req == nil || req.Password == "" {
+		return apperr.InvalidField("password", "PASSWORD_REQUIRED", "Password is required")
+	}
+
+	user, err := s.requireActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.passwordHasher.VerifyPassword(user.PasswordHash, req.Password); err != nil {
+		return apperr.Unauthorized("INVALID_CURRENT_PASSWORD", "Current password is incorrect")
+	}
+
+	soleOwner, err := s.repo.HasSoleOwnedBusiness(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check business ownership: %w", err)
+	}
+	if soleOwner {
+		return apperr.Conflict("OWNERSHIP_TRANSFER_REQUIRED", "Transfer the business or add a co-owner before deleting the account")
+	}
+
+	// Köhnə parolla girişin qarşısını almaq üçün təsadüfi hash
+	randomPassword, err := generateSecureRandomToken(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate random password: %w", err)
+	}
+	hashedPassword, err := s.passwordHasher.HashPassword(randomPassword)
+	if err != nil {
+		return apperr.Internal("PASSWORD_HASH_FAILED", "Password processing failed")
+	}
+
+	anonymizedEmail := fmt.Sprintf("deleted-%s@deleted.invalid", user.ID)
+	if err := s.repo.AnonymizeUser(ctx, user.ID, anonymizedEmail, hashedPassword); err != nil {
+		return fmt.Errorf("failed to anonymize user: %w", err)
+	}
+	s.logger.WithContext(ctx).Info("Account anonymised", logger.Field{Key: "user_id", Value: user.ID.String()})
+	return nil
+}
+
+func (s *Service) requireActiveUser(ctx context.Context, userID uuid.UUID) (*User, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, apperr.Unauthorized("USER_NOT_FOUND", "User not found")
+	}
+	if !user.IsActive {
+		return nil, apperr.Forbidden("USER_INACTIVE", "Account is inactive")
+	}
+	return user, nil
+}
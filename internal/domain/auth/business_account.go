@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+// CreateStaffUserRequest - dəvətlə gələn və hesabı olmayan işçi üçün
+type CreateStaffUserRequest struct {
+	Email      string
+	Password   string
+	FullName   string
+	Phone      string
+	BusinessID uuid.UUID
+	// InvitedEmail - dəvət linkinin yalnız email ilə göndərildiyi ünvan; boşdursa email təsdiqlənməmiş qalır
+	InvitedEmail string
+}
+
+// GetActiveUser - aktiv istifadəçini qaytarır (digər domenlər üçün)
+func (s *Service) GetActiveUser(ctx context.Context, userID uuid.UUID) (*User, error) {
+	ctx, span := tracer.Start(ctx, "auth.GetActiveUser")
+	defer span.End()
+
+	return s.requireActiveUser(ctx, userID)
+}
+
+// CreateStaffUser - dəvət linki ilə qeydiyyat. Email yalnız link həmin ünvana göndərilibsə verified sayılır,
+// əks halda adi qeydiyyatda olduğu kimi təsdiqlənməmiş qalır.
+func (s *Service) CreateStaffUser(ctx context.Context, req *CreateStaffUserRequest) (*User, error) {
+	ctx, span := tracer.Start(ctx, "auth.CreateStaffUser")
+	defer span.End()
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if err := s.validateEmail(email); err != nil {
+		return nil, err
+	}
+	if err := s.validatePassword(req.Password); err != nil {
+		return nil, err
+	}
+	if err := s.validateFullName(strings.TrimSpace(req.FullName)); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.EmailExists(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("email exists check failed: %w", err)
+	}
+	if exists {
+		return nil, apperr.Conflict("EMAIL_EXISTS", "Email already registered, sign in to accept the invite")
+	}
+
+	hashedPassword, err := s.passwordHasher.HashPassword(req.Password)
+	if err != nil {
+		return nil, apperr.Internal("PASSWORD_HASHING_FAILED", "Failed to process password")
+	}
+
+	now := time.Now()
+	businessID := req.BusinessID
+	user := &User{
+		ID:            uuid.New(),
+		Email:         email,
+		PasswordHash:  hashedPassword,
+		FullName:      strings.TrimSpace(req.FullName),
+		Phone:         strings.TrimSpace(req.Phone),
+		Role:          UserTypeStaff,
+		BusinessID:    &businessID,
+		IsActive:      true,
+		IsOwner:       false,
+		EmailVerified: req.InvitedEmail != "" && strings.EqualFold(email, strings.TrimSpace(req.InvitedEmail)),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := s.repo.CreateUser(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	s.logger.WithContext(ctx).Info("Staff user created",
+		logger.Field{Key: "user_id", Value: user.ID.String()},
+		logger.Field{Key: "business_id", Value: req.BusinessID.String()},
+	)
+	return user, nil
+}
+
+// AttachUserToBusiness - mövcud istifadəçini biznesə işçi kimi bağlayır
+func (s *Service) AttachUserToBusiness(ctx context.Context, user *User, businessID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "auth.AttachUserToBusiness")
+	defer span.End()
+
+	if user.BusinessID != nil && *user.BusinessID != businessID {
+		return apperr.Conflict("ALREADY_IN_BUSINESS", "User already belongs to another business")
+	}
+
+	role := user.Role
+	if role == UserTypeCustomer {
+		role = UserTypeStaff
+	}
+
+	if err := s.repo.UpdateUserBusiness(ctx, user.ID, businessID, role, user.IsOwner); err != nil {
+		return fmt.Errorf("failed to attach user to business: %w", err)
+	}
+
+	user.BusinessID = &businessID
+	user.Role = role
+	s.logger.WithContext(ctx).Info("User attached to business",
+		logger.Field{Key: "user_id", Value: user.ID.String()},
+		logger.Field{Key: "business_id", Value: businessID.String()},
+	)
+	return nil
+}
+
+// AssignBusinessOwner - onboarding zamanı istifadəçini yeni biznesin sahibi edir
+func (s *Service) AssignBusinessOwner(ctx context.Context, user *User, businessID uuid.UUID, role UserRole) error {
+	ctx, span := tracer.Start(ctx, "auth.AssignBusinessOwner")
+	defer span.End()
+
+	if user.BusinessID != nil {
+		return apperr.Conflict("ALREADY_IN_BUSINESS", "User already belongs to a business")
+	}
+
+	if err := s.repo.UpdateUserBusiness(ctx, user.ID, businessID, role, true); err != nil {
+		return fmt.Errorf("failed to assign business owner: %w", err)
+	}
+
+	user.BusinessID = &businessID
+	user.Role = role
+	user.IsOwner = true
+	return nil
+}
+
+// IssueAuthResponse - yeni access və refresh token yaradır
+func (s *Service) IssueAuthResponse(ctx context.Context, user *User) (*AuthResponse, error) {
+	ctx, span := tracer.Start(ctx, "auth.IssueAuthResponse")
+	defer span.End()
+
+	return s.generateAuthResponse(ctx, user)
+}
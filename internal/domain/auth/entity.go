@@ -42,6 +42,7 @@ type User struct {
 	IsActive      bool       `db:"is_active" json:"is_active"`
 	IsOwner       bool       `db:"is_owner" json:"is_owner"`
 	EmailVerified bool       `db:"email_verified" json:"email_verified"`
+	Locale        string     `db:"locale" json:"locale,omitempty"`
 	CreatedAt     time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt     time.Time  `db:"updated_at" json:"updated_at"`
 }
@@ -65,17 +66,17 @@ type PasswordReset struct {
 type StaffProfile struct {
 	ID         uuid.UUID  `db:"id" json:"id"`
 	UserID     uuid.UUID  `db:"user_id" json:"user_id"`
-	BusinessID *uuid.UUID `db:"business_id"`
-	LocationID *uuid.UUID `db:"location_id"`
-	Role       StaffRole  `db:"role"`
-	Title      string     `db:"title"`
-	Department string     `db:"department"`
-	Bio        string     `db:"bio"`
-	HourlyRate float64    `db:"hourly_rate"`
-	Status     string     `db:"status"`
-	JoinedAt   time.Time  `db:"joined_at"`
-	CreatedAt  time.Time  `db:"created_at"`
-	UpdatedAt  time.Time  `db:"updated_at"`
+	BusinessID *uuid.UUID `db:"business_id" json:"business_id"`
+	LocationID *uuid.UUID `db:"location_id" json:"location_id"`
+	Role       StaffRole  `db:"role" json:"role"`
+	Title      string     `db:"title" json:"title"`
+	Department string     `db:"department" json:"department"`
+	Bio        string     `db:"bio" json:"bio"`
+	HourlyRate float64    `db:"hourly_rate" json:"hourly_rate"`
+	Status     string     `db:"status" json:"status"`
+	JoinedAt   time.Time  `db:"joined_at" json:"joined_at"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at" json:"updated_at"`
 }
 type JWTClaims struct {
 	UserID     uuid.UUID  `db:"user_id" json:"user_id"`
@@ -83,6 +84,7 @@ type JWTClaims struct {
 	Role       UserRole   `db:"role" json:"role"`
 	BusinessID *uuid.UUID `db:"business_id" json:"business_id"`
 	IsOwner    bool       `db:"is_owner" json:"is_owner"`
+	Locale     string     `db:"locale" json:"locale,omitempty"`
 	ExpiresAt  int64      `db:"expires_at" json:"expires_at"`
 }
 type RegisterRequest struct {
@@ -90,6 +92,7 @@ type RegisterRequest struct {
 	Password string `db:"password" json:"password"`
 	FullName string `db:"full_name" json:"full_name"`
 	Phone    string `db:"phone" json:"phone"`
+	Locale   string `db:"locale" json:"locale"`
 }
 
 type LoginRequest struct {
@@ -106,6 +109,33 @@ type ResetPasswordRequest struct {
 	Token    string `db:"token" json:"token"`
 	Password string `db:"password" json:"password"`
 }
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+	RefreshToken    string `json:"refresh_token"`
+}
+type UpdateLocaleRequest struct {
+	Locale string `json:"locale"`
+}
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
+// SessionExport - refresh token-in hash-i olmadan sessiya məlumatı
+type SessionExport struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Revoked   bool      `json:"revoked"`
+}
+
+// UserDataExport - GDPR üzrə istifadəçinin bütün şəxsi məlumatları
+type UserDataExport struct {
+	ExportedAt    time.Time       `json:"exported_at"`
+	Profile       *User           `json:"profile"`
+	Sessions      []SessionExport `json:"sessions"`
+	StaffProfiles []*StaffProfile `json:"staff_profiles"`
+}
 
 type AuthResponse struct {
 	AccessToken  string `json:"access_token"`
@@ -114,11 +144,3 @@ type AuthResponse struct {
 	ExpiresIn    int    `json:"expires_in"`
 	TokenType    string `json:"token_type"`
 }
-type RegistrationError struct {
-	Code    string `db:"code" json:"code"`
-	Message string `db:"message" json:"message"`
-}
-
-func (e *RegistrationError) Error() string {
-	return e.Message
-}
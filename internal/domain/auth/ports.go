@@ -18,15 +18,20 @@ type AuthRepository interface {
 	UpdatePassword(ctx context.Context, userID string, hashedPassword string) error
 	EmailExists(ctx context.Context, email string) (bool, error)
 	UpdateUserStatus(ctx context.Context, userID uuid.UUID, status string) error
+	UpdateUserLocale(ctx context.Context, userID uuid.UUID, locale string) error
+
+	ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]*RefreshToken, error)
+	ChangePassword(ctx context.Context, userID uuid.UUID, hashedPassword string, keepTokenID *uuid.UUID) error
+	ListStaffProfilesByUser(ctx context.Context, userID uuid.UUID) ([]*StaffProfile, error)
+	HasSoleOwnedBusiness(ctx context.Context, userID uuid.UUID) (bool, error)
+	AnonymizeUser(ctx context.Context, userID uuid.UUID, anonymizedEmail string, passwordHash string) error
+	UpdateUserBusiness(ctx context.Context, userID, businessID uuid.UUID, role UserRole, isOwner bool) error
 }
 
 type PasswordHasher interface {
 	HashPassword(password string) (string, error)
 	VerifyPassword(hash, password string) error
 }
-type EmailService interface {
-	SendPasswordResetEmail(email string, resetURL string) error
-}
 type TokenManager interface {
 	GenerateAccessToken(claims *JWTClaims) (string, error)
 	GenerateRefreshToken() (string, error)
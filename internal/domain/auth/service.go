@@ -9,31 +9,52 @@ import (
 	"strings"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/metrics"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/transaction"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/auth")
+
 type Service struct {
 	repo           AuthRepository
+	txManager      transaction.Manager
 	passwordHasher PasswordHasher
-	emailService   EmailService
+	notifier       notification.Notifier
 	tokenManager   TokenManager
+	logger         logger.Logger
+	metrics        metrics.Recorder
 }
 
 func NewAuthService(
 	repo AuthRepository,
+	txManager transaction.Manager,
 	hasher PasswordHasher,
-	email EmailService,
+	notifier notification.Notifier,
 	token TokenManager,
+	appLogger logger.Logger,
+	recorder metrics.Recorder,
 ) *Service {
 	return &Service{
 		repo:           repo,
+		txManager:      txManager,
 		passwordHasher: hasher,
-		emailService:   email,
+		notifier:       notifier,
 		tokenManager:   token,
+		logger:         appLogger,
+		metrics:        recorder,
 	}
 }
 
 func (s *Service) Register(ctx context.Context, req *RegisterRequest) (*AuthResponse, error) {
+	ctx, span := tracer.Start(ctx, "auth.Register")
+	defer span.End()
+
 	if err := s.validateRegisterRequest(req); err != nil {
 		return nil, err
 	}
@@ -42,18 +63,13 @@ func (s *Service) Register(ctx context.Context, req *RegisterRequest) (*AuthResp
 		return nil, fmt.Errorf("email exists check failed: %w", err)
 	}
 	if exists {
-		return nil, &RegistrationError{
-			Code:    "EMAIL_EXISTS",
-			Message: "Email already registered",
-		}
+		return nil, apperr.Conflict("EMAIL_EXISTS", "Email already registered")
 	}
 	hashedPassword, err := s.passwordHasher.HashPassword(req.Password)
 	if err != nil {
-		return nil, &RegistrationError{
-			Code:    "PASSWORD_HASHING_FAILED",
-			Message: "Failed to process password",
-		}
+		return nil, apperr.Internal("PASSWORD_HASHING_FAILED", "Failed to process password")
 	}
+	locale, _ := parseLocale(req.Locale)
 	now := time.Now()
 	userID := uuid.New()
 
@@ -68,50 +84,65 @@ func (s *Service) Register(ctx context.Context, req *RegisterRequest) (*AuthResp
 		IsActive:      true,
 		IsOwner:       false,
 		EmailVerified: false,
+		Locale:        locale,
 		Avatar:        nil,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
 
-	if err := s.repo.CreateUser(ctx, user); err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
+	var authResp *AuthResponse
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateUser(ctx, user); err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		authResp, err = s.generateAuthResponse(ctx, user)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return s.generateAuthResponse(ctx, user)
+	s.logger.WithContext(ctx).Info("User registered", logger.Field{Key: "user_id", Value: user.ID.String()})
+	s.metrics.Record(metrics.EventUserRegistered)
+	return authResp, nil
 }
 
 func (s *Service) Login(ctx context.Context, req *LoginRequest) (*AuthResponse, error) {
+	ctx, span := tracer.Start(ctx, "auth.Login")
+	defer span.End()
+
 	email := strings.ToLower(strings.TrimSpace(req.Email))
 	user, err := s.repo.GetUserByEmail(ctx, email)
 	if err != nil || user == nil {
-		return nil, &RegistrationError{Code: "INVALID_CREDENTIALS", Message: "Invalid email or password"}
+		return nil, apperr.Unauthorized("INVALID_CREDENTIALS", "Invalid email or password")
 	}
 	if err := s.passwordHasher.VerifyPassword(user.PasswordHash, req.Password); err != nil {
-		return nil, &RegistrationError{
-			Code:    "INVALID_CREDENTIALS",
-			Message: "Invalid email or password",
-		}
+		s.logger.WithContext(ctx).Warn("Login failed: wrong password", logger.Field{Key: "user_id", Value: user.ID.String()})
+		return nil, apperr.Unauthorized("INVALID_CREDENTIALS", "Invalid email or password")
 	}
 	if !user.IsActive {
-		return nil, &RegistrationError{Code: "USER_INACTIVE", Message: "Account is inactive"}
+		return nil, apperr.Forbidden("USER_INACTIVE", "Account is inactive")
 	}
 	return s.generateAuthResponse(ctx, user)
 }
 func (s *Service) RefreshAccessToken(ctx context.Context, plainToken string) (string, error) {
+	ctx, span := tracer.Start(ctx, "auth.RefreshAccessToken")
+	defer span.End()
+
 	hashedToken := hashToken(plainToken)
 	rt, err := s.repo.GetRefreshToken(ctx, hashedToken)
 	if err != nil || rt == nil {
-		return "", &RegistrationError{Code: "INVALID_REFRESH_TOKEN", Message: "Invalid refresh token"}
+		return "", apperr.Unauthorized("INVALID_REFRESH_TOKEN", "Invalid refresh token")
 	}
 	if time.Now().After(rt.ExpiresAt) {
-		return "", &RegistrationError{Code: "REFRESH_TOKEN_EXPIRED", Message: "Refresh token expired"}
+		return "", apperr.Unauthorized("REFRESH_TOKEN_EXPIRED", "Refresh token expired")
 	}
 	if rt.Revoked {
-		return "", &RegistrationError{Code: "REFRESH_TOKEN_REVOKED", Message: "Refresh token revoked"}
+		return "", apperr.Unauthorized("REFRESH_TOKEN_REVOKED", "Refresh token revoked")
 	}
 
 	user, err := s.repo.GetUserByID(ctx, rt.UserID)
 	if err != nil || user == nil {
-		return "", &RegistrationError{Code: "USER_NOT_FOUND", Message: "User not found"}
+		return "", apperr.Unauthorized("USER_NOT_FOUND", "User not found")
 	}
 
 	claims := &JWTClaims{
@@ -120,6 +151,7 @@ func (s *Service) RefreshAccessToken(ctx context.Context, plainToken string) (st
 		Role:       user.Role,
 		BusinessID: user.BusinessID,
 		IsOwner:    user.IsOwner,
+		Locale:     user.Locale,
 		ExpiresAt:  time.Now().Add(15 * time.Minute).Unix(),
 	}
 
@@ -132,6 +164,9 @@ func (s *Service) RefreshAccessToken(ctx context.Context, plainToken string) (st
 }
 
 func (s *Service) ForgotPassword(ctx context.Context, req *ForgotPasswordRequest) error {
+	ctx, span := tracer.Start(ctx, "auth.ForgotPassword")
+	defer span.End()
+
 	email := strings.ToLower(strings.TrimSpace(req.Email))
 	user, err := s.repo.GetUserByEmail(ctx, email)
 	if err != nil || user == nil {
@@ -150,11 +185,20 @@ func (s *Service) ForgotPassword(ctx context.Context, req *ForgotPasswordRequest
 		CreatedAt: time.Now(),
 	}
 	if err := s.repo.SavePasswordReset(ctx, reset); err != nil {
-		return &RegistrationError{Code: "RESET_TOKEN_SAVE_FAILED", Message: "Failed to save reset token"}
+		return apperr.Internal("RESET_TOKEN_SAVE_FAILED", "Failed to save reset token")
 	}
 	resetURL := fmt.Sprintf("https://bronet.com/reset-password?token=%s", resetToken)
-	if err := s.emailService.SendPasswordResetEmail(user.Email, resetURL); err != nil {
-		fmt.Printf("Email send failed for %s: %v", user.Email, err)
+	if err := s.notifier.Notify(ctx, notification.Message{
+		Template: notification.TemplatePasswordReset,
+		UserID:   &user.ID,
+		Email:    user.Email,
+		Locale:   i18n.Preferred(ctx, user.Locale),
+		Data:     map[string]string{"url": resetURL},
+	}); err != nil {
+		s.logger.WithContext(ctx).Error("Password reset notification failed",
+			logger.Field{Key: "user_id", Value: user.ID.String()},
+			logger.Field{Key: "error", Value: err.Error()},
+		)
 	}
 	return nil
 }
@@ -165,6 +209,7 @@ func (s *Service) generateAuthResponse(ctx context.Context, user *User) (*AuthRe
 		Role:       user.Role,
 		BusinessID: user.BusinessID,
 		IsOwner:    user.IsOwner,
+		Locale:     user.Locale,
 		ExpiresAt:  time.Now().Add(15 * time.Minute).Unix(),
 	}
 
@@ -201,52 +246,60 @@ func (s *Service) generateAuthResponse(ctx context.Context, user *User) (*AuthRe
 }
 
 func (s *Service) ResetPassword(ctx context.Context, req *ResetPasswordRequest) error {
+	ctx, span := tracer.Start(ctx, "auth.ResetPassword")
+	defer span.End()
+
 	hashedToken := hashToken(req.Token)
 	reset, err := s.repo.GetPasswordReset(ctx, hashedToken)
 	if err != nil || reset == nil {
-		return &RegistrationError{Code: "INVALID_TOKEN", Message: "Invalid or expired token"}
+		return apperr.Validation("INVALID_TOKEN", "Invalid or expired token")
 	}
 	now := time.Now()
 	if now.After(reset.ExpiresAt) {
-		return &RegistrationError{Code: "TOKEN_EXPIRED", Message: "Token expired (24 hours)"}
+		return apperr.Validation("TOKEN_EXPIRED", "Token expired (24 hours)")
 	}
 	if reset.Used {
-		return &RegistrationError{Code: "TOKEN_ALREADY_USED", Message: "Token already used"}
+		return apperr.Conflict("TOKEN_ALREADY_USED", "Token already used")
 	}
 	hashedPassword, err := s.passwordHasher.HashPassword(req.Password)
 	if err != nil {
-		return &RegistrationError{Code: "PASSWORD_HASH_FAILED", Message: "Password processing failed"}
+		return apperr.Internal("PASSWORD_HASH_FAILED", "Password processing failed")
 	}
 	user, err := s.repo.GetUserByEmail(ctx, reset.Email)
 	if err != nil || user == nil {
-		return &RegistrationError{Code: "USER_NOT_FOUND", Message: "User not found"}
-	}
-	if err := s.repo.UpdatePassword(ctx, user.ID.String(), hashedPassword); err != nil {
-		return &RegistrationError{Code: "PASSWORD_UPDATE_FAILED", Message: "Failed to update password"}
+		return apperr.Validation("USER_NOT_FOUND", "User not found")
 	}
 	reset.Used = true
 	reset.UpdatedAt = now
-	if err := s.repo.SavePasswordReset(ctx, reset); err != nil {
-		fmt.Printf("Reset token revoke failed: %v", err)
+	// Token istifadə olunmuş kimi qeyd edilməsə parol da dəyişmir
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdatePassword(ctx, user.ID.String(), hashedPassword); err != nil {
+			return apperr.Internal("PASSWORD_UPDATE_FAILED", "Failed to update password")
+		}
+		if err := s.repo.SavePasswordReset(ctx, reset); err != nil {
+			return fmt.Errorf("failed to mark reset token as used: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+	s.logger.WithContext(ctx).Info("Password reset", logger.Field{Key: "user_id", Value: user.ID.String()})
 	return nil
 }
 func (s *Service) RevokeRefreshToken(ctx context.Context, plainToken string) error {
+	ctx, span := tracer.Start(ctx, "auth.RevokeRefreshToken")
+	defer span.End()
+
 	if plainToken == "" {
-		return &RegistrationError{
-			Code:    "INVALID_REFRESH_TOKEN",
-			Message: "Invalid refresh token",
-		}
+		return apperr.Unauthorized("INVALID_REFRESH_TOKEN", "Invalid refresh token")
 	}
 
 	hashedToken := hashToken(plainToken)
 
 	rt, err := s.repo.GetRefreshToken(ctx, hashedToken)
 	if err != nil || rt == nil {
-		return &RegistrationError{
-			Code:    "INVALID_REFRESH_TOKEN",
-			Message: "Invalid refresh token",
-		}
+		return apperr.Unauthorized("INVALID_REFRESH_TOKEN", "Invalid refresh token")
 	}
 
 	if err := s.repo.RevokeRefreshToken(ctx, rt.ID); err != nil {
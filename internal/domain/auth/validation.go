@@ -2,6 +2,10 @@ package auth
 
 import (
 	"regexp"
+	"strings"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
 )
 
 var (
@@ -19,145 +23,100 @@ func (s *Service) validateRegisterRequest(req *RegisterRequest) error {
 		return err
 	}
 	if req.Phone == "" {
-		return &RegistrationError{
-			Code:    "PHONE_REQUIRED",
-			Message: "phone is required",
-		}
+		return apperr.InvalidField("phone", "PHONE_REQUIRED", "phone is required")
+	}
+	if _, err := parseLocale(req.Locale); err != nil {
+		return err
 	}
 	return nil
 }
 
+// parseLocale - boş dəyər "seçim yoxdur" deməkdir, Accept-Language istifadə olunur
+func parseLocale(value string) (string, error) {
+	if strings.TrimSpace(value) == "" {
+		return "", nil
+	}
+	locale, ok := i18n.Parse(value)
+	if !ok {
+		return "", apperr.InvalidField("locale", "INVALID_LOCALE", "locale must be one of: az, en, ru")
+	}
+	return string(locale), nil
+}
+
 func (s *Service) validateEmail(email string) error {
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	if email == "" {
-		return &RegistrationError{
-			Code:    "EMAIL_REQUIRED",
-			Message: "email is required",
-		}
+		return apperr.InvalidField("email", "EMAIL_REQUIRED", "email is required")
 	}
 	if len(email) > 255 {
-		return &RegistrationError{
-			Code:    "EMAIL_TOO_LONG",
-			Message: "email address is too long",
-		}
+		return apperr.InvalidField("email", "EMAIL_TOO_LONG", "email address is too long")
 	}
 	if !emailRegex.MatchString(email) {
-		return &RegistrationError{
-			Code:    "INVALID_EMAIL_FORMAT",
-			Message: "please provide a valid email address",
-		}
+		return apperr.InvalidField("email", "INVALID_EMAIL_FORMAT", "please provide a valid email address")
 	}
 	return nil
 }
 func (s *Service) validatePassword(password string) error {
 	if password == "" {
-		return &RegistrationError{
-			Code:    "PASSWORD_REQUIRED",
-			Message: "Password is required",
-		}
+		return apperr.InvalidField("password", "PASSWORD_REQUIRED", "Password is required")
 	}
 	if len(password) < 8 {
-		return &RegistrationError{
-			Code:    "PASSWORD_TOO_SHORT",
-			Message: "Password must be at least 8 characters long",
-		}
+		return apperr.InvalidField("password", "PASSWORD_TOO_SHORT", "Password must be at least 8 characters long")
 	}
 	if len(password) > 128 {
-		return &RegistrationError{
-			Code:    "PASSWORD_TOO_LONG",
-			Message: "Password must not exceed 128 characters",
-		}
+		return apperr.InvalidField("password", "PASSWORD_TOO_LONG", "Password must not exceed 128 characters")
 	}
 	if !regexp.MustCompile(`[A-Z]`).MatchString(password) {
-		return &RegistrationError{
-			Code:    "PASSWORD_WEAK",
-			Message: "password must contain at least one uppercase letter",
-		}
+		return apperr.InvalidField("password", "PASSWORD_WEAK", "password must contain at least one uppercase letter")
 	}
 	if !regexp.MustCompile(`[a-z]`).MatchString(password) {
-		return &RegistrationError{
-			Code:    "PASSWORD_WEAK",
-			Message: "Password contain at least one lowercase letter",
-		}
+		return apperr.InvalidField("password", "PASSWORD_WEAK", "Password contain at least one lowercase letter")
 	}
 	if !regexp.MustCompile(`[0-9]`).MatchString(password) {
-		return &RegistrationError{
-			Code:    "PASSWORD_WEAK",
-			Message: "Password contain at least one number",
-		}
+		return apperr.InvalidField("password", "PASSWORD_WEAK", "Password contain at least one number")
 	}
 
 	if !regexp.MustCompile(`[!@#$%^&*()_+\-=\[\]{};:'",.<>?/\\|` + "`" + `]`).MatchString(password) {
-		return &RegistrationError{
-			Code:    "PASSWORD_WEAK",
-			Message: "password must contain at least one special character",
-		}
+		return apperr.InvalidField("password", "PASSWORD_WEAK", "password must contain at least one special character")
 	}
 	return nil
 }
 
 func (s *Service) validateBusinessName(businessName string) error {
 	if businessName == "" {
-		return &RegistrationError{
-			Code:    "BUSINESS_NAME_REQUIRED",
-			Message: "business name is required",
-		}
+		return apperr.InvalidField("name", "BUSINESS_NAME_REQUIRED", "business name is required")
 	}
 	if len(businessName) < 2 {
-		return &RegistrationError{
-			Code:    "BUSINESS_NAME_TOO_SHORT",
-			Message: "business name must be at least 2 characters long",
-		}
+		return apperr.InvalidField("name", "BUSINESS_NAME_TOO_SHORT", "business name must be at least 2 characters long")
 	}
 	if len(businessName) > 255 {
-		return &RegistrationError{
-			Code:    "BUSINESS_NAME_TOO_LONG",
-			Message: "business name must not exceed 255 characters",
-		}
+		return apperr.InvalidField("name", "BUSINESS_NAME_TOO_LONG", "business name must not exceed 255 characters")
 	}
 	return nil
 }
 
 func (s *Service) validateFullName(fullName string) error {
 	if fullName == "" {
-		return &RegistrationError{
-			Code:    "FULLNAME_REQUIRED",
-			Message: "fullname is required",
-		}
+		return apperr.InvalidField("full_name", "FULLNAME_REQUIRED", "fullname is required")
 	}
 	if len(fullName) < 2 {
-		return &RegistrationError{
-			Code:    "FULLNAME_TOO_SHORT",
-			Message: "fullname must be at least 2 characters long",
-		}
+		return apperr.InvalidField("full_name", "FULLNAME_TOO_SHORT", "fullname must be at least 2 characters long")
 	}
 	if len(fullName) > 255 {
-		return &RegistrationError{
-			Code:    "FULLNAME_TOO_LONG",
-			Message: "fullname must not exceed 255 characters",
-		}
+		return apperr.InvalidField("full_name", "FULLNAME_TOO_LONG", "fullname must not exceed 255 characters")
 	}
 	return nil
 }
 
 func (s *Service) validateLocationName(name string) error {
 	if name == "" {
-		return &RegistrationError{
-			Code:    "LOCATION_NAME_REQUIRED",
-			Message: "Location name is required",
-		}
+		return apperr.InvalidField("name", "LOCATION_NAME_REQUIRED", "Location name is required")
 	}
 	if len(name) < 2 {
-		return &RegistrationError{
-			Code:    "LOCATION_NAME_TOO_SHORT",
-			Message: "Location name must be at least 2 characters",
-		}
+		return apperr.InvalidField("name", "LOCATION_NAME_TOO_SHORT", "Location name must be at least 2 characters")
 	}
 	if len(name) > 150 {
-		return &RegistrationError{
-			Code:    "LOCATION_NAME_TOO_LONG",
-			Message: "Location name must not exceed 100 characters",
-		}
+		return apperr.InvalidField("name", "LOCATION_NAME_TOO_LONG", "Location name must not exceed 100 characters")
 	}
 	return nil
 }
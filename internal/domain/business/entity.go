@@ -27,6 +27,7 @@ type Business struct {
 	Phone           string       `db:"phone" json:"phone"`
 	BusinessType    BusinessType `db:"business_type" json:"business_type"`
 	IsActive        bool         `db:"is_active" json:"is_active"`
+	Version         int          `db:"version" json:"version"`
 	CreatedAt       time.Time    `db:"created_at" json:"created_at"`
 	UpdatedAt       time.Time    `db:"updated_at" json:"updated_at"`
 }
@@ -42,6 +43,7 @@ func NewBusiness(name, industry, serviceCategory, phone string, businessType Bus
 		Phone:           phone,
 		BusinessType:    businessType,
 		IsActive:        true,
+		Version:         1,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
@@ -59,20 +61,58 @@ type UpdateBusinessRequest struct {
 	Name     string `json:"name"`
 	Industry string `json:"industry"`
 	Phone    string `json:"phone"`
+	// Version - If-Match ilə gələn gözlənilən versiya; 0 yoxlamanı söndürür (If-Match: *)
+	Version int `json:"-"`
 }
 
-type BusinessError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+type OwnerRole string
+
+const (
+	OwnerRolePrimary OwnerRole = "owner"
+	OwnerRoleCoOwner OwnerRole = "co_owner"
+)
+
+type BusinessOwner struct {
+	BusinessID uuid.UUID `db:"business_id" json:"business_id"`
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	Role       OwnerRole `db:"role" json:"role"`
+	FullName   string    `db:"full_name" json:"full_name"`
+	Email      string    `db:"email" json:"email"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
 }
 
-func (e *BusinessError) Error() string {
-	return e.Message
+type TransferStatus string
+
+const (
+	TransferStatusPending   TransferStatus = "pending"
+	TransferStatusAccepted  TransferStatus = "accepted"
+	TransferStatusCancelled TransferStatus = "cancelled"
+)
+
+type OwnershipTransfer struct {
+	ID         uuid.UUID      `db:"id" json:"id"`
+	BusinessID uuid.UUID      `db:"business_id" json:"business_id"`
+	FromUserID uuid.UUID      `db:"from_user_id" json:"from_user_id"`
+	ToUserID   uuid.UUID      `db:"to_user_id" json:"to_user_id"`
+	Token      string         `db:"token" json:"-"`
+	Status     TransferStatus `db:"status" json:"status"`
+	ExpiresAt  time.Time      `db:"expires_at" json:"expires_at"`
+	CreatedAt  time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time      `db:"updated_at" json:"updated_at"`
 }
 
-func NewBusinessError(code, message string) *BusinessError {
-	return &BusinessError{
-		Code:    code,
-		Message: message,
-	}
+// StaffMember - sahiblik üçün namizəd olan aktiv işçi
+type StaffMember struct {
+	UserID   uuid.UUID `db:"user_id" json:"user_id"`
+	Email    string    `db:"email" json:"email"`
+	FullName string    `db:"full_name" json:"full_name"`
+	Locale   string    `db:"locale" json:"-"`
+}
+
+type InitiateTransferRequest struct {
+	ToUserID uuid.UUID `json:"to_user_id"`
+}
+
+type AddCoOwnerRequest struct {
+	UserID uuid.UUID `json:"user_id"`
 }
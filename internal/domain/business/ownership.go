@@ -0,0 +1,436 @@
+// File: internal/domain/business/ownership.go
+package business
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+const ownershipTransferTTL = 72 * time.Hour
+
+// ownershipTransferredEvent - business.ownership_transferred hadisəsinin payload-u
+type ownershipTransferredEvent struct {
+	BusinessID uuid.UUID `json:"business_id"`
+	FromUserID uuid.UUID `json:"from_user_id"`
+	ToUserID   uuid.UUID `json:"to_user_id"`
+}
+
+// ListOwners - sahiblərin status filtri yoxdur, default sıralama qoşulma tarixinə görədir (əsas sahib birinci)
+func (service *BusinessService) ListOwners(ctx context.Context, businessID uuid.UUID, query listing.Query) (*listing.Page[*BusinessOwner], error) {
+	ctx, span := tracer.Start(ctx, "business.ListOwners")
+	defer span.End()
+
+	if businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_BUSINESS_ID", "Business ID cannot be empty")
+	}
+	if err := query.Normalize(listing.SortCreatedAt, listing.OrderAsc); err != nil {
+		return nil, err
+	}
+
+	owners, err := service.repository.ListOwners(ctx, businessID, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list owners: %w", err)
+	}
+
+	return listing.Paginate(owners, query, func(owner *BusinessOwner) listing.Key {
+		return listing.Key{ID: owner.UserID, Name: owner.FullName, CreatedAt: owner.CreatedAt}
+	}), nil
+}
+
+// IsOwner - istifadəçi business_owners-dədirsə (əsas sahib və ya co-owner) true.
+// JWT-dəki rol təhvildən sonra köhnələ bilər, ona görə icazə buradan yoxlanır.
+func (service *BusinessService) IsOwner(ctx context.Context, businessID, userID uuid.UUID) (bool, error) {
+	if businessID == uuid.Nil || userID == uuid.Nil {
+		return false, nil
+	}
+
+	owner, err := service.repository.GetOwner(ctx, businessID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get owner: %w", err)
+	}
+	return owner != nil, nil
+}
+
+// AddCoOwner - Sahib aktiv işçini co-owner edir
+func (service *BusinessService) AddCoOwner(
+	ctx context.Context,
+	businessID, ownerID uuid.UUID,
+	request *AddCoOwnerRequest,
+) error {
+	ctx, span := tracer.Start(ctx, "business.AddCoOwner")
+	defer span.End()
+
+	if request == nil || request.UserID == uuid.Nil {
+		return apperr.Validation("INVALID_USER_ID", "User ID cannot be empty")
+	}
+
+	if _, err := service.requirePrimaryOwner(ctx, businessID, ownerID); err != nil {
+		return err
+	}
+
+	existing, err := service.repository.GetOwner(ctx, businessID, request.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get owner: %w", err)
+	}
+	if existing != nil {
+		return apperr.Conflict("ALREADY_OWNER", "User is already an owner of this business")
+	}
+
+	if _, err := service.requireActiveStaff(ctx, businessID, request.UserID); err != nil {
+		return err
+	}
+
+	err = service.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := service.repository.AddCoOwner(ctx, businessID, request.UserID); err != nil {
+			return fmt.Errorf("failed to add co-owner: %w", err)
+		}
+		return service.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityBusinessOwner,
+			EntityID:   request.UserID,
+			Action:     audit.ActionAdded,
+			Changes:    audit.Field("role", nil, OwnerRoleCoOwner),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	service.logger.WithContext(ctx).Info("Co-owner added",
+		logger.Field{Key: "business_id", Value: businessID.String()},
+		logger.Field{Key: "co_owner_id", Value: request.UserID.String()},
+	)
+	return nil
+}
+
+// RemoveCoOwner - Primary owner silinə bilməz, yalnız co-owner
+func (service *BusinessService) RemoveCoOwner(ctx context.Context, businessID, ownerID, userID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "business.RemoveCoOwner")
+	defer span.End()
+
+	if userID == uuid.Nil {
+		return apperr.Validation("INVALID_USER_ID", "User ID cannot be empty")
+	}
+
+	if _, err := service.requirePrimaryOwner(ctx, businessID, ownerID); err != nil {
+		return err
+	}
+
+	existing, err := service.repository.GetOwner(ctx, businessID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get owner: %w", err)
+	}
+	if existing == nil {
+		return apperr.NotFound("OWNER_NOT_FOUND", "Co-owner not found")
+	}
+	if existing.Role == OwnerRolePrimary {
+		return apperr.Validation("CANNOT_REMOVE_PRIMARY_OWNER", "Primary owner cannot be removed, transfer ownership first")
+	}
+
+	err = service.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := service.repository.RemoveCoOwner(ctx, businessID, userID); err != nil {
+			return fmt.Errorf("failed to remove co-owner: %w", err)
+		}
+		return service.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityBusinessOwner,
+			EntityID:   userID,
+			Action:     audit.ActionRemoved,
+			Changes:    audit.Field("role", existing.Role, nil),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	service.logger.WithContext(ctx).Info("Co-owner removed",
+		logger.Field{Key: "business_id", Value: businessID.String()},
+		logger.Field{Key: "co_owner_id", Value: userID.String()},
+	)
+	return nil
+}
+
+// InitiateOwnershipTransfer - Sahib biznesi aktiv işçiyə təhvil vermək istəyir, alıcıya email ilə token göndərilir
+func (service *BusinessService) InitiateOwnershipTransfer(
+	ctx context.Context,
+	businessID, ownerID uuid.UUID,
+	request *InitiateTransferRequest,
+) (*OwnershipTransfer, error) {
+	ctx, span := tracer.Start(ctx, "business.InitiateOwnershipTransfer")
+	defer span.End()
+
+	if request == nil || request.ToUserID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_USER_ID", "Recipient user ID cannot be empty")
+	}
+	if request.ToUserID == ownerID {
+		return nil, apperr.Validation("TRANSFER_TO_SELF", "Ownership cannot be transferred to yourself")
+	}
+
+	if _, err := service.requirePrimaryOwner(ctx, businessID, ownerID); err != nil {
+		return nil, err
+	}
+
+	recipient, err := service.requireActiveStaff(ctx, businessID, request.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := service.repository.GetPendingOwnershipTransfer(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending transfer: %w", err)
+	}
+	if pending != nil {
+		if time.Now().Before(pending.ExpiresAt) {
+			return nil, apperr.Conflict("TRANSFER_ALREADY_PENDING", "Another ownership transfer is already pending")
+		}
+		if err := service.repository.CancelOwnershipTransfer(ctx, pending.ID, businessID); err != nil {
+			return nil, fmt.Errorf("failed to cancel expired transfer: %w", err)
+		}
+	}
+
+	plainToken, err := generateSecureRandomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("transfer token generation failed: %w", err)
+	}
+
+	now := time.Now()
+	transfer := &OwnershipTransfer{
+		ID:         uuid.New(),
+		BusinessID: businessID,
+		FromUserID: ownerID,
+		ToUserID:   recipient.UserID,
+		Token:      hashToken(plainToken),
+		Status:     TransferStatusPending,
+		ExpiresAt:  now.Add(ownershipTransferTTL),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	// Bildiriş növbəyə qoyula bilməsə təhvil də yaradılmır
+	confirmURL := fmt.Sprintf("%s/confirm-ownership?token=%s", service.frontendURL, plainToken)
+	err = service.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := service.repository.CreateOwnershipTransfer(ctx, transfer); err != nil {
+			return fmt.Errorf("failed to create ownership transfer: %w", err)
+		}
+		if err := service.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityOwnershipTransfer,
+			EntityID:   transfer.ID,
+			Action:     audit.ActionInitiated,
+			Changes: audit.Changes{
+				"from_user_id": {After: transfer.FromUserID},
+				"to_user_id":   {After: transfer.ToUserID},
+				"expires_at":   {After: transfer.ExpiresAt},
+			},
+		}); err != nil {
+			return err
+		}
+		return service.notifier.Notify(ctx, notification.Message{
+			Template: notification.TemplateOwnershipTransfer,
+			UserID:   &recipient.UserID,
+			Email:    recipient.Email,
+			Locale:   i18n.Preferred(ctx, recipient.Locale),
+			Data:     map[string]string{"url": confirmURL},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	service.logger.WithContext(ctx).Info("Ownership transfer initiated",
+		logger.Field{Key: "business_id", Value: businessID.String()},
+		logger.Field{Key: "transfer_id", Value: transfer.ID.String()},
+	)
+	return transfer, nil
+}
+
+// ConfirmOwnershipTransfer - Alıcı emaildəki token ilə təhvili təsdiqləyir
+func (service *BusinessService) ConfirmOwnershipTransfer(ctx context.Context, userID uuid.UUID, token string) error {
+	ctx, span := tracer.Start(ctx, "business.ConfirmOwnershipTransfer")
+	defer span.End()
+
+	if userID == uuid.Nil {
+		return apperr.Validation("INVALID_USER_ID", "User ID cannot be empty")
+	}
+	if token == "" {
+		return apperr.Validation("INVALID_TOKEN", "Transfer token is required")
+	}
+
+	transfer, err := service.repository.GetOwnershipTransferByToken(tenant.Unscoped(ctx), hashToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to get ownership transfer: %w", err)
+	}
+	if transfer == nil {
+		return apperr.Validation("INVALID_TOKEN", "Invalid transfer token")
+	}
+	if transfer.Status != TransferStatusPending {
+		return apperr.Conflict("TRANSFER_NOT_PENDING", "Ownership transfer is no longer pending")
+	}
+	if time.Now().After(transfer.ExpiresAt) {
+		return apperr.Validation("TOKEN_EXPIRED", "Transfer token has expired")
+	}
+	if transfer.ToUserID != userID {
+		return apperr.Forbidden("TRANSFER_RECIPIENT_MISMATCH", "This transfer was issued to another user")
+	}
+	ctx = tenant.WithBusinessID(ctx, transfer.BusinessID)
+
+	business, err := service.repository.GetByID(ctx, transfer.BusinessID)
+	if err != nil {
+		return fmt.Errorf("failed to get business: %w", err)
+	}
+	if business == nil {
+		return apperr.NotFound("BUSINESS_NOT_FOUND", "Business not found")
+	}
+	if business.OwnerID != transfer.FromUserID {
+		return apperr.Conflict("TRANSFER_STALE", "Business owner has changed since the transfer was initiated")
+	}
+
+	if _, err := service.requireActiveStaff(ctx, transfer.BusinessID, userID); err != nil {
+		return err
+	}
+
+	err = service.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := service.repository.CompleteOwnershipTransfer(ctx, transfer); err != nil {
+			return fmt.Errorf("failed to complete ownership transfer: %w", err)
+		}
+		if err := service.audit.Record(ctx, audit.Entry{
+			BusinessID: transfer.BusinessID,
+			EntityType: audit.EntityOwnershipTransfer,
+			EntityID:   transfer.ID,
+			Action:     audit.ActionConfirmed,
+			Changes:    audit.Field("status", TransferStatusPending, TransferStatusAccepted),
+		}); err != nil {
+			return err
+		}
+		if err := service.audit.Record(ctx, audit.Entry{
+			BusinessID: transfer.BusinessID,
+			EntityType: audit.EntityBusiness,
+			EntityID:   transfer.BusinessID,
+			Action:     audit.ActionUpdated,
+			Changes:    audit.Field("owner_id", transfer.FromUserID, transfer.ToUserID),
+		}); err != nil {
+			return err
+		}
+		if err := service.audit.Record(ctx, audit.Entry{
+			BusinessID: transfer.BusinessID,
+			EntityType: audit.EntityBusinessOwner,
+			EntityID:   transfer.FromUserID,
+			Action:     audit.ActionRemoved,
+			Changes:    audit.Field("role", OwnerRolePrimary, nil),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, service.events, events.BusinessOwnershipTransferred, transfer.BusinessID, transfer.BusinessID,
+			ownershipTransferredEvent{BusinessID: transfer.BusinessID, FromUserID: transfer.FromUserID, ToUserID: transfer.ToUserID})
+	})
+	if err != nil {
+		return err
+	}
+
+	service.logger.WithContext(ctx).Info("Ownership transfer completed",
+		logger.Field{Key: "business_id", Value: transfer.BusinessID.String()},
+		logger.Field{Key: "transfer_id", Value: transfer.ID.String()},
+	)
+	return nil
+}
+
+func (service *BusinessService) CancelOwnershipTransfer(ctx context.Context, businessID, ownerID, transferID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "business.CancelOwnershipTransfer")
+	defer span.End()
+
+	if transferID == uuid.Nil {
+		return apperr.Validation("INVALID_TRANSFER_ID", "Transfer ID cannot be empty")
+	}
+
+	if _, err := service.requirePrimaryOwner(ctx, businessID, ownerID); err != nil {
+		return err
+	}
+
+	pending, err := service.repository.GetPendingOwnershipTransfer(ctx, businessID)
+	if err != nil {
+		return fmt.Errorf("failed to get pending transfer: %w", err)
+	}
+	if pending == nil || pending.ID != transferID {
+		return apperr.NotFound("TRANSFER_NOT_FOUND", "Pending ownership transfer not found")
+	}
+
+	err = service.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := service.repository.CancelOwnershipTransfer(ctx, transferID, businessID); err != nil {
+			return fmt.Errorf("failed to cancel ownership transfer: %w", err)
+		}
+		return service.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityOwnershipTransfer,
+			EntityID:   transferID,
+			Action:     audit.ActionCancelled,
+			Changes:    audit.Field("status", TransferStatusPending, TransferStatusCancelled),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	service.logger.WithContext(ctx).Info("Ownership transfer cancelled",
+		logger.Field{Key: "business_id", Value: businessID.String()},
+		logger.Field{Key: "transfer_id", Value: transferID.String()},
+	)
+	return nil
+}
+
+func (service *BusinessService) requirePrimaryOwner(ctx context.Context, businessID, userID uuid.UUID) (*Business, error) {
+	if businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_BUSINESS_ID", "Business ID cannot be empty")
+	}
+
+	business, err := service.repository.GetByID(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get business: %w", err)
+	}
+	if business == nil {
+		return nil, apperr.NotFound("BUSINESS_NOT_FOUND", "Business not found")
+	}
+	if business.OwnerID != userID {
+		return nil, apperr.Forbidden("NOT_BUSINESS_OWNER", "Only the business owner can perform this action")
+	}
+
+	return business, nil
+}
+
+func (service *BusinessService) requireActiveStaff(ctx context.Context, businessID, userID uuid.UUID) (*StaffMember, error) {
+	member, err := service.staffDirectory.GetActiveStaffMember(ctx, businessID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff member: %w", err)
+	}
+	if member == nil {
+		return nil, apperr.Validation("RECIPIENT_NOT_ACTIVE_STAFF", "User must be an active staff member of this business")
+	}
+	return member, nil
+}
+
+func generateSecureRandomToken(length int) (string, error) {
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+func hashToken(token string) string {
+	h := sha256.New()
+	h.Write([]byte(token))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
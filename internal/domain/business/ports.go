@@ -5,6 +5,8 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 )
 
 type Repository interface {
@@ -13,11 +15,35 @@ type Repository interface {
 	GetByOwnerID(ctx context.Context, ownerID uuid.UUID) (*Business, error)
 	Update(ctx context.Context, business *Business) error
 	UpdateOwner(ctx context.Context, businessID, ownerID uuid.UUID) error
+
+	ListOwners(ctx context.Context, businessID uuid.UUID, query listing.Query) ([]*BusinessOwner, error)
+	GetOwner(ctx context.Context, businessID, userID uuid.UUID) (*BusinessOwner, error)
+	AddCoOwner(ctx context.Context, businessID, userID uuid.UUID) error
+	RemoveCoOwner(ctx context.Context, businessID, userID uuid.UUID) error
+
+	CreateOwnershipTransfer(ctx context.Context, transfer *OwnershipTransfer) error
+	GetOwnershipTransferByToken(ctx context.Context, token string) (*OwnershipTransfer, error)
+	GetPendingOwnershipTransfer(ctx context.Context, businessID uuid.UUID) (*OwnershipTransfer, error)
+	CancelOwnershipTransfer(ctx context.Context, id, businessID uuid.UUID) error
+	CompleteOwnershipTransfer(ctx context.Context, transfer *OwnershipTransfer) error
+}
+
+type StaffDirectory interface {
+	GetActiveStaffMember(ctx context.Context, businessID, userID uuid.UUID) (*StaffMember, error)
 }
 
 type Service interface {
 	CreateBusiness(ctx context.Context, ownerID uuid.UUID, request *CreateBusinessRequest) (*Business, error)
 	GetBusinessByID(ctx context.Context, id uuid.UUID) (*Business, error)
 	GetBusinessByOwner(ctx context.Context, ownerID uuid.UUID) (*Business, error)
-	UpdateBusiness(ctx context.Context, businessID uuid.UUID, request *UpdateBusinessRequest) error
+	UpdateBusiness(ctx context.Context, businessID uuid.UUID, request *UpdateBusinessRequest) (*Business, error)
+
+	ListOwners(ctx context.Context, businessID uuid.UUID, query listing.Query) (*listing.Page[*BusinessOwner], error)
+	IsOwner(ctx context.Context, businessID, userID uuid.UUID) (bool, error)
+	AddCoOwner(ctx context.Context, businessID, ownerID uuid.UUID, request *AddCoOwnerRequest) error
+	RemoveCoOwner(ctx context.Context, businessID, ownerID, userID uuid.UUID) error
+
+	InitiateOwnershipTransfer(ctx context.Context, businessID, ownerID uuid.UUID, request *InitiateTransferRequest) (*OwnershipTransfer, error)
+	ConfirmOwnershipTransfer(ctx context.Context, userID uuid.UUID, token string) error
+	CancelOwnershipTransfer(ctx context.Context, businessID, ownerID, transferID uuid.UUID) error
 }
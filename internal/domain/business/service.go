@@ -4,18 +4,52 @@ package business
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/transaction"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/business")
+
 type BusinessService struct {
-	repository Repository
+	repository     Repository
+	txManager      transaction.Manager
+	staffDirectory StaffDirectory
+	notifier       notification.Notifier
+	frontendURL    string
+	logger         logger.Logger
+	audit          audit.Recorder
+	events         events.Publisher
 }
 
-func NewService(repository Repository) *BusinessService {
+func NewService(
+	repository Repository,
+	txManager transaction.Manager,
+	staffDirectory StaffDirectory,
+	notifier notification.Notifier,
+	frontendURL string,
+	appLogger logger.Logger,
+	auditRecorder audit.Recorder,
+	publisher events.Publisher,
+) *BusinessService {
 	return &BusinessService{
-		repository: repository,
+		repository:     repository,
+		txManager:      txManager,
+		staffDirectory: staffDirectory,
+		notifier:       notifier,
+		frontendURL:    strings.TrimRight(frontendURL, "/"),
+		logger:         appLogger,
+		audit:          auditRecorder,
+		events:         publisher,
 	}
 }
 
@@ -24,12 +58,15 @@ func (service *BusinessService) CreateBusiness(
 	ownerID uuid.UUID,
 	request *CreateBusinessRequest,
 ) (*Business, error) {
+	ctx, span := tracer.Start(ctx, "business.CreateBusiness")
+	defer span.End()
+
 	if ownerID == uuid.Nil {
-		return nil, NewBusinessError("INVALID_OWNER_ID", "Owner ID cannot be empty")
+		return nil, apperr.Validation("INVALID_OWNER_ID", "Owner ID cannot be empty")
 	}
 
 	if request == nil {
-		return nil, NewBusinessError("INVALID_REQUEST", "Request cannot be nil")
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	if err := service.validateCreateRequest(request); err != nil {
@@ -47,17 +84,41 @@ func (service *BusinessService) CreateBusiness(
 	if err := service.validateBusiness(business); err != nil {
 		return nil, err
 	}
-
-	if err := service.repository.Create(ctx, business); err != nil {
-		return nil, fmt.Errorf("failed to create business: %w", err)
+	// Yeni biznesin sətirləri yalnız onun tenant-ı altında yazıla bilər (row-level security)
+	ctx = tenant.WithBusinessID(ctx, business.ID)
+
+	err := service.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := service.repository.Create(ctx, business); err != nil {
+			return fmt.Errorf("failed to create business: %w", err)
+		}
+		if err := service.audit.Record(ctx, audit.Entry{
+			BusinessID: business.ID,
+			EntityType: audit.EntityBusiness,
+			EntityID:   business.ID,
+			Action:     audit.ActionCreated,
+			Changes:    audit.Diff(nil, business),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, service.events, events.BusinessCreated, business.ID, business.ID, business)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	service.logger.WithContext(ctx).Info("Business created",
+		logger.Field{Key: "business_id", Value: business.ID.String()},
+		logger.Field{Key: "business_type", Value: string(business.BusinessType)},
+	)
 	return business, nil
 }
 
 func (service *BusinessService) GetBusinessByID(ctx context.Context, id uuid.UUID) (*Business, error) {
+	ctx, span := tracer.Start(ctx, "business.GetBusinessByID")
+	defer span.End()
+
 	if id == uuid.Nil {
-		return nil, NewBusinessError("INVALID_BUSINESS_ID", "Business ID cannot be empty")
+		return nil, apperr.Validation("INVALID_BUSINESS_ID", "Business ID cannot be empty")
 	}
 
 	business, err := service.repository.GetByID(ctx, id)
@@ -66,15 +127,18 @@ func (service *BusinessService) GetBusinessByID(ctx context.Context, id uuid.UUI
 	}
 
 	if business == nil {
-		return nil, NewBusinessError("BUSINESS_NOT_FOUND", "Business not found")
+		return nil, apperr.NotFound("BUSINESS_NOT_FOUND", "Business not found")
 	}
 
 	return business, nil
 }
 
 func (service *BusinessService) GetBusinessByOwner(ctx context.Context, ownerID uuid.UUID) (*Business, error) {
+	ctx, span := tracer.Start(ctx, "business.GetBusinessByOwner")
+	defer span.End()
+
 	if ownerID == uuid.Nil {
-		return nil, NewBusinessError("INVALID_OWNER_ID", "Owner ID cannot be empty")
+		return nil, apperr.Validation("INVALID_OWNER_ID", "Owner ID cannot be empty")
 	}
 
 	business, err := service.repository.GetByOwnerID(ctx, ownerID)
@@ -83,7 +147,7 @@ func (service *BusinessService) GetBusinessByOwner(ctx context.Context, ownerID
 	}
 
 	if business == nil {
-		return nil, NewBusinessError("BUSINESS_NOT_FOUND", "No business found for this owner")
+		return nil, apperr.NotFound("BUSINESS_NOT_FOUND", "No business found for this owner")
 	}
 
 	return business, nil
@@ -93,36 +157,60 @@ func (service *BusinessService) UpdateBusiness(
 	ctx context.Context,
 	businessID uuid.UUID,
 	request *UpdateBusinessRequest,
-) error {
+) (*Business, error) {
+	ctx, span := tracer.Start(ctx, "business.UpdateBusiness")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return NewBusinessError("INVALID_BUSINESS_ID", "Business ID cannot be empty")
+		return nil, apperr.Validation("INVALID_BUSINESS_ID", "Business ID cannot be empty")
 	}
 
 	if request == nil {
-		return NewBusinessError("INVALID_REQUEST", "Request cannot be nil")
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	business, err := service.repository.GetByID(ctx, businessID)
 	if err != nil {
-		return fmt.Errorf("failed to get business: %w", err)
+		return nil, fmt.Errorf("failed to get business: %w", err)
 	}
 
 	if business == nil {
-		return NewBusinessError("BUSINESS_NOT_FOUND", "Business not found")
+		return nil, apperr.NotFound("BUSINESS_NOT_FOUND", "Business not found")
+	}
+
+	if request.Version != 0 && request.Version != business.Version {
+		return nil, apperr.ErrVersionMismatch
 	}
 
+	before := *business
 	business.Name = request.Name
 	business.Industry = request.Industry
 	business.Phone = request.Phone
 	business.UpdatedAt = time.Now()
 
 	if err := service.validateBusiness(business); err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := service.repository.Update(ctx, business); err != nil {
-		return fmt.Errorf("failed to update business: %w", err)
+	err = service.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := service.repository.Update(ctx, business); err != nil {
+			return fmt.Errorf("failed to update business: %w", err)
+		}
+		if err := service.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityBusiness,
+			EntityID:   businessID,
+			Action:     audit.ActionUpdated,
+			Changes:    audit.Diff(&before, business),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, service.events, events.BusinessUpdated, businessID, businessID, business)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	service.logger.WithContext(ctx).Info("Business updated", logger.Field{Key: "business_id", Value: businessID.String()})
+	return business, nil
 }
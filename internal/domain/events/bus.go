@@ -0,0 +1,62 @@
+// File: internal/domain/events/bus.go
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Handler - in-process abunəçi. Xəta qaytarsa hadisə sonra yenidən çatdırılır (bütün abunəçilərə),
+// ona görə handler eyni Event.ID-ni təkrar almağa hazır olmalıdır.
+type Handler func(ctx context.Context, event *Event) error
+
+type subscription struct {
+	name    string
+	types   map[Type]bool
+	handler Handler
+}
+
+// Bus - relay-in oxuduğu hadisələri abunəçilərə paylayır
+type Bus struct {
+	mu            sync.RWMutex
+	subscriptions []subscription
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe - types boşdursa abunəçi bütün hadisələri alır
+func (b *Bus) Subscribe(name string, handler Handler, types ...Type) {
+	sub := subscription{name: name, handler: handler}
+	if len(types) > 0 {
+		sub.types = make(map[Type]bool, len(types))
+		for _, t := range types {
+			sub.types[t] = true
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions = append(b.subscriptions, sub)
+}
+
+// Dispatch - hadisəni uyğun abunəçilərə ardıcıl ötürür; bir abunəçinin xətası digərlərini dayandırmır
+func (b *Bus) Dispatch(ctx context.Context, event *Event) error {
+	b.mu.RLock()
+	subscriptions := b.subscriptions
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, sub := range subscriptions {
+		if sub.types != nil && !sub.types[event.Type] {
+			continue
+		}
+		if err := sub.handler(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
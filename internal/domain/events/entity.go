@@ -0,0 +1,124 @@
+// File: internal/domain/events/entity.go
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type - domen hadisəsinin adı; webhook və bildiriş abunələri bu adlarla aparılır
+type Type string
+
+const (
+	BusinessCreated              Type = "business.created"
+	BusinessUpdated              Type = "business.updated"
+	BusinessOwnershipTransferred Type = "business.ownership_transferred"
+	LocationCreated              Type = "location.created"
+	LocationUpdated              Type = "location.updated"
+	LocationDeactivated          Type = "location.deactivated"
+	ServiceCreated               Type = "service.created"
+	ServiceUpdated               Type = "service.updated"
+	ServiceDeactivated           Type = "service.deactivated"
+	StaffCreated                 Type = "staff.created"
+	StaffUpdated                 Type = "staff.updated"
+	StaffDeactivated             Type = "staff.deactivated"
+	StaffInvited                 Type = "staff.invited"
+	StaffInviteRevoked           Type = "staff.invite_revoked"
+	StaffInviteAccepted          Type = "staff.invite_accepted"
+)
+
+// Types - bütün hadisələr; abunə siyahısı bu dəyərlərlə yoxlanılır
+var Types = []Type{
+	BusinessCreated,
+	BusinessUpdated,
+	BusinessOwnershipTransferred,
+	LocationCreated,
+	LocationUpdated,
+	LocationDeactivated,
+	ServiceCreated,
+	ServiceUpdated,
+	ServiceDeactivated,
+	StaffCreated,
+	StaffUpdated,
+	StaffDeactivated,
+	StaffInvited,
+	StaffInviteRevoked,
+	StaffInviteAccepted,
+}
+
+func (t Type) Valid() bool {
+	for _, candidate := range Types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// MaxAttempts - bu qədər uğursuz çatdırılmadan sonra hadisə outbox-da qalır, amma yenidən göndərilmir
+	MaxAttempts = 10
+	baseBackoff = 10 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// Event - dəyişiklikdən sonra abunəçilərə çatdırılan hadisə. Payload dəyişmiş resursun JSON təsviridir.
+// Çatdırılma ən azı bir dəfədir: abunəçilər eyni ID-li hadisəni təkrar ala bilər.
+type Event struct {
+	ID           uuid.UUID
+	Type         Type
+	BusinessID   uuid.UUID
+	AggregateID  uuid.UUID
+	Payload      json.RawMessage
+	OccurredAt   time.Time
+	TraceContext map[string]string
+	Attempts     int
+}
+
+// Ref - deaktivasiya kimi yalnız resursun ID-si ilə ifadə olunan hadisələrin payload-u
+type Ref struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func New(eventType Type, businessID, aggregateID uuid.UUID, payload interface{}) (*Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", eventType, err)
+	}
+	return &Event{
+		ID:          uuid.New(),
+		Type:        eventType,
+		BusinessID:  businessID,
+		AggregateID: aggregateID,
+		Payload:     data,
+		OccurredAt:  time.Now().UTC(),
+	}, nil
+}
+
+// Emit - hadisəni yaradıb outbox-a yazır. Vəziyyət dəyişikliyi ilə eyni tranzaksiyada çağırılmalıdır.
+func Emit(ctx context.Context, publisher Publisher, eventType Type, businessID, aggregateID uuid.UUID, payload interface{}) error {
+	event, err := New(eventType, businessID, aggregateID, payload)
+	if err != nil {
+		return err
+	}
+	if err := publisher.Publish(ctx, event); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", eventType, err)
+	}
+	return nil
+}
+
+// Backoff - attempts uğursuz cəhddən sonra növbəti cəhdə qədər gözləmə (10s, 20s, 40s ... 1 saat)
+func Backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+	return delay
+}
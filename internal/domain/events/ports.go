@@ -0,0 +1,34 @@
+// File: internal/domain/events/ports.go
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Publisher - domen servisləri hadisələri bu port vasitəsilə yazır. Postgres implementasiyası
+// ctx-dəki tranzaksiyaya qoşulur, hadisə vəziyyət dəyişikliyi ilə birlikdə commit olunur.
+type Publisher interface {
+	Publish(ctx context.Context, events ...*Event) error
+}
+
+// Outbox - worker relay-inin istifadə etdiyi növbə
+type Outbox interface {
+	// Claim - vaxtı çatmış hadisələri lease müddətinə götürür ki, başqa worker eyni hadisəni paralel göndərməsin
+	Claim(ctx context.Context, limit int, lease time.Duration, now time.Time) ([]*Event, error)
+	MarkPublished(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error
+	Pending(ctx context.Context) (int, error)
+	DeletePublished(ctx context.Context, before time.Time) (int, error)
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, ...*Event) error { return nil }
+
+// Nop - hadisələr tələb olunmayan yerlərdə
+func Nop() Publisher {
+	return nopPublisher{}
+}
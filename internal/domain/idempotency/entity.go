@@ -0,0 +1,58 @@
+// File: internal/domain/idempotency/entity.go
+package idempotency
+
+import (
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	// TTL - saxlanmış cavab bu müddət ərzində eyni açarla təkrar göndərilən sorğuya qaytarılır
+	TTL = 24 * time.Hour
+	// LockTimeout - cavabı saxlanmamış (proses çökmüş) açar bu müddətdən sonra yenidən istifadə oluna bilər
+	LockTimeout  = time.Minute
+	MaxKeyLength = 255
+)
+
+var (
+	ErrInvalidKey = apperr.InvalidField("Idempotency-Key", "INVALID_IDEMPOTENCY_KEY",
+		"Idempotency-Key must be 1-255 printable ASCII characters")
+	ErrKeyReused = apperr.Validation("IDEMPOTENCY_KEY_REUSED",
+		"Idempotency-Key was already used with a different request")
+	ErrInProgress = apperr.Conflict("IDEMPOTENCY_IN_PROGRESS",
+		"A request with this Idempotency-Key is still being processed")
+)
+
+// Record - istifadəçinin açarla göndərdiyi sorğu. Response nil-dirsə sorğu hələ icra olunur.
+type Record struct {
+	UserID      uuid.UUID
+	Key         string
+	Method      string
+	Path        string
+	Fingerprint string
+	Response    *Response
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// Response - təkrar sorğuya olduğu kimi qaytarılan cavab
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers"`
+	Body       []byte            `json:"body"`
+}
+
+// ValidKey - açar boş olmamalı, 255 simvoldan uzun olmamalı və yalnız görünən ASCII simvollardan ibarət olmalıdır
+func ValidKey(key string) bool {
+	if key == "" || len(key) > MaxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
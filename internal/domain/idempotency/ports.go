@@ -0,0 +1,19 @@
+// File: internal/domain/idempotency/ports.go
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Acquire - açarı sorğu üçün rezerv edir. Açar artıq mövcuddursa (və vaxtı keçməyibsə)
+	// acquired=false və mövcud qeyd qaytarılır.
+	Acquire(ctx context.Context, record *Record) (existing *Record, acquired bool, err error)
+	Complete(ctx context.Context, userID uuid.UUID, key string, response *Response) error
+	// Release - cavab saxlanmadıqda (5xx, panic) açarı buraxır ki, klient yenidən cəhd edə bilsin
+	Release(ctx context.Context, userID uuid.UUID, key string) error
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,193 @@
+// File: internal/domain/listing/query.go
+package listing
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// SortField - siyahının sıralana biləcəyi sahə
+type SortField string
+
+const (
+	SortCreatedAt SortField = "created_at"
+	SortName      SortField = "name"
+)
+
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// StatusAll - status filtrini söndürür; boş status isə resursun default filtridir
+const StatusAll = "all"
+
+// Query - bütün siyahı endpoint-ləri üçün ortaq pagination/filter/sort modeli.
+// Cursor verilibsə Offset nəzərə alınmır (ikisi birlikdə göndərilə bilməz).
+type Query struct {
+	Limit  int
+	Offset int
+	Cursor *Cursor
+	Status string
+	Search string
+	Sort   SortField
+	Order  Order
+}
+
+// Cursor - son qaytarılan sətrin sort dəyəri və ID-si (keyset pagination)
+type Cursor struct {
+	Sort  SortField `json:"s"`
+	Order Order     `json:"o"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// Key - cursor yaratmaq üçün elementin sort sahələri
+type Key struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+// Page - bir səhifə nəticə və növbəti səhifə üçün cursor
+type Page[T any] struct {
+	Items      []T
+	Limit      int
+	Offset     int
+	NextCursor string
+	HasMore    bool
+}
+
+func (c *Cursor) Encode() string {
+	payload, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, invalidCursor()
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, invalidCursor()
+	}
+	return &cursor, nil
+}
+
+// Normalize - default-ları tətbiq edir və resursun icazə verdiyi status-ları yoxlayır
+func (q *Query) Normalize(defaultSort SortField, defaultOrder Order, statuses ...string) error {
+	var details []*apperr.Error
+
+	switch {
+	case q.Limit == 0:
+		q.Limit = DefaultLimit
+	case q.Limit < 0 || q.Limit > MaxLimit:
+		details = append(details, apperr.InvalidField("limit", "INVALID_LIMIT", "limit must be between 1 and 100"))
+	}
+	if q.Offset < 0 {
+		details = append(details, apperr.InvalidField("offset", "INVALID_OFFSET", "offset cannot be negative"))
+	}
+
+	if q.Sort == "" {
+		q.Sort = defaultSort
+	}
+	if q.Sort != SortCreatedAt && q.Sort != SortName {
+		details = append(details, apperr.InvalidField("sort", "INVALID_SORT", "sort must be one of: created_at, name"))
+	}
+	switch {
+	case q.Order != "":
+	case q.Sort == defaultSort:
+		q.Order = defaultOrder
+	case q.Sort == SortName:
+		q.Order = OrderAsc
+	default:
+		q.Order = OrderDesc
+	}
+	if q.Order != OrderAsc && q.Order != OrderDesc {
+		details = append(details, apperr.InvalidField("order", "INVALID_ORDER", "order must be one of: asc, desc"))
+	}
+
+	q.Status = strings.ToLower(strings.TrimSpace(q.Status))
+	if q.Status != "" && !allowed(q.Status, statuses) {
+		details = append(details, apperr.InvalidField("status", "INVALID_STATUS",
+			"status must be one of: "+strings.Join(append(statuses, StatusAll), ", ")))
+	}
+	q.Search = strings.TrimSpace(q.Search)
+
+	if q.Cursor != nil {
+		if q.Offset > 0 {
+			details = append(details, apperr.InvalidField("cursor", "CURSOR_WITH_OFFSET", "cursor and offset cannot be combined"))
+		} else if q.Cursor.Sort != q.Sort || q.Cursor.Order != q.Order || !q.validCursorValue() {
+			details = append(details, invalidCursor())
+		}
+	}
+
+	if len(details) == 1 {
+		return details[0]
+	}
+	if len(details) > 1 {
+		return apperr.InvalidFields(details...)
+	}
+	return nil
+}
+
+// Paginate - repository limit+1 sətir qaytarır; artıq sətir növbəti səhifənin olduğunu göstərir
+func Paginate[T any](items []T, q Query, key func(T) Key) *Page[T] {
+	page := &Page[T]{Items: items, Limit: q.Limit, Offset: q.Offset}
+	if len(items) <= q.Limit {
+		if page.Items == nil {
+			page.Items = []T{}
+		}
+		return page
+	}
+
+	page.Items = items[:q.Limit]
+	page.HasMore = true
+
+	last := key(page.Items[len(page.Items)-1])
+	cursor := &Cursor{Sort: q.Sort, Order: q.Order, ID: last.ID}
+	if q.Sort == SortName {
+		cursor.Value = last.Name
+	} else {
+		cursor.Value = last.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	page.NextCursor = cursor.Encode()
+	return page
+}
+
+func (q *Query) validCursorValue() bool {
+	if q.Sort != SortCreatedAt {
+		return true
+	}
+	_, err := time.Parse(time.RFC3339Nano, q.Cursor.Value)
+	return err == nil
+}
+
+func allowed(status string, statuses []string) bool {
+	if status == StatusAll {
+		return true
+	}
+	for _, candidate := range statuses {
+		if candidate == status {
+			return true
+		}
+	}
+	return false
+}
+
+func invalidCursor() *apperr.Error {
+	return apperr.InvalidField("cursor", "INVALID_CURSOR", "cursor is invalid or does not match the requested sort")
+}
@@ -7,6 +7,12 @@ import (
 	"github.com/google/uuid"
 )
 
+// Siyahı filtrləri üçün status dəyərləri (is_active sütununa uyğun)
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+)
+
 type Location struct {
 	ID         uuid.UUID `db:"id" json:"id"`
 	BusinessID uuid.UUID `db:"business_id" json:"business_id"`
@@ -15,6 +21,7 @@ type Location struct {
 	City       *string   `db:"city" json:"city,omitempty"`
 	Phone      *string   `db:"phone" json:"phone,omitempty"`
 	IsActive   bool      `db:"is_active" json:"is_active"`
+	Version    int       `db:"version" json:"version"`
 	CreatedAt  time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
 }
@@ -26,6 +33,7 @@ func NewLocation(businessID uuid.UUID, name string) *Location {
 		BusinessID: businessID,
 		Name:       name,
 		IsActive:   true,
+		Version:    1,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
@@ -43,13 +51,6 @@ type UpdateLocationRequest struct {
 	Address *string `json:"address,omitempty"`
 	City    *string `json:"city,omitempty"`
 	Phone   *string `json:"phone,omitempty"`
-}
-
-type LocationError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
-
-func (e *LocationError) Error() string {
-	return e.Message
+	// Version - If-Match ilə gələn gözlənilən versiya; 0 yoxlamanı söndürür (If-Match: *)
+	Version int `json:"-"`
 }
@@ -5,12 +5,14 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 )
 
 type Repository interface {
 	Create(ctx context.Context, location *Location) error
 	GetByID(ctx context.Context, id, businessID uuid.UUID) (*Location, error)
-	ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*Location, error)
+	ListByBusiness(ctx context.Context, businessID uuid.UUID, query listing.Query) ([]*Location, error)
 	Update(ctx context.Context, location *Location) error
 	Deactivate(ctx context.Context, id, businessID uuid.UUID) error
 }
@@ -19,7 +21,7 @@ type Service interface {
 	CreateLocation(ctx context.Context, businessID uuid.UUID, req *CreateLocationRequest) (*Location, error)
 	CreateDefaultLocation(ctx context.Context, businessID uuid.UUID) (*Location, error)
 	GetLocation(ctx context.Context, id, businessID uuid.UUID) (*Location, error)
-	ListLocations(ctx context.Context, businessID uuid.UUID) ([]*Location, error)
-	UpdateLocation(ctx context.Context, id, businessID uuid.UUID, req *UpdateLocationRequest) error
+	ListLocations(ctx context.Context, businessID uuid.UUID, query listing.Query) (*listing.Page[*Location], error)
+	UpdateLocation(ctx context.Context, id, businessID uuid.UUID, req *UpdateLocationRequest) (*Location, error)
 	DeactivateLocation(ctx context.Context, id, businessID uuid.UUID) error
 }
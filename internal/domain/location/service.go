@@ -6,15 +6,40 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/transaction"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/location")
+
 type LocationService struct {
-	repo Repository
+	repo      Repository
+	txManager transaction.Manager
+	logger    logger.Logger
+	audit     audit.Recorder
+	events    events.Publisher
 }
 
-func NewService(repo Repository) *LocationService {
-	return &LocationService{repo: repo}
+func NewService(
+	repo Repository,
+	txManager transaction.Manager,
+	appLogger logger.Logger,
+	auditRecorder audit.Recorder,
+	publisher events.Publisher,
+) *LocationService {
+	return &LocationService{
+		repo:      repo,
+		txManager: txManager,
+		logger:    appLogger,
+		audit:     auditRecorder,
+		events:    publisher,
+	}
 }
 
 func (s *LocationService) CreateLocation(
@@ -22,11 +47,14 @@ func (s *LocationService) CreateLocation(
 	businessID uuid.UUID,
 	req *CreateLocationRequest,
 ) (*Location, error) {
+	ctx, span := tracer.Start(ctx, "location.CreateLocation")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &LocationError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 	if req == nil {
-		return nil, &LocationError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	if err := s.validateCreateRequest(req); err != nil {
@@ -42,10 +70,29 @@ func (s *LocationService) CreateLocation(
 		return nil, err
 	}
 
-	if err := s.repo.Create(ctx, location); err != nil {
-		return nil, fmt.Errorf("failed to create location: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, location); err != nil {
+			return fmt.Errorf("failed to create location: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityLocation,
+			EntityID:   location.ID,
+			Action:     audit.ActionCreated,
+			Changes:    audit.Diff(nil, location),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.LocationCreated, businessID, location.ID, location)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	s.logger.WithContext(ctx).Info("Location created",
+		logger.Field{Key: "location_id", Value: location.ID.String()},
+		logger.Field{Key: "business_id", Value: businessID.String()},
+	)
 	return location, nil
 }
 
@@ -53,16 +100,38 @@ func (s *LocationService) CreateDefaultLocation(
 	ctx context.Context,
 	businessID uuid.UUID,
 ) (*Location, error) {
+	ctx, span := tracer.Start(ctx, "location.CreateDefaultLocation")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &LocationError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 
 	location := NewLocation(businessID, "Default Location")
 
-	if err := s.repo.Create(ctx, location); err != nil {
-		return nil, fmt.Errorf("failed to create default location: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, location); err != nil {
+			return fmt.Errorf("failed to create default location: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityLocation,
+			EntityID:   location.ID,
+			Action:     audit.ActionCreated,
+			Changes:    audit.Diff(nil, location),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.LocationCreated, businessID, location.ID, location)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	s.logger.WithContext(ctx).Info("Default location created",
+		logger.Field{Key: "location_id", Value: location.ID.String()},
+		logger.Field{Key: "business_id", Value: businessID.String()},
+	)
 	return location, nil
 }
 
@@ -70,8 +139,11 @@ func (s *LocationService) GetLocation(
 	ctx context.Context,
 	id, businessID uuid.UUID,
 ) (*Location, error) {
+	ctx, span := tracer.Start(ctx, "location.GetLocation")
+	defer span.End()
+
 	if id == uuid.Nil || businessID == uuid.Nil {
-		return nil, &LocationError{Code: "INVALID_ID", Message: "Location ID and Business ID are required"}
+		return nil, apperr.Validation("INVALID_ID", "Location ID and Business ID are required")
 	}
 
 	location, err := s.repo.GetByID(ctx, id, businessID)
@@ -79,7 +151,7 @@ func (s *LocationService) GetLocation(
 		return nil, fmt.Errorf("failed to get location: %w", err)
 	}
 	if location == nil {
-		return nil, &LocationError{Code: "NOT_FOUND", Message: "Location not found"}
+		return nil, apperr.NotFound("NOT_FOUND", "Location not found")
 	}
 
 	return location, nil
@@ -88,36 +160,55 @@ func (s *LocationService) GetLocation(
 func (s *LocationService) ListLocations(
 	ctx context.Context,
 	businessID uuid.UUID,
-) ([]*Location, error) {
+	query listing.Query,
+) (*listing.Page[*Location], error) {
+	ctx, span := tracer.Start(ctx, "location.ListLocations")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &LocationError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
+	}
+	if err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc, StatusActive, StatusInactive); err != nil {
+		return nil, err
 	}
 
-	locations, err := s.repo.ListByBusiness(ctx, businessID)
+	locations, err := s.repo.ListByBusiness(ctx, businessID, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list locations: %w", err)
 	}
 
-	return locations, nil
+	return listing.Paginate(locations, query, locationKey), nil
+}
+
+func locationKey(location *Location) listing.Key {
+	return listing.Key{ID: location.ID, Name: location.Name, CreatedAt: location.CreatedAt}
 }
 
 func (s *LocationService) UpdateLocation(
 	ctx context.Context,
 	id, businessID uuid.UUID,
 	req *UpdateLocationRequest,
-) error {
+) (*Location, error) {
+	ctx, span := tracer.Start(ctx, "location.UpdateLocation")
+	defer span.End()
+
 	if id == uuid.Nil || businessID == uuid.Nil {
-		return &LocationError{Code: "INVALID_ID", Message: "Location ID and Business ID are required"}
+		return nil, apperr.Validation("INVALID_ID", "Location ID and Business ID are required")
 	}
 
 	location, err := s.repo.GetByID(ctx, id, businessID)
 	if err != nil {
-		return fmt.Errorf("failed to get location: %w", err)
+		return nil, fmt.Errorf("failed to get location: %w", err)
 	}
 	if location == nil {
-		return &LocationError{Code: "NOT_FOUND", Message: "Location not found"}
+		return nil, apperr.NotFound("NOT_FOUND", "Location not found")
 	}
 
+	if req.Version != 0 && req.Version != location.Version {
+		return nil, apperr.ErrVersionMismatch
+	}
+
+	before := *location
 	location.Name = req.Name
 	location.Address = req.Address
 	location.City = req.City
@@ -125,27 +216,62 @@ func (s *LocationService) UpdateLocation(
 	location.UpdatedAt = time.Now()
 
 	if err := s.validateLocation(location); err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := s.repo.Update(ctx, location); err != nil {
-		return fmt.Errorf("failed to update location: %w", err)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, location); err != nil {
+			return fmt.Errorf("failed to update location: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityLocation,
+			EntityID:   id,
+			Action:     audit.ActionUpdated,
+			Changes:    audit.Diff(&before, location),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.LocationUpdated, businessID, id, location)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	s.logger.WithContext(ctx).Info("Location updated", logger.Field{Key: "location_id", Value: id.String()})
+	return location, nil
 }
 
 func (s *LocationService) DeactivateLocation(
 	ctx context.Context,
 	id, businessID uuid.UUID,
 ) error {
+	ctx, span := tracer.Start(ctx, "location.DeactivateLocation")
+	defer span.End()
+
 	if id == uuid.Nil || businessID == uuid.Nil {
-		return &LocationError{Code: "INVALID_ID", Message: "Location ID and Business ID are required"}
+		return apperr.Validation("INVALID_ID", "Location ID and Business ID are required")
 	}
 
-	if err := s.repo.Deactivate(ctx, id, businessID); err != nil {
-		return fmt.Errorf("failed to deactivate location: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Deactivate(ctx, id, businessID); err != nil {
+			return fmt.Errorf("failed to deactivate location: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityLocation,
+			EntityID:   id,
+			Action:     audit.ActionDeactivated,
+			Changes:    audit.Field("is_active", true, false),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.LocationDeactivated, businessID, id, events.Ref{ID: id})
+	})
+	if err != nil {
+		return err
 	}
 
+	s.logger.WithContext(ctx).Info("Location deactivated", logger.Field{Key: "location_id", Value: id.String()})
 	return nil
 }
@@ -4,11 +4,13 @@ package location
 import (
 	"regexp"
 	"strings"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 )
 
 func (s *LocationService) validateLocation(loc *Location) error {
 	if loc == nil {
-		return &LocationError{Code: "INVALID_DATA", Message: "Location data cannot be nil"}
+		return apperr.Validation("INVALID_DATA", "Location data cannot be nil")
 	}
 
 	if err := s.validateLocationName(loc.Name); err != nil {
@@ -26,7 +28,7 @@ func (s *LocationService) validateLocation(loc *Location) error {
 
 func (s *LocationService) validateCreateRequest(req *CreateLocationRequest) error {
 	if req == nil {
-		return &LocationError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	if err := s.validateLocationName(req.Name); err != nil {
@@ -45,13 +47,13 @@ func (s *LocationService) validateCreateRequest(req *CreateLocationRequest) erro
 func (s *LocationService) validateLocationName(name string) error {
 	clean := strings.TrimSpace(name)
 	if clean == "" {
-		return &LocationError{Code: "NAME_REQUIRED", Message: "Location name is required"}
+		return apperr.InvalidField("name", "NAME_REQUIRED", "Location name is required")
 	}
 	if len(clean) < 2 {
-		return &LocationError{Code: "NAME_TOO_SHORT", Message: "Location name must be at least 2 characters"}
+		return apperr.InvalidField("name", "NAME_TOO_SHORT", "Location name must be at least 2 characters")
 	}
 	if len(clean) > 100 {
-		return &LocationError{Code: "NAME_TOO_LONG", Message: "Location name cannot exceed 100 characters"}
+		return apperr.InvalidField("name", "NAME_TOO_LONG", "Location name cannot exceed 100 characters")
 	}
 	return nil
 }
@@ -63,7 +65,7 @@ func (s *LocationService) validatePhone(phone string) error {
 	}
 	phoneRegex := regexp.MustCompile(`^\+?[0-9]{7,15}$`)
 	if !phoneRegex.MatchString(clean) {
-		return &LocationError{Code: "PHONE_INVALID", Message: "Invalid phone format"}
+		return apperr.InvalidField("phone", "PHONE_INVALID", "Invalid phone format")
 	}
 	return nil
 }
@@ -0,0 +1,34 @@
+// File: internal/domain/metrics/ports.go
+package metrics
+
+// Event - biznes səviyyəli hadisə (dashboard-larda sayğac kimi görünür)
+type Event string
+
+const (
+	EventUserRegistered      Event = "user_registered"
+	EventBusinessOnboarded   Event = "business_onboarded"
+	EventStaffInviteSent     Event = "staff_invite_sent"
+	EventStaffInviteAccepted Event = "staff_invite_accepted"
+)
+
+// Events - bütün hadisələr; sayğaclar başlanğıcda 0 ilə yaradılır
+var Events = []Event{
+	EventUserRegistered,
+	EventBusinessOnboarded,
+	EventStaffInviteSent,
+	EventStaffInviteAccepted,
+}
+
+// Recorder - domen servisləri metrikləri bu port vasitəsilə yazır, Prometheus-dan xəbərsizdir
+type Recorder interface {
+	Record(event Event)
+}
+
+type nopRecorder struct{}
+
+func (nopRecorder) Record(Event) {}
+
+// Nop - metriklər söndürüldükdə və ya worker kimi istifadə olunmayan yerlərdə
+func Nop() Recorder {
+	return nopRecorder{}
+}
@@ -0,0 +1,236 @@
+// File: internal/domain/notification/entity.go
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// Runtime image-də (alpine) zoneinfo yoxdur - sakit saatların vaxt zonası binary-yə daxil edilir
+	_ "time/tzdata"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAttempts - bu qədər uğursuz cəhddən sonra bildiriş failed olur
+	MaxAttempts = 5
+	// DefaultTimezone - istifadəçi vaxt zonası seçməyibsə sakit saatlar bu zonada hesablanır
+	DefaultTimezone = "Asia/Baku"
+	baseBackoff     = time.Minute
+	maxBackoff      = time.Hour
+	clockLayout     = "15:04"
+)
+
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelSMS   Channel = "sms"
+)
+
+// Template - adlandırılmış şablon; mətnlər infrastructure/notification/templates altında locale üzrə saxlanır
+type Template string
+
+const (
+	TemplatePasswordReset     Template = "password_reset"
+	TemplateOwnershipTransfer Template = "ownership_transfer"
+	TemplateStaffInvite       Template = "staff_invite"
+)
+
+// Definition - şablonun göndərilə biləcəyi kanallar və seçimlərə münasibəti.
+// Mandatory bildiriş kanal söndürülsə də göndərilir, Urgent bildiriş sakit saatları gözləmir.
+type Definition struct {
+	Channels  []Channel
+	Mandatory bool
+	Urgent    bool
+}
+
+// definitions - təhlükəsizlik linkləri (şifrə, sahiblik) istifadəçi seçimindən asılı deyil.
+// Dəvət olunanın çox vaxt hesabı olmur, ona görə seçimlər yalnız UserID verildikdə tətbiq olunur.
+var definitions = map[Template]Definition{
+	TemplatePasswordReset:     {Channels: []Channel{ChannelEmail}, Mandatory: true, Urgent: true},
+	TemplateOwnershipTransfer: {Channels: []Channel{ChannelEmail}, Mandatory: true},
+	TemplateStaffInvite:       {Channels: []Channel{ChannelEmail, ChannelSMS}},
+}
+
+// Templates - renderer başlanğıcda bütün şablonları yoxlayır
+func Templates() map[Template]Definition {
+	result := make(map[Template]Definition, len(definitions))
+	for name, def := range definitions {
+		result[name] = def
+	}
+	return result
+}
+
+type Status string
+
+const (
+	StatusPending    Status = "pending"
+	StatusSent       Status = "sent"
+	StatusFailed     Status = "failed"
+	StatusSuppressed Status = "suppressed"
+)
+
+// Message - domen servislərinin göndərmək istədiyi bildiriş. Email/Phone olan hər kanal üçün ayrıca
+// Notification yaradılır. Data şablon dəyişənləridir (məs. "url").
+type Message struct {
+	Template Template
+	UserID   *uuid.UUID
+	Email    string
+	Phone    string
+	Locale   i18n.Locale
+	Data     map[string]string
+}
+
+func (m Message) recipient(channel Channel) string {
+	switch channel {
+	case ChannelEmail:
+		return strings.TrimSpace(m.Email)
+	case ChannelSMS:
+		return strings.TrimSpace(m.Phone)
+	}
+	return ""
+}
+
+// Notification - bildiriş jurnalının sətri. Recipient və Data şifrəli saxlanır,
+// Data (tokenli linklər) göndərildikdən və ya imtina edildikdən sonra silinir.
+type Notification struct {
+	ID          uuid.UUID         `json:"id"`
+	UserID      *uuid.UUID        `json:"user_id,omitempty"`
+	Template    Template          `json:"template"`
+	Channel     Channel           `json:"channel"`
+	Locale      i18n.Locale       `json:"locale"`
+	Recipient   string            `json:"-"`
+	Data        map[string]string `json:"-"`
+	Status      Status            `json:"status"`
+	Attempts    int               `json:"attempts"`
+	ScheduledAt time.Time         `json:"scheduled_at"`
+	LastError   string            `json:"last_error,omitempty"`
+	SentAt      *time.Time        `json:"sent_at,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// Content - render olunmuş bildiriş; SMS üçün yalnız Text doldurulur
+type Content struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Preferences - istifadəçinin kanal seçimləri və sakit saatları ("HH:MM", boş - söndürülüb).
+// Sakit saatlar gecəyarısını keçə bilər (22:00-08:00).
+type Preferences struct {
+	UserID          uuid.UUID `json:"user_id"`
+	EmailEnabled    bool      `json:"email_enabled"`
+	SMSEnabled      bool      `json:"sms_enabled"`
+	QuietHoursStart string    `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string    `json:"quiet_hours_end,omitempty"`
+	Timezone        string    `json:"timezone"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func DefaultPreferences(userID uuid.UUID) *Preferences {
+	return &Preferences{
+		UserID:       userID,
+		EmailEnabled: true,
+		SMSEnabled:   true,
+		Timezone:     DefaultTimezone,
+	}
+}
+
+func (p *Preferences) Allows(channel Channel) bool {
+	switch channel {
+	case ChannelEmail:
+		return p.EmailEnabled
+	case ChannelSMS:
+		return p.SMSEnabled
+	}
+	return false
+}
+
+// NextAllowed - now sakit saatlara düşürsə onların bitmə anını, əks halda now-u qaytarır
+func (p *Preferences) NextAllowed(now time.Time) time.Time {
+	if p.QuietHoursStart == "" || p.QuietHoursEnd == "" {
+		return now
+	}
+	start, errStart := time.Parse(clockLayout, p.QuietHoursStart)
+	end, errEnd := time.Parse(clockLayout, p.QuietHoursEnd)
+	location, errLocation := time.LoadLocation(p.Timezone)
+	if errStart != nil || errEnd != nil || errLocation != nil {
+		return now
+	}
+
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	endAt := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, location)
+
+	switch {
+	case startMinute < endMinute && minute >= startMinute && minute < endMinute:
+		return endAt.UTC()
+	case startMinute > endMinute && minute >= startMinute:
+		return endAt.AddDate(0, 0, 1).UTC()
+	case startMinute > endMinute && minute < endMinute:
+		return endAt.UTC()
+	}
+	return now
+}
+
+// UpdatePreferencesRequest - nil sahələr cari dəyəri saxlayır; sakit saatları söndürmək üçün
+// hər ikisi boş sətir göndərilir
+type UpdatePreferencesRequest struct {
+	EmailEnabled    *bool   `json:"email_enabled,omitempty"`
+	SMSEnabled      *bool   `json:"sms_enabled,omitempty"`
+	QuietHoursStart *string `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   *string `json:"quiet_hours_end,omitempty"`
+	Timezone        *string `json:"timezone,omitempty"`
+}
+
+// Backoff - attempts uğursuz cəhddən sonra gözləmə (1m, 2m, 4m ... 1 saat)
+func Backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+	return delay
+}
+
+func validatePreferences(p *Preferences) error {
+	var details []*apperr.Error
+
+	if _, err := time.LoadLocation(p.Timezone); err != nil || p.Timezone == "" || p.Timezone == "Local" {
+		details = append(details, apperr.InvalidField("timezone", "INVALID_TIMEZONE", "timezone must be an IANA name like Asia/Baku"))
+	}
+
+	if (p.QuietHoursStart == "") != (p.QuietHoursEnd == "") {
+		details = append(details, apperr.InvalidField("quiet_hours", "INVALID_QUIET_HOURS", "quiet_hours_start and quiet_hours_end must be set together"))
+	} else if p.QuietHoursStart != "" {
+		start, errStart := time.Parse(clockLayout, p.QuietHoursStart)
+		end, errEnd := time.Parse(clockLayout, p.QuietHoursEnd)
+		switch {
+		case errStart != nil || errEnd != nil:
+			details = append(details, apperr.InvalidField("quiet_hours", "INVALID_QUIET_HOURS", "quiet hours must use HH:MM format"))
+		case start.Equal(end):
+			details = append(details, apperr.InvalidField("quiet_hours", "INVALID_QUIET_HOURS", "quiet hours start and end must differ"))
+		}
+	}
+
+	if len(details) == 1 {
+		return details[0]
+	}
+	if len(details) > 1 {
+		return apperr.InvalidFields(details...)
+	}
+	return nil
+}
+
+func unknownTemplate(name Template) error {
+	return fmt.Errorf("unknown notification template %q", name)
+}
@@ -0,0 +1,118 @@
+package notification
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextAllowed(t *testing.T) {
+	cases := []struct {
+		name       string
+		start, end string
+		timezone   string
+		now        time.Time
+		want       time.Time
+	}{
+		{
+			name:     "no quiet hours",
+			timezone: "Asia/Baku",
+			now:      time.Date(2026, 5, 10, 20, 0, 0, 0, time.UTC),
+			want:     time.Date(2026, 5, 10, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "only start set",
+			start: "22:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 10, 20, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 5, 10, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "unknown timezone",
+			start: "22:00", end: "08:00", timezone: "Mars/Olympus",
+			now:  time.Date(2026, 5, 10, 20, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 5, 10, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "same-day window, inside",
+			start: "09:00", end: "17:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 10, 6, 0, 0, 0, time.UTC), // 10:00 Bakı
+			want: time.Date(2026, 5, 10, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "same-day window, outside",
+			start: "09:00", end: "17:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 10, 14, 0, 0, 0, time.UTC), // 18:00 Bakı
+			want: time.Date(2026, 5, 10, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "wraps midnight, before midnight",
+			start: "22:00", end: "08:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 10, 19, 0, 0, 0, time.UTC), // 23:00 Bakı
+			want: time.Date(2026, 5, 11, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "wraps midnight, after midnight",
+			start: "22:00", end: "08:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 10, 22, 0, 0, 0, time.UTC), // 02:00 Bakı, 11 may
+			want: time.Date(2026, 5, 11, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "wraps midnight, at start",
+			start: "22:00", end: "08:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 10, 18, 0, 0, 0, time.UTC), // 22:00 Bakı
+			want: time.Date(2026, 5, 11, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "wraps midnight, at end",
+			start: "22:00", end: "08:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 11, 4, 0, 0, 0, time.UTC), // 08:00 Bakı
+			want: time.Date(2026, 5, 11, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "wraps midnight, outside",
+			start: "22:00", end: "08:00", timezone: "Asia/Baku",
+			now:  time.Date(2026, 5, 10, 8, 0, 0, 0, time.UTC), // 12:00 Bakı
+			want: time.Date(2026, 5, 10, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			// 29 mart 02:00 CET → 03:00 CEST: gecə +01:00-da başlayır, səhər +02:00-da bitir
+			name:  "spring DST change inside the window",
+			start: "22:00", end: "08:00", timezone: "Europe/Berlin",
+			now:  time.Date(2026, 3, 28, 22, 0, 0, 0, time.UTC), // 23:00 CET
+			want: time.Date(2026, 3, 29, 6, 0, 0, 0, time.UTC),  // 08:00 CEST
+		},
+		{
+			// 25 oktyabr 03:00 CEST → 02:00 CET
+			name:  "autumn DST change inside the window",
+			start: "22:00", end: "08:00", timezone: "Europe/Berlin",
+			now:  time.Date(2026, 10, 24, 21, 0, 0, 0, time.UTC), // 23:00 CEST
+			want: time.Date(2026, 10, 25, 7, 0, 0, 0, time.UTC),  // 08:00 CET
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			preferences := &Preferences{QuietHoursStart: tc.start, QuietHoursEnd: tc.end, Timezone: tc.timezone}
+			if got := preferences.NextAllowed(tc.now); !got.Equal(tc.want) {
+				t.Errorf("NextAllowed(%v) = %v, want %v", tc.now, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		0:  time.Minute,
+		1:  time.Minute,
+		2:  2 * time.Minute,
+		3:  4 * time.Minute,
+		6:  32 * time.Minute,
+		7:  time.Hour,
+		8:  time.Hour,
+		50: time.Hour,
+	}
+	for attempts, want := range cases {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
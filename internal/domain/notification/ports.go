@@ -0,0 +1,47 @@
+// File: internal/domain/notification/ports.go
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, notification *Notification) error
+	// ClaimDue - vaxtı çatmış pending bildirişləri lease müddətinə götürür (SKIP LOCKED)
+	ClaimDue(ctx context.Context, limit int, lease time.Duration, now time.Time) ([]*Notification, error)
+	SaveAttempt(ctx context.Context, notification *Notification) error
+	PendingNotifications(ctx context.Context) (int, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, query listing.Query) ([]*Notification, error)
+
+	// GetPreferences - seçimlər saxlanmayıbsa nil qaytarır
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*Preferences, error)
+	SavePreferences(ctx context.Context, preferences *Preferences) error
+}
+
+// Renderer - şablonu kanal və locale üçün render edir (infrastructure/notification)
+type Renderer interface {
+	Render(name Template, channel Channel, locale i18n.Locale, data map[string]string) (*Content, error)
+}
+
+// Sender - kanal adapteri (SMTP, SMS provayderi)
+type Sender interface {
+	Channel() Channel
+	Send(ctx context.Context, recipient string, content *Content) error
+}
+
+// Notifier - digər domenlərin istifadə etdiyi port. Bildiriş çağıranın tranzaksiyasında növbəyə
+// qoyulur və worker tərəfindən göndərilir.
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+type Service interface {
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*Preferences, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, req *UpdatePreferencesRequest) (*Preferences, error)
+	ListNotifications(ctx context.Context, userID uuid.UUID, query listing.Query) (*listing.Page[*Notification], error)
+}
@@ -0,0 +1,303 @@
+// File: internal/domain/notification/service.go
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/notification")
+
+// NotificationService - API-də yalnız növbəyə qoyur (senders boş ola bilər), worker DeliverDue ilə göndərir
+type NotificationService struct {
+	repo     Repository
+	renderer Renderer
+	senders  map[Channel]Sender
+	logger   logger.Logger
+}
+
+func NewService(
+	repo Repository,
+	renderer Renderer,
+	appLogger logger.Logger,
+	senders ...Sender,
+) *NotificationService {
+	byChannel := make(map[Channel]Sender, len(senders))
+	for _, sender := range senders {
+		byChannel[sender.Channel()] = sender
+	}
+	return &NotificationService{
+		repo:     repo,
+		renderer: renderer,
+		senders:  byChannel,
+		logger:   appLogger,
+	}
+}
+
+// Notify - ünvanı olan hər kanal üçün jurnala sətir yazır. Söndürülmüş kanal suppressed kimi qeyd olunur,
+// sakit saatlarda təcili olmayan bildiriş onların sonuna planlanır.
+func (s *NotificationService) Notify(ctx context.Context, message Message) error {
+	ctx, span := tracer.Start(ctx, "notification.Notify")
+	defer span.End()
+	span.SetAttributes(attribute.String("notification.template", string(message.Template)))
+
+	def, ok := definitions[message.Template]
+	if !ok {
+		return unknownTemplate(message.Template)
+	}
+
+	preferences, err := s.preferences(ctx, message.UserID)
+	if err != nil {
+		return err
+	}
+
+	locale := message.Locale
+	if locale == "" {
+		locale = i18n.Default
+	}
+
+	now := time.Now().UTC()
+	for _, channel := range def.Channels {
+		recipient := message.recipient(channel)
+		if recipient == "" {
+			continue
+		}
+
+		notification := &Notification{
+			ID:          uuid.New(),
+			UserID:      message.UserID,
+			Template:    message.Template,
+			Channel:     channel,
+			Locale:      locale,
+			Recipient:   recipient,
+			Data:        message.Data,
+			Status:      StatusPending,
+			ScheduledAt: now,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		switch {
+		case !def.Mandatory && !preferences.Allows(channel):
+			notification.Status = StatusSuppressed
+			notification.Data = nil
+		case !def.Urgent:
+			notification.ScheduledAt = preferences.NextAllowed(now)
+		}
+
+		if err := s.repo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to queue %s notification: %w", channel, err)
+		}
+	}
+	return nil
+}
+
+// preferences - hesabı olmayan alıcı üçün standart seçimlər tətbiq olunur
+func (s *NotificationService) preferences(ctx context.Context, userID *uuid.UUID) (*Preferences, error) {
+	if userID == nil {
+		return DefaultPreferences(uuid.Nil), nil
+	}
+	preferences, err := s.repo.GetPreferences(ctx, *userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	if preferences == nil {
+		return DefaultPreferences(*userID), nil
+	}
+	return preferences, nil
+}
+
+func (s *NotificationService) GetPreferences(ctx context.Context, userID uuid.UUID) (*Preferences, error) {
+	ctx, span := tracer.Start(ctx, "notification.GetPreferences")
+	defer span.End()
+
+	if userID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_USER_ID", "User ID cannot be empty")
+	}
+	return s.preferences(ctx, &userID)
+}
+
+func (s *NotificationService) UpdatePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	req *UpdatePreferencesRequest,
+) (*Preferences, error) {
+	ctx, span := tracer.Start(ctx, "notification.UpdatePreferences")
+	defer span.End()
+
+	if userID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_USER_ID", "User ID cannot be empty")
+	}
+	if req == nil {
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
+	}
+
+	preferences, err := s.preferences(ctx, &userID)
+	if err != nil {
+		return nil, err
+	}
+	if req.EmailEnabled != nil {
+		preferences.EmailEnabled = *req.EmailEnabled
+	}
+	if req.SMSEnabled != nil {
+		preferences.SMSEnabled = *req.SMSEnabled
+	}
+	if req.QuietHoursStart != nil {
+		preferences.QuietHoursStart = strings.TrimSpace(*req.QuietHoursStart)
+	}
+	if req.QuietHoursEnd != nil {
+		preferences.QuietHoursEnd = strings.TrimSpace(*req.QuietHoursEnd)
+	}
+	if req.Timezone != nil {
+		preferences.Timezone = strings.TrimSpace(*req.Timezone)
+	}
+	if err := validatePreferences(preferences); err != nil {
+		return nil, err
+	}
+	preferences.QuietHoursStart = normalizeClock(preferences.QuietHoursStart)
+	preferences.QuietHoursEnd = normalizeClock(preferences.QuietHoursEnd)
+	preferences.UpdatedAt = time.Now().UTC()
+
+	if err := s.repo.SavePreferences(ctx, preferences); err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return preferences, nil
+}
+
+// ListNotifications - istifadəçinin öz bildiriş jurnalı (ünvan və məzmun qaytarılmır)
+func (s *NotificationService) ListNotifications(
+	ctx context.Context,
+	userID uuid.UUID,
+	query listing.Query,
+) (*listing.Page[*Notification], error) {
+	ctx, span := tracer.Start(ctx, "notification.ListNotifications")
+	defer span.End()
+
+	if userID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_USER_ID", "User ID cannot be empty")
+	}
+	if query.Sort == listing.SortName {
+		return nil, apperr.InvalidField("sort", "INVALID_NOTIFICATION_SORT", "sort must be created_at")
+	}
+	if err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc,
+		string(StatusPending), string(StatusSent), string(StatusFailed), string(StatusSuppressed)); err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.repo.ListByUser(ctx, userID, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return listing.Paginate(notifications, query, notificationKey), nil
+}
+
+func notificationKey(notification *Notification) listing.Key {
+	return listing.Key{ID: notification.ID, CreatedAt: notification.CreatedAt}
+}
+
+// DeliverDue - vaxtı çatmış bildirişləri göndərir, götürülənlərin sayını qaytarır
+func (s *NotificationService) DeliverDue(ctx context.Context, limit int, lease time.Duration) (int, error) {
+	notifications, err := s.repo.ClaimDue(ctx, limit, lease, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim notifications: %w", err)
+	}
+	for _, notification := range notifications {
+		if ctx.Err() != nil {
+			break
+		}
+		s.deliver(ctx, notification)
+	}
+	return len(notifications), nil
+}
+
+func (s *NotificationService) PendingNotifications(ctx context.Context) (int, error) {
+	return s.repo.PendingNotifications(ctx)
+}
+
+// deliver - render edib kanal adapterinə verir. Render xətası və adapterin olmaması
+// təkrar cəhdlə düzəlmir, bildiriş dərhal failed olur.
+func (s *NotificationService) deliver(ctx context.Context, notification *Notification) {
+	ctx, span := tracer.Start(ctx, "notification.Deliver")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("notification.id", notification.ID.String()),
+		attribute.String("notification.template", string(notification.Template)),
+		attribute.String("notification.channel", string(notification.Channel)),
+		attribute.Int("notification.attempt", notification.Attempts+1),
+	)
+
+	// Adapterlər də bu sahələrlə log yazır - alıcı və mətn loga düşmür
+	ctx = logger.ContextWithFields(ctx,
+		logger.Field{Key: "notification_id", Value: notification.ID.String()},
+		logger.Field{Key: "template", Value: string(notification.Template)},
+		logger.Field{Key: "channel", Value: string(notification.Channel)},
+	)
+
+	now := time.Now().UTC()
+	notification.Attempts++
+	notification.LastError = ""
+	notification.UpdatedAt = now
+
+	fields := []logger.Field{
+		{Key: "attempt", Value: notification.Attempts},
+	}
+
+	var content *Content
+	var err error
+	permanent := true
+	sender, ok := s.senders[notification.Channel]
+	if !ok {
+		err = fmt.Errorf("no sender configured for channel %s", notification.Channel)
+	} else if content, err = s.renderer.Render(notification.Template, notification.Channel, notification.Locale, notification.Data); err == nil {
+		permanent = false
+		err = sender.Send(ctx, notification.Recipient, content)
+	}
+
+	switch {
+	case err == nil:
+		notification.Status = StatusSent
+		notification.SentAt = &now
+		notification.Data = nil
+	default:
+		notification.LastError = err.Error()
+		span.SetStatus(codes.Error, notification.LastError)
+		fields = append(fields, logger.Field{Key: "error", Value: notification.LastError})
+		if permanent || notification.Attempts >= MaxAttempts {
+			notification.Status = StatusFailed
+			notification.Data = nil
+			s.logger.WithContext(ctx).Error("Notification delivery abandoned", fields...)
+		} else {
+			notification.ScheduledAt = now.Add(Backoff(notification.Attempts))
+			s.logger.WithContext(ctx).Warn("Notification delivery failed, will retry", fields...)
+		}
+	}
+
+	if err := s.repo.SaveAttempt(ctx, notification); err != nil {
+		// Lease bitdikdən sonra bildiriş yenidən göndəriləcək
+		s.logger.WithContext(ctx).Error("Failed to save notification attempt",
+			logger.Field{Key: "error", Value: err.Error()},
+		)
+	}
+}
+
+// normalizeClock - "8:00" kimi dəyərləri "08:00" formatında saxlayır
+func normalizeClock(value string) string {
+	if value == "" {
+		return ""
+	}
+	parsed, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return value
+	}
+	return parsed.Format(clockLayout)
+}
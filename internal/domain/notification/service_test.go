@@ -0,0 +1,167 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/config"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+// attemptRepository - yalnız SaveAttempt çağırılır (embed olunmuş nil interfeys qalanlarında panic edər)
+type attemptRepository struct {
+	Repository
+	saved []Notification
+}
+
+func (r *attemptRepository) SaveAttempt(ctx context.Context, notification *Notification) error {
+	r.saved = append(r.saved, *notification)
+	return nil
+}
+
+type stubRenderer struct {
+	err error
+}
+
+func (r stubRenderer) Render(name Template, channel Channel, locale i18n.Locale, data map[string]string) (*Content, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &Content{Subject: "subject", Text: "text " + data["url"]}, nil
+}
+
+type stubSender struct {
+	channel Channel
+	err     error
+	sent    []*Content
+}
+
+func (s *stubSender) Channel() Channel { return s.channel }
+
+func (s *stubSender) Send(ctx context.Context, recipient string, content *Content) error {
+	s.sent = append(s.sent, content)
+	return s.err
+}
+
+func TestDeliver(t *testing.T) {
+	appLogger, err := logger.New(&config.AppConfig{LogLevel: "error"})
+	if err != nil {
+		t.Fatalf("logger: %v", err)
+	}
+	sendErr := errors.New("smtp: connection refused")
+
+	cases := []struct {
+		name        string
+		channel     Channel
+		attempts    int
+		renderErr   error
+		sendErr     error
+		wantStatus  Status
+		wantSends   int
+		wantRetry   bool
+		wantData    bool
+		wantSentAt  bool
+		wantLastErr bool
+	}{
+		{
+			name:       "success",
+			channel:    ChannelEmail,
+			wantStatus: StatusSent,
+			wantSends:  1,
+			wantSentAt: true,
+		},
+		{
+			name:        "send error is retried",
+			channel:     ChannelEmail,
+			attempts:    1,
+			sendErr:     sendErr,
+			wantStatus:  StatusPending,
+			wantSends:   1,
+			wantRetry:   true,
+			wantData:    true,
+			wantLastErr: true,
+		},
+		{
+			name:        "abandoned after max attempts",
+			channel:     ChannelEmail,
+			attempts:    MaxAttempts - 1,
+			sendErr:     sendErr,
+			wantStatus:  StatusFailed,
+			wantSends:   1,
+			wantLastErr: true,
+		},
+		{
+			name:        "missing sender fails at once",
+			channel:     ChannelSMS,
+			wantStatus:  StatusFailed,
+			wantLastErr: true,
+		},
+		{
+			name:        "render error fails at once",
+			channel:     ChannelEmail,
+			renderErr:   errors.New("template: missing url"),
+			wantStatus:  StatusFailed,
+			wantLastErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &attemptRepository{}
+			sender := &stubSender{channel: ChannelEmail, err: tc.sendErr}
+			service := NewService(repo, stubRenderer{err: tc.renderErr}, appLogger, sender)
+
+			scheduledAt := time.Now().UTC().Add(-time.Minute)
+			before := time.Now().UTC()
+			service.deliver(context.Background(), &Notification{
+				ID:          uuid.New(),
+				Template:    TemplateStaffInvite,
+				Channel:     tc.channel,
+				Locale:      i18n.Default,
+				Recipient:   "owner@example.com",
+				Data:        map[string]string{"url": "https://example.com/accept-invite?token=secret"},
+				Status:      StatusPending,
+				Attempts:    tc.attempts,
+				ScheduledAt: scheduledAt,
+			})
+			after := time.Now().UTC()
+
+			if len(repo.saved) != 1 {
+				t.Fatalf("saved %d attempts, want 1", len(repo.saved))
+			}
+			got := repo.saved[0]
+
+			if got.Status != tc.wantStatus {
+				t.Errorf("status = %s, want %s", got.Status, tc.wantStatus)
+			}
+			if got.Attempts != tc.attempts+1 {
+				t.Errorf("attempts = %d, want %d", got.Attempts, tc.attempts+1)
+			}
+			if len(sender.sent) != tc.wantSends {
+				t.Errorf("sender called %d times, want %d", len(sender.sent), tc.wantSends)
+			}
+			if (got.Data != nil) != tc.wantData {
+				t.Errorf("data = %v, want kept = %v", got.Data, tc.wantData)
+			}
+			if (got.SentAt != nil) != tc.wantSentAt {
+				t.Errorf("sent at = %v, want set = %v", got.SentAt, tc.wantSentAt)
+			}
+			if (got.LastError != "") != tc.wantLastErr {
+				t.Errorf("last error = %q, want set = %v", got.LastError, tc.wantLastErr)
+			}
+
+			backoff := Backoff(tc.attempts + 1)
+			retried := !got.ScheduledAt.Equal(scheduledAt)
+			if retried != tc.wantRetry {
+				t.Fatalf("scheduled at = %v, want rescheduled = %v", got.ScheduledAt, tc.wantRetry)
+			}
+			if tc.wantRetry && (got.ScheduledAt.Before(before.Add(backoff)) || got.ScheduledAt.After(after.Add(backoff))) {
+				t.Errorf("scheduled at = %v, want about now + %v", got.ScheduledAt, backoff)
+			}
+		})
+	}
+}
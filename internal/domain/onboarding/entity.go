@@ -0,0 +1,17 @@
+// File: internal/domain/onboarding/entity.go
+package onboarding
+
+import (
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/location"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
+)
+
+// Result - onboarding zamanı yaradılan bütün qeydlər və yeni tokenlər
+type Result struct {
+	Business     *business.Business
+	Location     *location.Location
+	StaffProfile *staff.StaffProfile
+	Auth         *auth.AuthResponse
+}
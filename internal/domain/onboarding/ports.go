@@ -0,0 +1,34 @@
+// File: internal/domain/onboarding/ports.go
+package onboarding
+
+import (
+	"context"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/location"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
+	"github.com/google/uuid"
+)
+
+type BusinessService interface {
+	CreateBusiness(ctx context.Context, ownerID uuid.UUID, request *business.CreateBusinessRequest) (*business.Business, error)
+}
+
+type LocationService interface {
+	CreateDefaultLocation(ctx context.Context, businessID uuid.UUID) (*location.Location, error)
+}
+
+type StaffService interface {
+	CreateStaffProfile(ctx context.Context, businessID uuid.UUID, req *staff.CreateStaffRequest) (*staff.StaffProfile, error)
+}
+
+type UserService interface {
+	GetActiveUser(ctx context.Context, userID uuid.UUID) (*auth.User, error)
+	AssignBusinessOwner(ctx context.Context, user *auth.User, businessID uuid.UUID, role auth.UserRole) error
+	IssueAuthResponse(ctx context.Context, user *auth.User) (*auth.AuthResponse, error)
+}
+
+type Service interface {
+	CreateBusiness(ctx context.Context, ownerID uuid.UUID, request *business.CreateBusinessRequest) (*Result, error)
+}
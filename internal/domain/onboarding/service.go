@@ -0,0 +1,130 @@
+// File: internal/domain/onboarding/service.go
+package onboarding
+
+import (
+	"context"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/metrics"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/transaction"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/onboarding")
+
+const ownerStaffTitle = "Owner"
+
+type OnboardingService struct {
+	txManager  transaction.Manager
+	businesses BusinessService
+	locations  LocationService
+	staff      StaffService
+	users      UserService
+	logger     logger.Logger
+	metrics    metrics.Recorder
+}
+
+func NewService(
+	txManager transaction.Manager,
+	businesses BusinessService,
+	locations LocationService,
+	staff StaffService,
+	users UserService,
+	appLogger logger.Logger,
+	recorder metrics.Recorder,
+) *OnboardingService {
+	return &OnboardingService{
+		txManager:  txManager,
+		businesses: businesses,
+		locations:  locations,
+		staff:      staff,
+		users:      users,
+		logger:     appLogger,
+		metrics:    recorder,
+	}
+}
+
+// CreateBusiness - Biznes, default filial, sahibin işçi profili və istifadəçi yeniləməsi tək tranzaksiyada.
+// Yeni tokenlər business_id ilə qaytarılır.
+func (s *OnboardingService) CreateBusiness(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	request *business.CreateBusinessRequest,
+) (*Result, error) {
+	ctx, span := tracer.Start(ctx, "onboarding.CreateBusiness")
+	defer span.End()
+
+	if ownerID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_OWNER_ID", "Owner ID cannot be empty")
+	}
+	if request == nil {
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
+	}
+
+	result := &Result{}
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := s.users.GetActiveUser(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		if user.BusinessID != nil {
+			return apperr.Conflict("ALREADY_ONBOARDED", "User already has a business")
+		}
+
+		result.Business, err = s.businesses.CreateBusiness(ctx, ownerID, request)
+		if err != nil {
+			return err
+		}
+		// Filial və işçi profili yeni biznesin tenant-ı altında yazılır
+		ctx = tenant.WithBusinessID(ctx, result.Business.ID)
+
+		result.Location, err = s.locations.CreateDefaultLocation(ctx, result.Business.ID)
+		if err != nil {
+			return err
+		}
+
+		result.StaffProfile, err = s.staff.CreateStaffProfile(ctx, result.Business.ID, &staff.CreateStaffRequest{
+			UserID:     ownerID,
+			Role:       staff.StaffRoleAdmin,
+			Title:      ownerStaffTitle,
+			LocationID: &result.Location.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := s.users.AssignBusinessOwner(ctx, user, result.Business.ID, ownerRole(request.BusinessType)); err != nil {
+			return err
+		}
+
+		result.Auth, err = s.users.IssueAuthResponse(ctx, user)
+		return err
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Onboarding rolled back",
+			logger.Field{Key: "owner_id", Value: ownerID.String()},
+			logger.Field{Key: "error", Value: err.Error()},
+		)
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Business onboarding completed",
+		logger.Field{Key: "owner_id", Value: ownerID.String()},
+		logger.Field{Key: "business_id", Value: result.Business.ID.String()},
+	)
+	s.metrics.Record(metrics.EventBusinessOnboarded)
+	return result, nil
+}
+
+func ownerRole(businessType business.BusinessType) auth.UserRole {
+	if businessType == business.BusinessTypeSolo {
+		return auth.UserTypeSoloPractitioner
+	}
+	return auth.UserTypeOwner
+}
@@ -0,0 +1,81 @@
+// File: internal/domain/ratelimit/entity.go
+package ratelimit
+
+import (
+	"math"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+)
+
+var ErrRateLimited = apperr.TooManyRequests("RATE_LIMITED", "Too many requests, retry later")
+
+// Scope - kvotanın kimə aid olduğu: klient IP-si, istifadəçi və ya biznes (tenant)
+type Scope string
+
+const (
+	ScopeIP       Scope = "ip"
+	ScopeUser     Scope = "user"
+	ScopeBusiness Scope = "business"
+)
+
+// Limit - token bucket: tutumu Requests, boş bucket Per müddətində tam dolur
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Policy - route və ya route qrupu üçün kvota. Name konfiqurasiyada (APP_RATE_LIMITS) override açarıdır.
+type Policy struct {
+	Name  string
+	Scope Scope
+	Limit Limit
+}
+
+// Bucket - saxlanan hal; yeni bucket tam doludur
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Decision - RateLimit-* və Retry-After header-ləri bundan yaradılır
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// NewBucket - tam dolu bucket
+func (l Limit) NewBucket(now time.Time) Bucket {
+	return Bucket{Tokens: float64(l.Requests), UpdatedAt: now}
+}
+
+// Take - bucket-i now anına qədər doldurur və bir token götürməyə çalışır
+func (l Limit) Take(bucket Bucket, now time.Time) (Bucket, Decision) {
+	capacity := float64(l.Requests)
+	rate := capacity / l.Per.Seconds()
+
+	elapsed := now.Sub(bucket.UpdatedAt).Seconds()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	tokens := math.Min(capacity, bucket.Tokens+elapsed*rate)
+
+	decision := Decision{Limit: l.Requests}
+	if tokens >= 1 {
+		tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	decision.Remaining = int(math.Floor(tokens))
+	decision.ResetAfter = seconds((capacity - tokens) / rate)
+
+	return Bucket{Tokens: tokens, UpdatedAt: now}, decision
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
@@ -0,0 +1,12 @@
+// File: internal/domain/ratelimit/ports.go
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Store - bucket-lərin saxlandığı backend: yaddaş (tək instans) və ya Postgres (instanslar arası ortaq)
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Decision, error)
+}
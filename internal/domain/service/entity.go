@@ -7,6 +7,12 @@ import (
 	"github.com/google/uuid"
 )
 
+// Siyahı filtrləri üçün status dəyərləri (is_active sütununa uyğun)
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+)
+
 type Service struct {
 	ID              uuid.UUID `db:"id" json:"id"`
 	BusinessID      uuid.UUID `db:"business_id" json:"business_id"`
@@ -15,6 +21,7 @@ type Service struct {
 	DurationMinutes int       `db:"duration_minutes" json:"duration_minutes"`
 	Price           float64   `db:"price" json:"price"`
 	IsActive        bool      `db:"is_active" json:"is_active"`
+	Version         int       `db:"version" json:"version"`
 	CreatedAt       time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
 }
@@ -34,6 +41,7 @@ func NewService(businessID uuid.UUID, name, description string, durationMinutes
 		DurationMinutes: durationMinutes,
 		Price:           price,
 		IsActive:        true,
+		Version:         1,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
@@ -51,13 +59,6 @@ type UpdateServiceRequest struct {
 	Description     string  `json:"description"`
 	DurationMinutes int     `json:"duration_minutes"`
 	Price           float64 `json:"price"`
-}
-
-type ServiceError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
-
-func (e *ServiceError) Error() string {
-	return e.Message
+	// Version - If-Match ilə gələn gözlənilən versiya; 0 yoxlamanı söndürür (If-Match: *)
+	Version int `json:"-"`
 }
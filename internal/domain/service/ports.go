@@ -3,30 +3,36 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 )
 
+// ErrDuplicateName - biznes daxilində eyni adlı aktiv xidmət artıq mövcuddur
+var ErrDuplicateName = errors.New("service name already exists")
+
 type Repository interface {
 	Create(ctx context.Context, s *Service) error
 	GetByID(ctx context.Context, id, businessID uuid.UUID) (*Service, error)
-	ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*Service, error)
+	ListByBusiness(ctx context.Context, businessID uuid.UUID, query listing.Query) ([]*Service, error)
 	Update(ctx context.Context, s *Service) error
 	Deactivate(ctx context.Context, id, businessID uuid.UUID) error
 
 	AssignServicesToStaff(ctx context.Context, businessID, staffID uuid.UUID, serviceIDs []uuid.UUID) error
-	GetStaffServices(ctx context.Context, businessID, staffID uuid.UUID) ([]*Service, error)
+	GetStaffServices(ctx context.Context, businessID, staffID uuid.UUID, query listing.Query) ([]*Service, error)
 	RemoveServiceFromStaff(ctx context.Context, businessID, staffID, serviceID uuid.UUID) error
 }
 
 type ServiceUseCase interface {
 	CreateService(ctx context.Context, businessID uuid.UUID, req *CreateServiceRequest) (*Service, error)
-	ListServices(ctx context.Context, businessID uuid.UUID) ([]*Service, error)
+	ListServices(ctx context.Context, businessID uuid.UUID, query listing.Query) (*listing.Page[*Service], error)
 	GetService(ctx context.Context, id, businessID uuid.UUID) (*Service, error)
-	UpdateService(ctx context.Context, id, businessID uuid.UUID, req *UpdateServiceRequest) error
+	UpdateService(ctx context.Context, id, businessID uuid.UUID, req *UpdateServiceRequest) (*Service, error)
 	DeactivateService(ctx context.Context, id, businessID uuid.UUID) error
 
 	AssignServicesToStaff(ctx context.Context, businessID, staffID uuid.UUID, serviceIDs []uuid.UUID) error
-	GetStaffServices(ctx context.Context, businessID, staffID uuid.UUID) ([]*Service, error)
+	GetStaffServices(ctx context.Context, businessID, staffID uuid.UUID, query listing.Query) (*listing.Page[*Service], error)
 	RemoveServiceFromStaff(ctx context.Context, businessID, staffID, serviceID uuid.UUID) error
 }
@@ -3,18 +3,44 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/transaction"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/service")
+
 type ServiceService struct {
-	repo Repository
+	repo      Repository
+	txManager transaction.Manager
+	logger    logger.Logger
+	audit     audit.Recorder
+	events    events.Publisher
 }
 
-func NewServiceUseCase(repo Repository) *ServiceService {
-	return &ServiceService{repo: repo}
+func NewServiceUseCase(
+	repo Repository,
+	txManager transaction.Manager,
+	appLogger logger.Logger,
+	auditRecorder audit.Recorder,
+	publisher events.Publisher,
+) *ServiceService {
+	return &ServiceService{
+		repo:      repo,
+		txManager: txManager,
+		logger:    appLogger,
+		audit:     auditRecorder,
+		events:    publisher,
+	}
 }
 
 // CreateService - Yeni xidmət yaratmaq
@@ -23,11 +49,14 @@ func (s *ServiceService) CreateService(
 	businessID uuid.UUID,
 	req *CreateServiceRequest,
 ) (*Service, error) {
+	ctx, span := tracer.Start(ctx, "service.CreateService")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &ServiceError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 	if req == nil {
-		return nil, &ServiceError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	if err := s.validateCreateRequest(req); err != nil {
@@ -40,35 +69,71 @@ func (s *ServiceService) CreateService(
 		return nil, err
 	}
 
-	if err := s.repo.Create(ctx, svc); err != nil {
-		return nil, fmt.Errorf("failed to create service: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, svc); err != nil {
+			if errors.Is(err, ErrDuplicateName) {
+				return apperr.Conflict("SERVICE_NAME_EXISTS", "Service with this name already exists")
+			}
+			return fmt.Errorf("failed to create service: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityService,
+			EntityID:   svc.ID,
+			Action:     audit.ActionCreated,
+			Changes:    audit.Diff(nil, svc),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.ServiceCreated, businessID, svc.ID, svc)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	s.logger.WithContext(ctx).Info("Service created",
+		logger.Field{Key: "service_id", Value: svc.ID.String()},
+		logger.Field{Key: "business_id", Value: businessID.String()},
+	)
 	return svc, nil
 }
 
 func (s *ServiceService) ListServices(
 	ctx context.Context,
 	businessID uuid.UUID,
-) ([]*Service, error) {
+	query listing.Query,
+) (*listing.Page[*Service], error) {
+	ctx, span := tracer.Start(ctx, "service.ListServices")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &ServiceError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
+	}
+	if err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc, StatusActive, StatusInactive); err != nil {
+		return nil, err
 	}
 
-	services, err := s.repo.ListByBusiness(ctx, businessID)
+	services, err := s.repo.ListByBusiness(ctx, businessID, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list services: %w", err)
 	}
 
-	return services, nil
+	return listing.Paginate(services, query, serviceKey), nil
+}
+
+func serviceKey(service *Service) listing.Key {
+	return listing.Key{ID: service.ID, Name: service.Name, CreatedAt: service.CreatedAt}
 }
 
 func (s *ServiceService) GetService(
 	ctx context.Context,
 	id, businessID uuid.UUID,
 ) (*Service, error) {
+	ctx, span := tracer.Start(ctx, "service.GetService")
+	defer span.End()
+
 	if id == uuid.Nil || businessID == uuid.Nil {
-		return nil, &ServiceError{Code: "INVALID_ID", Message: "Service ID and Business ID are required"}
+		return nil, apperr.Validation("INVALID_ID", "Service ID and Business ID are required")
 	}
 
 	svc, err := s.repo.GetByID(ctx, id, businessID)
@@ -76,7 +141,7 @@ func (s *ServiceService) GetService(
 		return nil, fmt.Errorf("failed to get service: %w", err)
 	}
 	if svc == nil {
-		return nil, &ServiceError{Code: "NOT_FOUND", Message: "Service not found"}
+		return nil, apperr.NotFound("NOT_FOUND", "Service not found")
 	}
 
 	return svc, nil
@@ -86,22 +151,30 @@ func (s *ServiceService) UpdateService(
 	ctx context.Context,
 	id, businessID uuid.UUID,
 	req *UpdateServiceRequest,
-) error {
+) (*Service, error) {
+	ctx, span := tracer.Start(ctx, "service.UpdateService")
+	defer span.End()
+
 	if id == uuid.Nil || businessID == uuid.Nil {
-		return &ServiceError{Code: "INVALID_ID", Message: "Service ID and Business ID are required"}
+		return nil, apperr.Validation("INVALID_ID", "Service ID and Business ID are required")
 	}
 	if req == nil {
-		return &ServiceError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	svc, err := s.repo.GetByID(ctx, id, businessID)
 	if err != nil {
-		return fmt.Errorf("failed to get service: %w", err)
+		return nil, fmt.Errorf("failed to get service: %w", err)
 	}
 	if svc == nil {
-		return &ServiceError{Code: "NOT_FOUND", Message: "Service not found"}
+		return nil, apperr.NotFound("NOT_FOUND", "Service not found")
+	}
+
+	if req.Version != 0 && req.Version != svc.Version {
+		return nil, apperr.ErrVersionMismatch
 	}
 
+	before := *svc
 	svc.Name = req.Name
 	svc.Description = req.Description
 	svc.DurationMinutes = req.DurationMinutes
@@ -109,28 +182,66 @@ func (s *ServiceService) UpdateService(
 	svc.UpdatedAt = time.Now()
 
 	if err := s.validateService(svc); err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := s.repo.Update(ctx, svc); err != nil {
-		return fmt.Errorf("failed to update service: %w", err)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, svc); err != nil {
+			if errors.Is(err, ErrDuplicateName) {
+				return apperr.Conflict("SERVICE_NAME_EXISTS", "Service with this name already exists")
+			}
+			return fmt.Errorf("failed to update service: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityService,
+			EntityID:   id,
+			Action:     audit.ActionUpdated,
+			Changes:    audit.Diff(&before, svc),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.ServiceUpdated, businessID, id, svc)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	s.logger.WithContext(ctx).Info("Service updated", logger.Field{Key: "service_id", Value: id.String()})
+	return svc, nil
 }
 
 func (s *ServiceService) DeactivateService(
 	ctx context.Context,
 	id, businessID uuid.UUID,
 ) error {
+	ctx, span := tracer.Start(ctx, "service.DeactivateService")
+	defer span.End()
+
 	if id == uuid.Nil || businessID == uuid.Nil {
-		return &ServiceError{Code: "INVALID_ID", Message: "Service ID and Business ID are required"}
+		return apperr.Validation("INVALID_ID", "Service ID and Business ID are required")
 	}
 
-	if err := s.repo.Deactivate(ctx, id, businessID); err != nil {
-		return fmt.Errorf("failed to deactivate service: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Deactivate(ctx, id, businessID); err != nil {
+			return fmt.Errorf("failed to deactivate service: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityService,
+			EntityID:   id,
+			Action:     audit.ActionDeactivated,
+			Changes:    audit.Field("is_active", true, false),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.ServiceDeactivated, businessID, id, events.Ref{ID: id})
+	})
+	if err != nil {
+		return err
 	}
 
+	s.logger.WithContext(ctx).Info("Service deactivated", logger.Field{Key: "service_id", Value: id.String()})
 	return nil
 }
 
@@ -139,59 +250,104 @@ func (s *ServiceService) AssignServicesToStaff(
 	businessID, staffID uuid.UUID,
 	serviceIDs []uuid.UUID,
 ) error {
+	ctx, span := tracer.Start(ctx, "service.AssignServicesToStaff")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return &ServiceError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 	if staffID == uuid.Nil {
-		return &ServiceError{Code: "INVALID_STAFF", Message: "Staff ID cannot be empty"}
+		return apperr.Validation("INVALID_STAFF", "Staff ID cannot be empty")
 	}
 	if err := s.validateAssignServicesRequest(serviceIDs); err != nil {
 		return err
 	}
 
-	if err := s.repo.AssignServicesToStaff(ctx, businessID, staffID, serviceIDs); err != nil {
-		return fmt.Errorf("failed to assign services to staff: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.AssignServicesToStaff(ctx, businessID, staffID, serviceIDs); err != nil {
+			return fmt.Errorf("failed to assign services to staff: %w", err)
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaffService,
+			EntityID:   staffID,
+			Action:     audit.ActionAssigned,
+			Changes:    audit.Field("service_ids", nil, serviceIDs),
+		})
+	})
+	if err != nil {
+		return err
 	}
 
+	s.logger.WithContext(ctx).Info("Services assigned to staff",
+		logger.Field{Key: "staff_id", Value: staffID.String()},
+		logger.Field{Key: "count", Value: len(serviceIDs)},
+	)
 	return nil
 }
 
 func (s *ServiceService) GetStaffServices(
 	ctx context.Context,
 	businessID, staffID uuid.UUID,
-) ([]*Service, error) {
+	query listing.Query,
+) (*listing.Page[*Service], error) {
+	ctx, span := tracer.Start(ctx, "service.GetStaffServices")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &ServiceError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 	if staffID == uuid.Nil {
-		return nil, &ServiceError{Code: "INVALID_STAFF", Message: "Staff ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_STAFF", "Staff ID cannot be empty")
+	}
+	if err := query.Normalize(listing.SortName, listing.OrderAsc, StatusActive, StatusInactive); err != nil {
+		return nil, err
 	}
 
-	services, err := s.repo.GetStaffServices(ctx, businessID, staffID)
+	services, err := s.repo.GetStaffServices(ctx, businessID, staffID, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get staff services: %w", err)
 	}
 
-	return services, nil
+	return listing.Paginate(services, query, serviceKey), nil
 }
 
 func (s *ServiceService) RemoveServiceFromStaff(
 	ctx context.Context,
 	businessID, staffID, serviceID uuid.UUID,
 ) error {
+	ctx, span := tracer.Start(ctx, "service.RemoveServiceFromStaff")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return &ServiceError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 	if staffID == uuid.Nil {
-		return &ServiceError{Code: "INVALID_STAFF", Message: "Staff ID cannot be empty"}
+		return apperr.Validation("INVALID_STAFF", "Staff ID cannot be empty")
 	}
 	if serviceID == uuid.Nil {
-		return &ServiceError{Code: "INVALID_SERVICE", Message: "Service ID cannot be empty"}
+		return apperr.Validation("INVALID_SERVICE", "Service ID cannot be empty")
 	}
 
-	if err := s.repo.RemoveServiceFromStaff(ctx, businessID, staffID, serviceID); err != nil {
-		return fmt.Errorf("failed to remove service from staff: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.RemoveServiceFromStaff(ctx, businessID, staffID, serviceID); err != nil {
+			return fmt.Errorf("failed to remove service from staff: %w", err)
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaffService,
+			EntityID:   staffID,
+			Action:     audit.ActionRemoved,
+			Changes:    audit.Field("service_id", serviceID, nil),
+		})
+	})
+	if err != nil {
+		return err
 	}
 
+	s.logger.WithContext(ctx).Info("Service removed from staff",
+		logger.Field{Key: "staff_id", Value: staffID.String()},
+		logger.Field{Key: "service_id", Value: serviceID.String()},
+	)
 	return nil
 }
@@ -39,6 +39,7 @@ type StaffProfile struct {
 	HourlyRate float64     `db:"hourly_rate" json:"hourly_rate"`
 	Status     StaffStatus `db:"status" json:"status"`
 	JoinedAt   time.Time   `db:"joined_at" json:"joined_at"`
+	Version    int         `db:"version" json:"version"`
 	CreatedAt  time.Time   `db:"created_at" json:"created_at"`
 	UpdatedAt  time.Time   `db:"updated_at" json:"updated_at"`
 }
@@ -52,12 +53,40 @@ func NewStaffProfile(userID, businessID uuid.UUID, role StaffRole, title string)
 		Role:       role,
 		Title:      title,
 		Status:     StaffStatusActive,
+		Version:    1,
 		JoinedAt:   now,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
 }
 
+// profileEvent - staff hadisələrinin payload-u; şifrəli saxlanan hourly_rate outbox-a yazılmır
+type profileEvent struct {
+	ID         uuid.UUID   `json:"id"`
+	UserID     uuid.UUID   `json:"user_id"`
+	BusinessID uuid.UUID   `json:"business_id"`
+	LocationID *uuid.UUID  `json:"location_id,omitempty"`
+	Role       StaffRole   `json:"role"`
+	Title      string      `json:"title"`
+	Department string      `json:"department"`
+	Status     StaffStatus `json:"status"`
+	JoinedAt   time.Time   `json:"joined_at"`
+}
+
+func newProfileEvent(profile *StaffProfile) profileEvent {
+	return profileEvent{
+		ID:         profile.ID,
+		UserID:     profile.UserID,
+		BusinessID: profile.BusinessID,
+		LocationID: profile.LocationID,
+		Role:       profile.Role,
+		Title:      profile.Title,
+		Department: profile.Department,
+		Status:     profile.Status,
+		JoinedAt:   profile.JoinedAt,
+	}
+}
+
 type StaffWithUser struct {
 	ID         uuid.UUID   `db:"id" json:"id"`
 	UserID     uuid.UUID   `db:"user_id" json:"user_id"`
@@ -71,6 +100,7 @@ type StaffWithUser struct {
 	LocationID *uuid.UUID  `db:"location_id" json:"location_id,omitempty"`
 	Status     StaffStatus `db:"status" json:"status"`
 	JoinedAt   time.Time   `db:"joined_at" json:"joined_at"`
+	CreatedAt  time.Time   `db:"created_at" json:"created_at"`
 }
 
 type BusinessInvite struct {
@@ -80,13 +110,36 @@ type BusinessInvite struct {
 	InvitedPhone string     `db:"invited_phone" json:"invited_phone"`
 	Role         StaffRole  `db:"role" json:"role"`
 	LocationID   *uuid.UUID `db:"location_id" json:"location_id,omitempty"`
-	Token        string     `db:"token" json:"token"`
+	Token        string     `db:"token" json:"-"`
 	ExpiresAt    time.Time  `db:"expires_at" json:"expires_at"`
 	Used         bool       `db:"used" json:"used"`
+	RevokedAt    *time.Time `db:"revoked_at" json:"revoked_at,omitempty"`
 	CreatedAt    time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt    time.Time  `db:"updated_at" json:"updated_at"`
 }
 
+type InviteStatus string
+
+const (
+	InviteStatusPending  InviteStatus = "pending"
+	InviteStatusAccepted InviteStatus = "accepted"
+	InviteStatusRevoked  InviteStatus = "revoked"
+	InviteStatusExpired  InviteStatus = "expired"
+)
+
+func (i *BusinessInvite) Status(now time.Time) InviteStatus {
+	switch {
+	case i.Used:
+		return InviteStatusAccepted
+	case i.RevokedAt != nil:
+		return InviteStatusRevoked
+	case now.After(i.ExpiresAt):
+		return InviteStatusExpired
+	default:
+		return InviteStatusPending
+	}
+}
+
 type CreateStaffRequest struct {
 	UserID     uuid.UUID  `json:"user_id"`
 	Role       StaffRole  `json:"role"`
@@ -101,6 +154,8 @@ type UpdateStaffRequest struct {
 	Bio        string     `json:"bio"`
 	HourlyRate float64    `json:"hourly_rate"`
 	LocationID *uuid.UUID `json:"location_id,omitempty"`
+	// Version - If-Match ilə gələn gözlənilən versiya; 0 yoxlamanı söndürür (If-Match: *)
+	Version int `json:"-"`
 }
 
 type InviteStaffRequest struct {
@@ -110,16 +165,10 @@ type InviteStaffRequest struct {
 	LocationID *uuid.UUID `json:"location_id,omitempty"`
 }
 
+// AcceptInviteRequest - Password və FullName yalnız hesabı olmayan dəvətli üçün tələb olunur
 type AcceptInviteRequest struct {
 	Token    string `json:"token"`
 	Password string `json:"password"`
-}
-
-type StaffError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
-
-func (e *StaffError) Error() string {
-	return e.Message
+	FullName string `json:"full_name"`
+	Phone    string `json:"phone"`
 }
@@ -3,7 +3,10 @@ package staff
 
 import (
 	"context"
+	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 	"github.com/google/uuid"
 )
 
@@ -11,26 +14,36 @@ type Repository interface {
 	CreateStaffProfile(ctx context.Context, profile *StaffProfile) error
 	GetStaffByID(ctx context.Context, id, businessID uuid.UUID) (*StaffProfile, error)
 	GetStaffByUserID(ctx context.Context, userID, businessID uuid.UUID) (*StaffProfile, error)
-	ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*StaffWithUser, error)
+	ListByBusiness(ctx context.Context, businessID uuid.UUID, query listing.Query) ([]*StaffWithUser, error)
 	UpdateStaffProfile(ctx context.Context, profile *StaffProfile) error
 	DeactivateStaff(ctx context.Context, id, businessID uuid.UUID) error
 	CreateInvite(ctx context.Context, invite *BusinessInvite) error
 	GetInviteByToken(ctx context.Context, token string) (*BusinessInvite, error)
 	MarkInviteAsUsed(ctx context.Context, inviteID uuid.UUID) error
-	ListInvitesByBusiness(ctx context.Context, businessID uuid.UUID) ([]*BusinessInvite, error)
+	ListInvitesByBusiness(ctx context.Context, businessID uuid.UUID, query listing.Query) ([]*BusinessInvite, error)
+	GetInviteByID(ctx context.Context, id, businessID uuid.UUID) (*BusinessInvite, error)
+	RefreshInviteToken(ctx context.Context, id, businessID uuid.UUID, token string, expiresAt time.Time) error
+	RevokeInvite(ctx context.Context, id, businessID uuid.UUID) error
 }
 
+// UserService - auth domeni ilə əlaqə (auth.Service tərəfindən implement olunur)
 type UserService interface {
-	UpdateUserBusinessID(ctx context.Context, userID, businessID uuid.UUID, isOwner bool) error
+	GetActiveUser(ctx context.Context, userID uuid.UUID) (*auth.User, error)
+	CreateStaffUser(ctx context.Context, req *auth.CreateStaffUserRequest) (*auth.User, error)
+	AttachUserToBusiness(ctx context.Context, user *auth.User, businessID uuid.UUID) error
+	IssueAuthResponse(ctx context.Context, user *auth.User) (*auth.AuthResponse, error)
 }
 
 type Service interface {
 	CreateStaffProfile(ctx context.Context, businessID uuid.UUID, req *CreateStaffRequest) (*StaffProfile, error)
 	GetStaff(ctx context.Context, staffID, businessID uuid.UUID) (*StaffProfile, error)
-	ListStaff(ctx context.Context, businessID uuid.UUID) ([]*StaffWithUser, error)
-	UpdateStaff(ctx context.Context, staffID, businessID uuid.UUID, req *UpdateStaffRequest) error
+	ListStaff(ctx context.Context, businessID uuid.UUID, query listing.Query) (*listing.Page[*StaffWithUser], error)
+	UpdateStaff(ctx context.Context, staffID, businessID uuid.UUID, req *UpdateStaffRequest) (*StaffProfile, error)
 	DeactivateStaff(ctx context.Context, staffID, businessID uuid.UUID) error
-	InviteStaff(ctx context.Context, businessID uuid.UUID, req *InviteStaffRequest) (string, error)
+	InviteStaff(ctx context.Context, businessID uuid.UUID, req *InviteStaffRequest) (*BusinessInvite, error)
+	ListInvites(ctx context.Context, businessID uuid.UUID, query listing.Query) (*listing.Page[*BusinessInvite], error)
+	ResendInvite(ctx context.Context, inviteID, businessID uuid.UUID) (*BusinessInvite, error)
+	RevokeInvite(ctx context.Context, inviteID, businessID uuid.UUID) error
 	ValidateInviteToken(ctx context.Context, token string) (*BusinessInvite, error)
-	AcceptInvite(ctx context.Context, userID uuid.UUID, token, password string) error
+	AcceptInvite(ctx context.Context, currentUserID *uuid.UUID, req *AcceptInviteRequest) (*auth.AuthResponse, error)
 }
@@ -4,22 +4,64 @@ package staff
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/metrics"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/transaction"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/staff")
+
+const inviteTTL = 7 * 24 * time.Hour
+
 type StaffService struct {
 	repo        Repository
+	txManager   transaction.Manager
 	userService UserService
+	notifier    notification.Notifier
+	frontendURL string
+	logger      logger.Logger
+	metrics     metrics.Recorder
+	audit       audit.Recorder
+	events      events.Publisher
 }
 
-func NewService(repo Repository, userService UserService) *StaffService {
+func NewService(
+	repo Repository,
+	txManager transaction.Manager,
+	userService UserService,
+	notifier notification.Notifier,
+	frontendURL string,
+	appLogger logger.Logger,
+	recorder metrics.Recorder,
+	auditRecorder audit.Recorder,
+	publisher events.Publisher,
+) *StaffService {
 	return &StaffService{
 		repo:        repo,
+		txManager:   txManager,
 		userService: userService,
+		notifier:    notifier,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+		logger:      appLogger,
+		metrics:     recorder,
+		audit:       auditRecorder,
+		events:      publisher,
 	}
 }
 
@@ -29,11 +71,14 @@ func (s *StaffService) CreateStaffProfile(
 	businessID uuid.UUID,
 	req *CreateStaffRequest,
 ) (*StaffProfile, error) {
+	ctx, span := tracer.Start(ctx, "staff.CreateStaffProfile")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &StaffError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 	if req == nil {
-		return nil, &StaffError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	// Validation
@@ -48,10 +93,29 @@ func (s *StaffService) CreateStaffProfile(
 		return nil, err
 	}
 
-	if err := s.repo.CreateStaffProfile(ctx, profile); err != nil {
-		return nil, fmt.Errorf("failed to create staff profile: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateStaffProfile(ctx, profile); err != nil {
+			return fmt.Errorf("failed to create staff profile: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaff,
+			EntityID:   profile.ID,
+			Action:     audit.ActionCreated,
+			Changes:    audit.Diff(nil, profile),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.StaffCreated, businessID, profile.ID, newProfileEvent(profile))
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	s.logger.WithContext(ctx).Info("Staff profile created",
+		logger.Field{Key: "staff_id", Value: profile.ID.String()},
+		logger.Field{Key: "business_id", Value: businessID.String()},
+	)
 	return profile, nil
 }
 
@@ -60,8 +124,11 @@ func (s *StaffService) GetStaff(
 	ctx context.Context,
 	staffID, businessID uuid.UUID,
 ) (*StaffProfile, error) {
+	ctx, span := tracer.Start(ctx, "staff.GetStaff")
+	defer span.End()
+
 	if staffID == uuid.Nil || businessID == uuid.Nil {
-		return nil, &StaffError{Code: "INVALID_ID", Message: "Staff ID and Business ID are required"}
+		return nil, apperr.Validation("INVALID_ID", "Staff ID and Business ID are required")
 	}
 
 	staff, err := s.repo.GetStaffByID(ctx, staffID, businessID)
@@ -69,27 +136,38 @@ func (s *StaffService) GetStaff(
 		return nil, fmt.Errorf("failed to get staff: %w", err)
 	}
 	if staff == nil {
-		return nil, &StaffError{Code: "NOT_FOUND", Message: "Staff not found"}
+		return nil, apperr.NotFound("NOT_FOUND", "Staff not found")
 	}
 
 	return staff, nil
 }
 
-// ListStaff - Business-ə aid bütün işçilər (JOIN ilə User detalları)
+// ListStaff - Business-ə aid işçilər (JOIN ilə User detalları); name sort/axtarış tam ada görədir
 func (s *StaffService) ListStaff(
 	ctx context.Context,
 	businessID uuid.UUID,
-) ([]*StaffWithUser, error) {
+	query listing.Query,
+) (*listing.Page[*StaffWithUser], error) {
+	ctx, span := tracer.Start(ctx, "staff.ListStaff")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return nil, &StaffError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
+	}
+	err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc,
+		string(StaffStatusActive), string(StaffStatusInactive), string(StaffStatusPending))
+	if err != nil {
+		return nil, err
 	}
 
-	staff, err := s.repo.ListByBusiness(ctx, businessID)
+	staff, err := s.repo.ListByBusiness(ctx, businessID, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list staff: %w", err)
 	}
 
-	return staff, nil
+	return listing.Paginate(staff, query, func(member *StaffWithUser) listing.Key {
+		return listing.Key{ID: member.ID, Name: member.FullName, CreatedAt: member.CreatedAt}
+	}), nil
 }
 
 // UpdateStaff - İşçi məlumatlarını yeniləmək
@@ -97,19 +175,27 @@ func (s *StaffService) UpdateStaff(
 	ctx context.Context,
 	staffID, businessID uuid.UUID,
 	req *UpdateStaffRequest,
-) error {
+) (*StaffProfile, error) {
+	ctx, span := tracer.Start(ctx, "staff.UpdateStaff")
+	defer span.End()
+
 	if staffID == uuid.Nil || businessID == uuid.Nil {
-		return &StaffError{Code: "INVALID_ID", Message: "Staff ID and Business ID are required"}
+		return nil, apperr.Validation("INVALID_ID", "Staff ID and Business ID are required")
 	}
 
 	staff, err := s.repo.GetStaffByID(ctx, staffID, businessID)
 	if err != nil {
-		return fmt.Errorf("failed to get staff: %w", err)
+		return nil, fmt.Errorf("failed to get staff: %w", err)
 	}
 	if staff == nil {
-		return &StaffError{Code: "NOT_FOUND", Message: "Staff not found"}
+		return nil, apperr.NotFound("NOT_FOUND", "Staff not found")
+	}
+
+	if req.Version != 0 && req.Version != staff.Version {
+		return nil, apperr.ErrVersionMismatch
 	}
 
+	before := *staff
 	// Update fields
 	staff.Role = req.Role
 	staff.Title = req.Title
@@ -120,14 +206,30 @@ func (s *StaffService) UpdateStaff(
 	staff.UpdatedAt = time.Now()
 
 	if err := s.validateStaffProfile(staff); err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := s.repo.UpdateStaffProfile(ctx, staff); err != nil {
-		return fmt.Errorf("failed to update staff: %w", err)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateStaffProfile(ctx, staff); err != nil {
+			return fmt.Errorf("failed to update staff: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaff,
+			EntityID:   staffID,
+			Action:     audit.ActionUpdated,
+			Changes:    audit.Diff(&before, staff),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.StaffUpdated, businessID, staffID, newProfileEvent(staff))
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	s.logger.WithContext(ctx).Info("Staff profile updated", logger.Field{Key: "staff_id", Value: staffID.String()})
+	return staff, nil
 }
 
 // DeactivateStaff - Soft delete (status = inactive)
@@ -135,121 +237,441 @@ func (s *StaffService) DeactivateStaff(
 	ctx context.Context,
 	staffID, businessID uuid.UUID,
 ) error {
-	if staffID == uuid.Nil || businessID == uuid.Nil {
-		return &StaffError{Code: "INVALID_ID", Message: "Staff ID and Business ID are required"}
-	}
+	ctx, span := tracer.Start(ctx, "staff.DeactivateStaff")
+	defer span.End()
 
-	if err := s.repo.DeactivateStaff(ctx, staffID, businessID); err != nil {
-		return fmt.Errorf("failed to deactivate staff: %w", err)
+	if staffID == uuid.Nil || businessID == uuid.Nil {
+		return apperr.Validation("INVALID_ID", "Staff ID and Business ID are required")
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.DeactivateStaff(ctx, staffID, businessID); err != nil {
+			return fmt.Errorf("failed to deactivate staff: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaff,
+			EntityID:   staffID,
+			Action:     audit.ActionDeactivated,
+			Changes:    audit.Field("status", StaffStatusActive, StaffStatusInactive),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.StaffDeactivated, businessID, staffID, events.Ref{ID: staffID})
+	})
+	if err != nil {
+		return err
 	}
 
+	s.logger.WithContext(ctx).Info("Staff deactivated", logger.Field{Key: "staff_id", Value: staffID.String()})
 	return nil
 }
 
-// InviteStaff - Yeni işçi dəvət etmək
+// InviteStaff - Yeni işçi dəvət etmək, link email və/və ya SMS ilə göndərilir
 func (s *StaffService) InviteStaff(
 	ctx context.Context,
 	businessID uuid.UUID,
 	req *InviteStaffRequest,
-) (string, error) {
+) (*BusinessInvite, error) {
+	ctx, span := tracer.Start(ctx, "staff.InviteStaff")
+	defer span.End()
+
 	if businessID == uuid.Nil {
-		return "", &StaffError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 	if req == nil {
-		return "", &StaffError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	// Validation
 	if err := s.validateInviteRequest(req); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Generate secure token (32 bytes)
-	tokenBytes := make([]byte, 32)
-	if _, err := rand.Read(tokenBytes); err != nil {
-		return "", fmt.Errorf("failed to generate token: %w", err)
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, err
 	}
-	token := hex.EncodeToString(tokenBytes)
 
+	now := time.Now()
 	invite := &BusinessInvite{
 		ID:           uuid.New(),
 		BusinessID:   businessID,
-		InvitedEmail: req.Email,
-		InvitedPhone: req.Phone,
+		InvitedEmail: strings.ToLower(strings.TrimSpace(req.Email)),
+		InvitedPhone: strings.TrimSpace(req.Phone),
 		Role:         req.Role,
 		LocationID:   req.LocationID,
-		Token:        token,
-		ExpiresAt:    time.Now().Add(7 * 24 * time.Hour),
+		Token:        hashToken(token),
+		ExpiresAt:    now.Add(inviteTTL),
 		Used:         false,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	// Bildiriş növbəyə qoyula bilməsə dəvət və hadisə geri alınır
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateInvite(ctx, invite); err != nil {
+			return fmt.Errorf("failed to create invite: %w", err)
+		}
+		if err := events.Emit(ctx, s.events, events.StaffInvited, businessID, invite.ID, invite); err != nil {
+			return err
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaffInvite,
+			EntityID:   invite.ID,
+			Action:     audit.ActionInvited,
+			Changes:    audit.Diff(nil, invite),
+		}); err != nil {
+			return err
+		}
+		return s.deliverInvite(ctx, invite, token)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Staff invite sent",
+		logger.Field{Key: "invite_id", Value: invite.ID.String()},
+		logger.Field{Key: "business_id", Value: businessID.String()},
+	)
+	s.metrics.Record(metrics.EventStaffInviteSent)
+	return invite, nil
+}
+
+// ListInvites - status verilməyibsə qəbul və ya ləğv edilməmiş dəvətlər (vaxtı keçənlər də, yenidən göndərmək üçün).
+// Dəvətin adı olmadığı üçün name sort və axtarış email üzrədir.
+func (s *StaffService) ListInvites(
+	ctx context.Context,
+	businessID uuid.UUID,
+	query listing.Query,
+) (*listing.Page[*BusinessInvite], error) {
+	ctx, span := tracer.Start(ctx, "staff.ListInvites")
+	defer span.End()
+
+	if businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
+	}
+	err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc,
+		string(InviteStatusPending), string(InviteStatusExpired), string(InviteStatusAccepted), string(InviteStatusRevoked))
+	if err != nil {
+		return nil, err
+	}
+
+	invites, err := s.repo.ListInvitesByBusiness(ctx, businessID, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invites: %w", err)
+	}
+
+	return listing.Paginate(invites, query, func(invite *BusinessInvite) listing.Key {
+		return listing.Key{ID: invite.ID, Name: invite.InvitedEmail, CreatedAt: invite.CreatedAt}
+	}), nil
+}
+
+// ResendInvite - köhnə token etibarsız olur, yeni token və müddət ilə yenidən göndərilir
+func (s *StaffService) ResendInvite(
+	ctx context.Context,
+	inviteID, businessID uuid.UUID,
+) (*BusinessInvite, error) {
+	ctx, span := tracer.Start(ctx, "staff.ResendInvite")
+	defer span.End()
+
+	invite, err := s.getPendingInvite(ctx, inviteID, businessID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invite.Token = hashToken(token)
+	invite.ExpiresAt = time.Now().Add(inviteTTL)
+	invite.UpdatedAt = time.Now()
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.RefreshInviteToken(ctx, invite.ID, businessID, invite.Token, invite.ExpiresAt); err != nil {
+			return fmt.Errorf("failed to refresh invite token: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaffInvite,
+			EntityID:   invite.ID,
+			Action:     audit.ActionResent,
+			Changes:    audit.Field("expires_at", nil, invite.ExpiresAt),
+		}); err != nil {
+			return err
+		}
+		return s.deliverInvite(ctx, invite, token)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Staff invite resent", logger.Field{Key: "invite_id", Value: invite.ID.String()})
+	return invite, nil
+}
+
+func (s *StaffService) RevokeInvite(
+	ctx context.Context,
+	inviteID, businessID uuid.UUID,
+) error {
+	ctx, span := tracer.Start(ctx, "staff.RevokeInvite")
+	defer span.End()
+
+	if _, err := s.getPendingInvite(ctx, inviteID, businessID); err != nil {
+		return err
 	}
 
-	if err := s.repo.CreateInvite(ctx, invite); err != nil {
-		return "", fmt.Errorf("failed to create invite: %w", err)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.RevokeInvite(ctx, inviteID, businessID); err != nil {
+			return fmt.Errorf("failed to revoke invite: %w", err)
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityStaffInvite,
+			EntityID:   inviteID,
+			Action:     audit.ActionRevoked,
+			Changes:    audit.Field("status", InviteStatusPending, InviteStatusRevoked),
+		}); err != nil {
+			return err
+		}
+		return events.Emit(ctx, s.events, events.StaffInviteRevoked, businessID, inviteID, events.Ref{ID: inviteID})
+	})
+	if err != nil {
+		return err
 	}
 
-	return token, nil
+	s.logger.WithContext(ctx).Info("Staff invite revoked", logger.Field{Key: "invite_id", Value: inviteID.String()})
+	return nil
 }
 
 func (s *StaffService) ValidateInviteToken(
 	ctx context.Context,
 	token string,
 ) (*BusinessInvite, error) {
+	ctx, span := tracer.Start(ctx, "staff.ValidateInviteToken")
+	defer span.End()
+
 	if token == "" {
-		return nil, &StaffError{Code: "INVALID_TOKEN", Message: "Invite token is required"}
+		return nil, apperr.Validation("INVALID_TOKEN", "Invite token is required")
 	}
 
-	invite, err := s.repo.GetInviteByToken(ctx, token)
+	// Token özü icazədir: dəvət istənilən biznesə aid ola bilər, axtarış tenant kontekstsiz aparılır
+	invite, err := s.repo.GetInviteByToken(tenant.Unscoped(ctx), hashToken(token))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get invite: %w", err)
 	}
 	if invite == nil {
-		return nil, &StaffError{Code: "INVALID_TOKEN", Message: "Invalid invite token"}
+		return nil, apperr.Validation("INVALID_TOKEN", "Invalid invite token")
 	}
 
 	if invite.Used {
-		return nil, &StaffError{Code: "TOKEN_USED", Message: "Invite token has already been used"}
+		return nil, apperr.Conflict("TOKEN_USED", "Invite token has already been used")
+	}
+
+	if invite.RevokedAt != nil {
+		return nil, apperr.Conflict("TOKEN_REVOKED", "Invite has been revoked")
 	}
 
 	if time.Now().After(invite.ExpiresAt) {
-		return nil, &StaffError{Code: "TOKEN_EXPIRED", Message: "Invite token has expired"}
+		return nil, apperr.Validation("TOKEN_EXPIRED", "Invite token has expired")
 	}
 
 	return invite, nil
 }
 
+// AcceptInvite - Dəvəti qəbul edir. Giriş edilməyibsə dəvət edilmiş email ilə yeni hesab yaradılır,
+// giriş edilibsə hesabın email-i dəvətdəki email ilə eyni olmalıdır. Hamısı tək tranzaksiyadadır.
 func (s *StaffService) AcceptInvite(
 	ctx context.Context,
-	userID uuid.UUID,
-	token, password string,
-) error {
-	if userID == uuid.Nil {
-		return &StaffError{Code: "INVALID_USER", Message: "User ID cannot be empty"}
-	}
-	if token == "" {
-		return &StaffError{Code: "INVALID_TOKEN", Message: "Invite token is required"}
+	currentUserID *uuid.UUID,
+	req *AcceptInviteRequest,
+) (*auth.AuthResponse, error) {
+	ctx, span := tracer.Start(ctx, "staff.AcceptInvite")
+	defer span.End()
+
+	if req == nil {
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
-	invite, err := s.ValidateInviteToken(ctx, token)
+	invite, err := s.ValidateInviteToken(ctx, req.Token)
 	if err != nil {
+		return nil, err
+	}
+	// İstifadəçinin cari biznesindən asılı olmayaraq yazılar dəvətin biznesinə aiddir
+	ctx = tenant.WithBusinessID(ctx, invite.BusinessID)
+
+	var authResp *auth.AuthResponse
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := s.resolveInvitedUser(ctx, invite, currentUserID, req)
+		if err != nil {
+			return err
+		}
+
+		existing, err := s.repo.GetStaffByUserID(ctx, user.ID, invite.BusinessID)
+		if err != nil {
+			return fmt.Errorf("failed to check staff profile: %w", err)
+		}
+		if existing != nil {
+			return apperr.Conflict("ALREADY_STAFF", "User is already a member of this business")
+		}
+
+		profile := NewStaffProfile(user.ID, invite.BusinessID, invite.Role, "Staff Member")
+		profile.LocationID = invite.LocationID
+		if err := s.repo.CreateStaffProfile(ctx, profile); err != nil {
+			return fmt.Errorf("failed to create staff profile: %w", err)
+		}
+
+		if err := s.repo.MarkInviteAsUsed(ctx, invite.ID); err != nil {
+			return fmt.Errorf("failed to mark invite as used: %w", err)
+		}
+		if err := events.Emit(ctx, s.events, events.StaffInviteAccepted, invite.BusinessID, invite.ID, newProfileEvent(profile)); err != nil {
+			return err
+		}
+
+		// Dəvəti qəbul edən (yeni yaradılmış ola bilər) istifadəçi dəyişikliyin aktorudur
+		ctx = audit.WithActor(ctx, user.ID)
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: invite.BusinessID,
+			EntityType: audit.EntityStaff,
+			EntityID:   profile.ID,
+			Action:     audit.ActionCreated,
+			Changes:    audit.Diff(nil, profile),
+		}); err != nil {
+			return err
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			BusinessID: invite.BusinessID,
+			EntityType: audit.EntityStaffInvite,
+			EntityID:   invite.ID,
+			Action:     audit.ActionAccepted,
+			Changes:    audit.Field("status", InviteStatusPending, InviteStatusAccepted),
+		}); err != nil {
+			return err
+		}
+
+		authResp, err = s.userService.IssueAuthResponse(ctx, user)
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Staff invite accepted",
+		logger.Field{Key: "invite_id", Value: invite.ID.String()},
+		logger.Field{Key: "business_id", Value: invite.BusinessID.String()},
+	)
+	s.metrics.Record(metrics.EventStaffInviteAccepted)
+	return authResp, nil
+}
+
+func (s *StaffService) resolveInvitedUser(
+	ctx context.Context,
+	invite *BusinessInvite,
+	currentUserID *uuid.UUID,
+	req *AcceptInviteRequest,
+) (*auth.User, error) {
+	if currentUserID == nil {
+		if invite.InvitedEmail == "" {
+			return nil, apperr.Unauthorized("LOGIN_REQUIRED", "Sign in to accept an invite sent by SMS")
+		}
+		phone := req.Phone
+		if phone == "" {
+			phone = invite.InvitedPhone
+		}
+		createReq := &auth.CreateStaffUserRequest{
+			Email:      invite.InvitedEmail,
+			Password:   req.Password,
+			FullName:   req.FullName,
+			Phone:      phone,
+			BusinessID: invite.BusinessID,
+		}
+		// SMS ilə də göndərilən dəvətin tokeni email-ə sahib olmağı sübut etmir
+		if invite.InvitedPhone == "" {
+			createReq.InvitedEmail = invite.InvitedEmail
+		}
+		return s.userService.CreateStaffUser(ctx, createReq)
+	}
+
+	user, err := s.userService.GetActiveUser(ctx, *currentUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !inviteMatchesUser(invite, user) {
+		return nil, apperr.Forbidden("INVITE_EMAIL_MISMATCH", "This invite was sent to a different account")
+	}
+	if err := s.userService.AttachUserToBusiness(ctx, user, invite.BusinessID); err != nil {
+		return nil, err
 	}
+	return user, nil
+}
 
-	if err := s.userService.UpdateUserBusinessID(ctx, userID, invite.BusinessID, false); err != nil {
-		return fmt.Errorf("failed to link user to business: %w", err)
+// inviteMatchesUser - email ilə dəvətdə email, yalnız telefonla dəvətdə telefon müqayisə olunur
+func inviteMatchesUser(invite *BusinessInvite, user *auth.User) bool {
+	if invite.InvitedEmail != "" {
+		return strings.EqualFold(strings.TrimSpace(invite.InvitedEmail), strings.TrimSpace(user.Email))
 	}
+	return invite.InvitedPhone != "" && normalizePhone(invite.InvitedPhone) == normalizePhone(user.Phone)
+}
+
+func normalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+}
 
-	profile := NewStaffProfile(userID, invite.BusinessID, invite.Role, "Staff Member")
-	profile.LocationID = invite.LocationID
+func (s *StaffService) getPendingInvite(
+	ctx context.Context,
+	inviteID, businessID uuid.UUID,
+) (*BusinessInvite, error) {
+	if inviteID == uuid.Nil || businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_ID", "Invite ID and Business ID are required")
+	}
 
-	if err := s.repo.CreateStaffProfile(ctx, profile); err != nil {
-		return fmt.Errorf("failed to create staff profile: %w", err)
+	invite, err := s.repo.GetInviteByID(ctx, inviteID, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invite: %w", err)
+	}
+	if invite == nil {
+		return nil, apperr.NotFound("INVITE_NOT_FOUND", "Invite not found")
+	}
+	if invite.Used {
+		return nil, apperr.Conflict("INVITE_ALREADY_ACCEPTED", "Invite has already been accepted")
+	}
+	if invite.RevokedAt != nil {
+		return nil, apperr.Conflict("INVITE_REVOKED", "Invite has been revoked")
 	}
 
-	if err := s.repo.MarkInviteAsUsed(ctx, invite.ID); err != nil {
-		return fmt.Errorf("failed to mark invite as used: %w", err)
+	return invite, nil
+}
+
+// deliverInvite - dəvəti email və/və ya SMS ilə növbəyə qoyur. Dəvət olunan hələ qeydiyyatdan
+// keçməyib, ona görə dəvət edənin dili istifadə olunur.
+func (s *StaffService) deliverInvite(ctx context.Context, invite *BusinessInvite, token string) error {
+	return s.notifier.Notify(ctx, notification.Message{
+		Template: notification.TemplateStaffInvite,
+		Email:    invite.InvitedEmail,
+		Phone:    invite.InvitedPhone,
+		Locale:   i18n.FromContext(ctx),
+		Data:     map[string]string{"url": fmt.Sprintf("%s/accept-invite?token=%s", s.frontendURL, token)},
+	})
+}
+
+func generateInviteToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
 	}
+	return hex.EncodeToString(tokenBytes), nil
+}
 
-	return nil
+func hashToken(token string) string {
+	h := sha256.New()
+	h.Write([]byte(token))
+	return fmt.Sprintf("%x", h.Sum(nil))
 }
@@ -6,23 +6,25 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 )
 
 func (s *StaffService) validateStaffProfile(profile *StaffProfile) error {
 	if profile == nil {
-		return &StaffError{Code: "INVALID_DATA", Message: "Staff profile data cannot be nil"}
+		return apperr.Validation("INVALID_DATA", "Staff profile data cannot be nil")
 	}
 
 	if profile.UserID == uuid.Nil {
-		return &StaffError{Code: "INVALID_USER", Message: "User ID cannot be empty"}
+		return apperr.Validation("INVALID_USER", "User ID cannot be empty")
 	}
 
 	if profile.BusinessID == uuid.Nil {
-		return &StaffError{Code: "INVALID_BUSINESS", Message: "Business ID cannot be empty"}
+		return apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
 	}
 
 	if !profile.Role.IsValid() {
-		return &StaffError{Code: "INVALID_ROLE", Message: "Invalid staff role"}
+		return apperr.InvalidField("role", "INVALID_ROLE", "Invalid staff role")
 	}
 
 	if err := s.validateTitle(profile.Title); err != nil {
@@ -34,15 +36,15 @@ func (s *StaffService) validateStaffProfile(profile *StaffProfile) error {
 
 func (s *StaffService) validateCreateRequest(req *CreateStaffRequest) error {
 	if req == nil {
-		return &StaffError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	if req.UserID == uuid.Nil {
-		return &StaffError{Code: "INVALID_USER", Message: "User ID cannot be empty"}
+		return apperr.Validation("INVALID_USER", "User ID cannot be empty")
 	}
 
 	if !req.Role.IsValid() {
-		return &StaffError{Code: "INVALID_ROLE", Message: "Invalid staff role"}
+		return apperr.InvalidField("role", "INVALID_ROLE", "Invalid staff role")
 	}
 
 	if err := s.validateTitle(req.Title); err != nil {
@@ -54,11 +56,11 @@ func (s *StaffService) validateCreateRequest(req *CreateStaffRequest) error {
 
 func (s *StaffService) validateInviteRequest(req *InviteStaffRequest) error {
 	if req == nil {
-		return &StaffError{Code: "INVALID_REQUEST", Message: "Request cannot be nil"}
+		return apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
 	}
 
 	if strings.TrimSpace(req.Email) == "" && strings.TrimSpace(req.Phone) == "" {
-		return &StaffError{Code: "CONTACT_REQUIRED", Message: "Either email or phone is required"}
+		return apperr.Validation("CONTACT_REQUIRED", "Either email or phone is required")
 	}
 
 	if strings.TrimSpace(req.Email) != "" {
@@ -74,7 +76,7 @@ func (s *StaffService) validateInviteRequest(req *InviteStaffRequest) error {
 	}
 
 	if !req.Role.IsValid() {
-		return &StaffError{Code: "INVALID_ROLE", Message: "Invalid staff role"}
+		return apperr.InvalidField("role", "INVALID_ROLE", "Invalid staff role")
 	}
 
 	return nil
@@ -83,13 +85,13 @@ func (s *StaffService) validateInviteRequest(req *InviteStaffRequest) error {
 func (s *StaffService) validateTitle(title string) error {
 	clean := strings.TrimSpace(title)
 	if clean == "" {
-		return &StaffError{Code: "TITLE_REQUIRED", Message: "Staff title is required"}
+		return apperr.InvalidField("title", "TITLE_REQUIRED", "Staff title is required")
 	}
 	if len(clean) < 2 {
-		return &StaffError{Code: "TITLE_TOO_SHORT", Message: "Staff title must be at least 2 characters"}
+		return apperr.InvalidField("title", "TITLE_TOO_SHORT", "Staff title must be at least 2 characters")
 	}
 	if len(clean) > 50 {
-		return &StaffError{Code: "TITLE_TOO_LONG", Message: "Staff title cannot exceed 50 characters"}
+		return apperr.InvalidField("title", "TITLE_TOO_LONG", "Staff title cannot exceed 50 characters")
 	}
 	return nil
 }
@@ -101,7 +103,7 @@ func (s *StaffService) validateEmail(email string) error {
 	}
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	if !emailRegex.MatchString(clean) {
-		return &StaffError{Code: "EMAIL_INVALID", Message: "Invalid email format"}
+		return apperr.InvalidField("email", "EMAIL_INVALID", "Invalid email format")
 	}
 	return nil
 }
@@ -113,7 +115,7 @@ func (s *StaffService) validatePhone(phone string) error {
 	}
 	phoneRegex := regexp.MustCompile(`^\+?[0-9]{7,15}$`)
 	if !phoneRegex.MatchString(clean) {
-		return &StaffError{Code: "PHONE_INVALID", Message: "Invalid phone format"}
+		return apperr.InvalidField("phone", "PHONE_INVALID", "Invalid phone format")
 	}
 	return nil
 }
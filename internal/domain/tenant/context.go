@@ -0,0 +1,43 @@
+// File: internal/domain/tenant/context.go
+package tenant
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type contextKey struct{}
+
+// scope - ctx-dəki tenant: ya konkret biznes, ya da açıq şəkildə bütün bizneslər (unscoped)
+type scope struct {
+	businessID uuid.UUID
+	unscoped   bool
+}
+
+// WithBusinessID - sorğunun aid olduğu biznes. Postgres bu dəyəri app.business_id kimi qurur
+// və row-level security başqa biznesin sətirlərini gizlədir.
+func WithBusinessID(ctx context.Context, businessID uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{businessID: businessID})
+}
+
+// Unscoped - hesab səviyyəli (bütün bizneslər üzrə), worker və ya token ilə icazə verilən əməliyyatlar
+// üçün row-level security-ni açıq şəkildə keçir (app.bypass_rls). Tenant qurulmayan ctx heç bir
+// tenant sətrini görmür. Yalnız icazə başqa yolla yoxlanıldıqda istifadə olunmalıdır.
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{unscoped: true})
+}
+
+func BusinessID(ctx context.Context) (uuid.UUID, bool) {
+	current, ok := ctx.Value(contextKey{}).(scope)
+	if !ok || current.unscoped || current.businessID == uuid.Nil {
+		return uuid.Nil, false
+	}
+	return current.businessID, true
+}
+
+// IsUnscoped - ctx Unscoped ilə işarələnibsə true
+func IsUnscoped(ctx context.Context) bool {
+	current, ok := ctx.Value(contextKey{}).(scope)
+	return ok && current.unscoped
+}
@@ -0,0 +1,10 @@
+// File: internal/domain/transaction/ports.go
+package transaction
+
+import "context"
+
+// Manager - bir neçə repository əməliyyatını tək tranzaksiyada icra edir.
+// fn-ə ötürülən ctx tranzaksiyanı daşıyır; repository-lər həmin ctx ilə çağırılmalıdır.
+type Manager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
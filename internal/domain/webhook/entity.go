@@ -0,0 +1,189 @@
+// File: internal/domain/webhook/entity.go
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAttempts - bu qədər uğursuz cəhddən sonra çatdırılma failed olur (əl ilə redeliver edilə bilər)
+	MaxAttempts = 8
+	// MaxEndpointsPerBusiness - bir biznesin qeydiyyatdan keçirə biləcəyi endpoint sayı
+	MaxEndpointsPerBusiness = 10
+	maxURLLength            = 2048
+	baseBackoff             = 30 * time.Second
+	maxBackoff              = 6 * time.Hour
+	secretPrefix            = "whsec_"
+)
+
+var (
+	ErrEndpointNotFound = apperr.NotFound("WEBHOOK_NOT_FOUND", "Webhook endpoint not found")
+	ErrDeliveryNotFound = apperr.NotFound("WEBHOOK_DELIVERY_NOT_FOUND", "Webhook delivery not found")
+	ErrTooManyEndpoints = apperr.Conflict("WEBHOOK_LIMIT_REACHED", "Maximum number of webhook endpoints reached")
+)
+
+// Endpoint siyahısının status filtri
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Endpoint - biznesin hadisələri qəbul edən URL-i. Secret yalnız yaradılanda klientə göstərilir,
+// verilənlər bazasında şifrəli saxlanır.
+type Endpoint struct {
+	ID          uuid.UUID     `json:"id"`
+	BusinessID  uuid.UUID     `json:"business_id"`
+	URL         string        `json:"url"`
+	Description string        `json:"description"`
+	EventTypes  []events.Type `json:"event_types"`
+	Secret      string        `json:"-"`
+	IsActive    bool          `json:"is_active"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// Delivery - bir hadisənin bir endpoint-ə çatdırılması; jurnal kimi saxlanır.
+// Redeliver yeni Delivery yaradır, RedeliveryOf ilkinə işarə edir.
+type Delivery struct {
+	ID             uuid.UUID      `json:"id"`
+	EndpointID     uuid.UUID      `json:"endpoint_id"`
+	BusinessID     uuid.UUID      `json:"business_id"`
+	EventID        uuid.UUID      `json:"event_id"`
+	EventType      events.Type    `json:"event_type"`
+	Payload        []byte         `json:"-"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	NextAttemptAt  *time.Time     `json:"next_attempt_at,omitempty"`
+	ResponseStatus *int           `json:"response_status,omitempty"`
+	ResponseBody   string         `json:"response_body,omitempty"`
+	LastError      string         `json:"last_error,omitempty"`
+	DurationMs     *int64         `json:"duration_ms,omitempty"`
+	RedeliveryOf   *uuid.UUID     `json:"redelivery_of,omitempty"`
+	DeliveredAt    *time.Time     `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// Target - worker-in göndərmək üçün götürdüyü çatdırılma və endpoint-in cari URL/secret-i
+type Target struct {
+	Delivery *Delivery
+	URL      string
+	Secret   string
+}
+
+// Attempt - bir HTTP cəhdinin nəticəsi
+type Attempt struct {
+	StatusCode int
+	Body       string
+	Err        error
+	Duration   time.Duration
+}
+
+func (a Attempt) Succeeded() bool {
+	return a.Err == nil && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+type CreateEndpointRequest struct {
+	URL         string        `json:"url"`
+	Description string        `json:"description"`
+	EventTypes  []events.Type `json:"event_types"`
+}
+
+type UpdateEndpointRequest struct {
+	URL         string        `json:"url"`
+	Description string        `json:"description"`
+	EventTypes  []events.Type `json:"event_types"`
+	// IsActive - nil olduqda cari vəziyyət saxlanır
+	IsActive *bool `json:"is_active,omitempty"`
+}
+
+// Envelope - endpoint-ə göndərilən JSON gövdə
+type Envelope struct {
+	ID         uuid.UUID   `json:"id"`
+	Type       events.Type `json:"type"`
+	BusinessID uuid.UUID   `json:"business_id"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+// Backoff - attempts uğursuz cəhddən sonra gözləmə (30s, 1m, 2m ... 6 saat)
+func Backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+	return delay
+}
+
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("webhook secret generation failed: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
+
+func validateEndpoint(rawURL string, eventTypes []events.Type) error {
+	var details []*apperr.Error
+
+	parsed, err := url.Parse(rawURL)
+	switch {
+	case rawURL == "" || len(rawURL) > maxURLLength:
+		details = append(details, apperr.InvalidField("url", "INVALID_WEBHOOK_URL", "url must be an absolute http(s) URL"))
+	case err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http"):
+		details = append(details, apperr.InvalidField("url", "INVALID_WEBHOOK_URL", "url must be an absolute http(s) URL"))
+	case parsed.User != nil:
+		details = append(details, apperr.InvalidField("url", "INVALID_WEBHOOK_URL", "url must not contain credentials"))
+	}
+
+	if len(eventTypes) == 0 {
+		details = append(details, apperr.InvalidField("event_types", "INVALID_EVENT_TYPES", "at least one event type is required"))
+	}
+	for _, t := range eventTypes {
+		if !t.Valid() {
+			details = append(details, apperr.InvalidField("event_types", "INVALID_EVENT_TYPES", "unknown event type: "+string(t)))
+			break
+		}
+	}
+
+	if len(details) == 1 {
+		return details[0]
+	}
+	if len(details) > 1 {
+		return apperr.InvalidFields(details...)
+	}
+	return nil
+}
+
+func normalizeEventTypes(eventTypes []events.Type) []events.Type {
+	seen := make(map[events.Type]bool, len(eventTypes))
+	result := make([]events.Type, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		t = events.Type(strings.TrimSpace(string(t)))
+		if !seen[t] {
+			seen[t] = true
+			result = append(result, t)
+		}
+	}
+	return result
+}
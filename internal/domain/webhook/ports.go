@@ -0,0 +1,53 @@
+// File: internal/domain/webhook/ports.go
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	CreateEndpoint(ctx context.Context, endpoint *Endpoint) error
+	CountEndpoints(ctx context.Context, businessID uuid.UUID) (int, error)
+	GetEndpoint(ctx context.Context, id, businessID uuid.UUID) (*Endpoint, error)
+	ListEndpoints(ctx context.Context, businessID uuid.UUID, query listing.Query) ([]*Endpoint, error)
+	// ListSubscribed - hadisə tipinə abunə olan aktiv endpoint-lər
+	ListSubscribed(ctx context.Context, businessID uuid.UUID, eventType events.Type) ([]*Endpoint, error)
+	UpdateEndpoint(ctx context.Context, endpoint *Endpoint) error
+	DeleteEndpoint(ctx context.Context, id, businessID uuid.UUID) error
+
+	// CreateDelivery - eyni hadisə eyni endpoint üçün artıq növbədədirsə false qaytarır
+	CreateDelivery(ctx context.Context, delivery *Delivery) (bool, error)
+	GetDelivery(ctx context.Context, id, businessID uuid.UUID) (*Delivery, error)
+	ListDeliveries(ctx context.Context, endpointID, businessID uuid.UUID, query listing.Query) ([]*Delivery, error)
+	// ClaimDue - vaxtı çatmış çatdırılmaları lease müddətinə götürür (SKIP LOCKED)
+	ClaimDue(ctx context.Context, limit int, lease time.Duration, now time.Time) ([]*Target, error)
+	SaveAttempt(ctx context.Context, delivery *Delivery) error
+	PendingDeliveries(ctx context.Context) (int, error)
+}
+
+// Request - imzalanmış HTTP sorğu
+type Request struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+// Sender - çatdırılmanın nəqliyyatı (infrastructure/webhook HTTP implementasiyası)
+type Sender interface {
+	Send(ctx context.Context, req Request) Attempt
+}
+
+type Service interface {
+	CreateEndpoint(ctx context.Context, businessID uuid.UUID, req *CreateEndpointRequest) (*Endpoint, error)
+	GetEndpoint(ctx context.Context, id, businessID uuid.UUID) (*Endpoint, error)
+	ListEndpoints(ctx context.Context, businessID uuid.UUID, query listing.Query) (*listing.Page[*Endpoint], error)
+	UpdateEndpoint(ctx context.Context, id, businessID uuid.UUID, req *UpdateEndpointRequest) (*Endpoint, error)
+	DeleteEndpoint(ctx context.Context, id, businessID uuid.UUID) error
+	ListDeliveries(ctx context.Context, endpointID, businessID uuid.UUID, query listing.Query) (*listing.Page[*Delivery], error)
+	Redeliver(ctx context.Context, deliveryID, businessID uuid.UUID) (*Delivery, error)
+}
@@ -0,0 +1,469 @@
+// File: internal/domain/webhook/service.go
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/transaction"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var tracer = otel.Tracer("github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook")
+
+const (
+	maxDescriptionLength = 255
+	userAgent            = "booking-service-webhooks/1.0"
+)
+
+type WebhookService struct {
+	repo      Repository
+	txManager transaction.Manager
+	sender    Sender
+	logger    logger.Logger
+	audit     audit.Recorder
+}
+
+func NewService(
+	repo Repository,
+	txManager transaction.Manager,
+	sender Sender,
+	appLogger logger.Logger,
+	auditRecorder audit.Recorder,
+) *WebhookService {
+	return &WebhookService{
+		repo:      repo,
+		txManager: txManager,
+		sender:    sender,
+		logger:    appLogger,
+		audit:     auditRecorder,
+	}
+}
+
+func (s *WebhookService) CreateEndpoint(
+	ctx context.Context,
+	businessID uuid.UUID,
+	req *CreateEndpointRequest,
+) (*Endpoint, error) {
+	ctx, span := tracer.Start(ctx, "webhook.CreateEndpoint")
+	defer span.End()
+
+	if businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
+	}
+	if req == nil {
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
+	}
+
+	endpoint := &Endpoint{
+		ID:          uuid.New(),
+		BusinessID:  businessID,
+		URL:         strings.TrimSpace(req.URL),
+		Description: strings.TrimSpace(req.Description),
+		EventTypes:  normalizeEventTypes(req.EventTypes),
+		IsActive:    true,
+	}
+	if err := validate(endpoint); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountEndpoints(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+	if count >= MaxEndpointsPerBusiness {
+		return nil, ErrTooManyEndpoints
+	}
+
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpoint.Secret = secret
+	now := time.Now().UTC()
+	endpoint.CreatedAt = now
+	endpoint.UpdatedAt = now
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateEndpoint(ctx, endpoint); err != nil {
+			return fmt.Errorf("failed to create webhook endpoint: %w", err)
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityWebhookEndpoint,
+			EntityID:   endpoint.ID,
+			Action:     audit.ActionCreated,
+			Changes:    audit.Diff(nil, endpoint),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Webhook endpoint created",
+		logger.Field{Key: "endpoint_id", Value: endpoint.ID.String()},
+		logger.Field{Key: "business_id", Value: businessID.String()},
+	)
+	return endpoint, nil
+}
+
+func (s *WebhookService) GetEndpoint(ctx context.Context, id, businessID uuid.UUID) (*Endpoint, error) {
+	ctx, span := tracer.Start(ctx, "webhook.GetEndpoint")
+	defer span.End()
+
+	if id == uuid.Nil || businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_ID", "Endpoint ID and Business ID are required")
+	}
+	return s.repo.GetEndpoint(ctx, id, businessID)
+}
+
+func (s *WebhookService) ListEndpoints(
+	ctx context.Context,
+	businessID uuid.UUID,
+	query listing.Query,
+) (*listing.Page[*Endpoint], error) {
+	ctx, span := tracer.Start(ctx, "webhook.ListEndpoints")
+	defer span.End()
+
+	if businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_BUSINESS", "Business ID cannot be empty")
+	}
+	if err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc, StatusActive, StatusInactive); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.repo.ListEndpoints(ctx, businessID, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	return listing.Paginate(endpoints, query, endpointKey), nil
+}
+
+// endpointKey - name sıralaması URL üzrədir
+func endpointKey(endpoint *Endpoint) listing.Key {
+	return listing.Key{ID: endpoint.ID, Name: endpoint.URL, CreatedAt: endpoint.CreatedAt}
+}
+
+func (s *WebhookService) UpdateEndpoint(
+	ctx context.Context,
+	id, businessID uuid.UUID,
+	req *UpdateEndpointRequest,
+) (*Endpoint, error) {
+	ctx, span := tracer.Start(ctx, "webhook.UpdateEndpoint")
+	defer span.End()
+
+	if id == uuid.Nil || businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_ID", "Endpoint ID and Business ID are required")
+	}
+	if req == nil {
+		return nil, apperr.Validation("INVALID_REQUEST", "Request cannot be nil")
+	}
+
+	endpoint, err := s.repo.GetEndpoint(ctx, id, businessID)
+	if err != nil {
+		return nil, err
+	}
+	before := *endpoint
+
+	endpoint.URL = strings.TrimSpace(req.URL)
+	endpoint.Description = strings.TrimSpace(req.Description)
+	endpoint.EventTypes = normalizeEventTypes(req.EventTypes)
+	if req.IsActive != nil {
+		endpoint.IsActive = *req.IsActive
+	}
+	if err := validate(endpoint); err != nil {
+		return nil, err
+	}
+	endpoint.UpdatedAt = time.Now().UTC()
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateEndpoint(ctx, endpoint); err != nil {
+			return fmt.Errorf("failed to update webhook endpoint: %w", err)
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityWebhookEndpoint,
+			EntityID:   endpoint.ID,
+			Action:     audit.ActionUpdated,
+			Changes:    audit.Diff(&before, endpoint),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Webhook endpoint updated", logger.Field{Key: "endpoint_id", Value: id.String()})
+	return endpoint, nil
+}
+
+// DeleteEndpoint - endpoint çatdırılma jurnalı ilə birlikdə silinir
+func (s *WebhookService) DeleteEndpoint(ctx context.Context, id, businessID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "webhook.DeleteEndpoint")
+	defer span.End()
+
+	if id == uuid.Nil || businessID == uuid.Nil {
+		return apperr.Validation("INVALID_ID", "Endpoint ID and Business ID are required")
+	}
+
+	endpoint, err := s.repo.GetEndpoint(ctx, id, businessID)
+	if err != nil {
+		return err
+	}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.DeleteEndpoint(ctx, id, businessID); err != nil {
+			return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			BusinessID: businessID,
+			EntityType: audit.EntityWebhookEndpoint,
+			EntityID:   id,
+			Action:     audit.ActionDeleted,
+			Changes:    audit.Diff(endpoint, nil),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithContext(ctx).Info("Webhook endpoint deleted", logger.Field{Key: "endpoint_id", Value: id.String()})
+	return nil
+}
+
+func (s *WebhookService) ListDeliveries(
+	ctx context.Context,
+	endpointID, businessID uuid.UUID,
+	query listing.Query,
+) (*listing.Page[*Delivery], error) {
+	ctx, span := tracer.Start(ctx, "webhook.ListDeliveries")
+	defer span.End()
+
+	if endpointID == uuid.Nil || businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_ID", "Endpoint ID and Business ID are required")
+	}
+	if query.Sort == listing.SortName {
+		return nil, apperr.InvalidField("sort", "INVALID_DELIVERY_SORT", "sort must be created_at")
+	}
+	if err := query.Normalize(listing.SortCreatedAt, listing.OrderDesc,
+		string(DeliveryPending), string(DeliverySucceeded), string(DeliveryFailed)); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetEndpoint(ctx, endpointID, businessID); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.repo.ListDeliveries(ctx, endpointID, businessID, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return listing.Paginate(deliveries, query, deliveryKey), nil
+}
+
+func deliveryKey(delivery *Delivery) listing.Key {
+	return listing.Key{ID: delivery.ID, CreatedAt: delivery.CreatedAt}
+}
+
+// Redeliver - eyni payload ilə yeni çatdırılma növbəyə qoyur; ilkin qeyd jurnalda dəyişmədən qalır
+func (s *WebhookService) Redeliver(ctx context.Context, deliveryID, businessID uuid.UUID) (*Delivery, error) {
+	ctx, span := tracer.Start(ctx, "webhook.Redeliver")
+	defer span.End()
+
+	if deliveryID == uuid.Nil || businessID == uuid.Nil {
+		return nil, apperr.Validation("INVALID_ID", "Delivery ID and Business ID are required")
+	}
+
+	original, err := s.repo.GetDelivery(ctx, deliveryID, businessID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	delivery := &Delivery{
+		ID:            uuid.New(),
+		EndpointID:    original.EndpointID,
+		BusinessID:    original.BusinessID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: &now,
+		RedeliveryOf:  &original.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if _, err := s.repo.CreateDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to queue webhook redelivery: %w", err)
+	}
+
+	s.logger.WithContext(ctx).Info("Webhook redelivery queued",
+		logger.Field{Key: "delivery_id", Value: delivery.ID.String()},
+		logger.Field{Key: "redelivery_of", Value: original.ID.String()},
+	)
+	return delivery, nil
+}
+
+// HandleEvent - outbox relay-in abunəçisi: hadisəyə abunə olan hər aktiv endpoint üçün çatdırılma yaradır.
+// Relay hadisəni təkrar ötürsə unikal indeks ikinci çatdırılmanın yaranmasına imkan vermir.
+func (s *WebhookService) HandleEvent(ctx context.Context, event *events.Event) error {
+	ctx, span := tracer.Start(ctx, "webhook.HandleEvent")
+	defer span.End()
+
+	endpoints, err := s.repo.ListSubscribed(ctx, event.BusinessID, event.Type)
+	if err != nil {
+		return fmt.Errorf("failed to list subscribed webhook endpoints: %w", err)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(Envelope{
+		ID:         event.ID,
+		Type:       event.Type,
+		BusinessID: event.BusinessID,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for _, endpoint := range endpoints {
+		delivery := &Delivery{
+			ID:            uuid.New(),
+			EndpointID:    endpoint.ID,
+			BusinessID:    event.BusinessID,
+			EventID:       event.ID,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        DeliveryPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if _, err := s.repo.CreateDelivery(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to queue webhook delivery: %w", err)
+		}
+	}
+	return nil
+}
+
+// DeliverDue - vaxtı çatmış çatdırılmaları göndərir, göndərilənlərin sayını qaytarır
+func (s *WebhookService) DeliverDue(ctx context.Context, limit int, lease time.Duration) (int, error) {
+	targets, err := s.repo.ClaimDue(ctx, limit, lease, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	for _, target := range targets {
+		if ctx.Err() != nil {
+			break
+		}
+		s.deliver(ctx, target)
+	}
+	return len(targets), nil
+}
+
+func (s *WebhookService) PendingDeliveries(ctx context.Context) (int, error) {
+	return s.repo.PendingDeliveries(ctx)
+}
+
+// deliver - bir cəhd: imzalayır, göndərir və nəticəni jurnala yazır.
+// Uğursuz cəhd backoff ilə yenidən planlanır, MaxAttempts-dan sonra failed olur.
+func (s *WebhookService) deliver(ctx context.Context, target *Target) {
+	delivery := target.Delivery
+	ctx, span := tracer.Start(ctx, "webhook.Deliver")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("webhook.delivery_id", delivery.ID.String()),
+		attribute.String("webhook.endpoint_id", delivery.EndpointID.String()),
+		attribute.String("event.type", string(delivery.EventType)),
+		attribute.Int("webhook.attempt", delivery.Attempts+1),
+	)
+
+	timestamp := time.Now().UTC()
+	attempt := s.sender.Send(ctx, Request{
+		URL: target.URL,
+		Headers: map[string]string{
+			"Content-Type":  "application/json",
+			"User-Agent":    userAgent,
+			IDHeader:        delivery.EventID.String(),
+			EventHeader:     string(delivery.EventType),
+			TimestampHeader: fmt.Sprintf("%d", timestamp.Unix()),
+			SignatureHeader: Sign(target.Secret, timestamp, delivery.Payload),
+		},
+		Body: delivery.Payload,
+	})
+
+	now := time.Now().UTC()
+	durationMs := attempt.Duration.Milliseconds()
+	delivery.Attempts++
+	delivery.DurationMs = &durationMs
+	delivery.ResponseBody = attempt.Body
+	delivery.ResponseStatus = nil
+	if attempt.StatusCode != 0 {
+		statusCode := attempt.StatusCode
+		delivery.ResponseStatus = &statusCode
+	}
+	delivery.LastError = ""
+	delivery.UpdatedAt = now
+
+	fields := []logger.Field{
+		{Key: "delivery_id", Value: delivery.ID.String()},
+		{Key: "endpoint_id", Value: delivery.EndpointID.String()},
+		{Key: "attempt", Value: delivery.Attempts},
+	}
+	switch {
+	case attempt.Succeeded():
+		delivery.Status = DeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+	default:
+		delivery.LastError = attemptError(attempt)
+		span.SetStatus(codes.Error, delivery.LastError)
+		fields = append(fields, logger.Field{Key: "error", Value: delivery.LastError})
+		if delivery.Attempts >= MaxAttempts {
+			delivery.Status = DeliveryFailed
+			delivery.NextAttemptAt = nil
+			s.logger.WithContext(ctx).Error("Webhook delivery abandoned", fields...)
+		} else {
+			retryAt := now.Add(Backoff(delivery.Attempts))
+			delivery.NextAttemptAt = &retryAt
+			s.logger.WithContext(ctx).Warn("Webhook delivery failed, will retry", fields...)
+		}
+	}
+
+	if err := s.repo.SaveAttempt(ctx, delivery); err != nil {
+		// Lease bitdikdən sonra çatdırılma yenidən göndəriləcək - qəbul edən Webhook-Id ilə dublikatı tanıyır
+		s.logger.WithContext(ctx).Error("Failed to save webhook delivery attempt",
+			logger.Field{Key: "delivery_id", Value: delivery.ID.String()},
+			logger.Field{Key: "error", Value: err.Error()},
+		)
+	}
+}
+
+func attemptError(attempt Attempt) string {
+	if attempt.Err != nil {
+		return attempt.Err.Error()
+	}
+	return fmt.Sprintf("unexpected response status %d", attempt.StatusCode)
+}
+
+func validate(endpoint *Endpoint) error {
+	if err := validateEndpoint(endpoint.URL, endpoint.EventTypes); err != nil {
+		return err
+	}
+	if len(endpoint.Description) > maxDescriptionLength {
+		return apperr.InvalidField("description", "INVALID_DESCRIPTION", "description must be at most 255 characters")
+	}
+	return nil
+}
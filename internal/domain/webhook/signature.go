@@ -0,0 +1,58 @@
+// File: internal/domain/webhook/signature.go
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SignatureHeader = "Webhook-Signature"
+	IDHeader        = "Webhook-Id"
+	EventHeader     = "Webhook-Event"
+	TimestampHeader = "Webhook-Timestamp"
+	// SignatureTolerance - qəbul edən tərəf bu müddətdən köhnə imzanı rədd etməlidir (replay)
+	SignatureTolerance = 5 * time.Minute
+)
+
+// Sign - "t=<unix>,v1=<hex>" formatında imza; HMAC-SHA256(secret, "<unix>.<body>").
+// Timestamp imzaya daxildir ki, tutulmuş sorğu sonradan təkrar göndərilə bilməsin.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + unix + ",v1=" + computeSignature(secret, unix, body)
+}
+
+// Verify - qəbul edən tərəfin yoxlaması (dokumentasiya və inteqrasiya testləri üçün)
+func Verify(secret, header string, body []byte, now time.Time) bool {
+	var unix, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			signature = value
+		}
+	}
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil || signature == "" {
+		return false
+	}
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > SignatureTolerance || age < -SignatureTolerance {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(computeSignature(secret, unix, body)))
+}
+
+func computeSignature(secret, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
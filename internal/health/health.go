@@ -0,0 +1,75 @@
+// File: internal/health/health.go
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// Checker - readiness yoxlaması (Postgres, job queue və s.)
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type checkFunc struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// NewCheck - funksiyanı Checker-ə çevirir
+func NewCheck(name string, fn func(ctx context.Context) error) Checker {
+	return checkFunc{name: name, fn: fn}
+}
+
+func (c checkFunc) Name() string                    { return c.name }
+func (c checkFunc) Check(ctx context.Context) error { return c.fn(ctx) }
+
+// CheckResult - xəta mətni (host, driver mesajı) public cavaba yazılmır, yalnız log-a düşür
+type CheckResult struct {
+	Status string `json:"status"`
+	Err    error  `json:"-"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+func (r Report) Healthy() bool {
+	return r.Status == StatusOK
+}
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Run - bütün yoxlamaları paralel icra edir, hər biri timeout ilə məhdudlaşır
+func Run(ctx context.Context, timeout time.Duration, checks ...Checker) Report {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(checks))
+	for _, c := range checks {
+		go func(c Checker) {
+			results <- result{name: c.Name(), err: c.Check(ctx)}
+		}(c)
+	}
+
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(checks))}
+	for range checks {
+		res := <-results
+		if res.err != nil {
+			report.Status = StatusFail
+			report.Checks[res.name] = CheckResult{Status: StatusFail, Err: res.err}
+			continue
+		}
+		report.Checks[res.name] = CheckResult{Status: StatusOK}
+	}
+	return report
+}
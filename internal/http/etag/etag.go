@@ -0,0 +1,51 @@
+// File: internal/http/etag/etag.go
+package etag
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+)
+
+// AnyVersion - "If-Match: *" versiya yoxlamasını söndürür (domain-də 0 kimi ötürülür)
+const AnyVersion = 0
+
+var (
+	errIfMatchRequired = apperr.PreconditionRequired("IF_MATCH_REQUIRED", "If-Match header is required for updates")
+	errInvalidIfMatch  = apperr.Validation("INVALID_IF_MATCH", "If-Match header is malformed")
+)
+
+// Format - versiyadan güclü (strong) ETag yaradır: "3"
+func Format(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+func Set(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", Format(version))
+}
+
+// IfMatch - PUT sorğularında məcburidir. Zəif (W/) tag-lar güclü müqayisədə heç vaxt uyğun gəlmir.
+func IfMatch(r *http.Request) (int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" {
+		return 0, errIfMatchRequired
+	}
+	if value == "*" {
+		return AnyVersion, nil
+	}
+	if strings.HasPrefix(value, "W/") {
+		return 0, apperr.ErrVersionMismatch
+	}
+
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, errInvalidIfMatch
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, errInvalidIfMatch
+	}
+	return version, nil
+}
@@ -0,0 +1,62 @@
+// File: internal/http/handlers/audit/dto.go
+package audit
+
+import (
+	"time"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/google/uuid"
+)
+
+type ChangeResponse struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+type EventResponse struct {
+	ID         uuid.UUID                 `json:"id"`
+	BusinessID uuid.UUID                 `json:"business_id"`
+	ActorID    *uuid.UUID                `json:"actor_id,omitempty"`
+	EntityType string                    `json:"entity_type" example:"service"`
+	EntityID   uuid.UUID                 `json:"entity_id"`
+	Action     string                    `json:"action" example:"updated"`
+	Changes    map[string]ChangeResponse `json:"changes"`
+	IP         string                    `json:"ip,omitempty" example:"203.0.113.7"`
+	RequestID  string                    `json:"request_id,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+type SuccessResponse struct {
+	Success bool             `json:"success"`
+	Data    interface{}      `json:"data,omitempty"`
+	Meta    *pagination.Meta `json:"meta,omitempty"`
+	Message string           `json:"message,omitempty"`
+}
+
+func FromDomainEvent(event *domain.Event) EventResponse {
+	changes := make(map[string]ChangeResponse, len(event.Changes))
+	for field, change := range event.Changes {
+		changes[field] = ChangeResponse{Before: change.Before, After: change.After}
+	}
+	return EventResponse{
+		ID:         event.ID,
+		BusinessID: event.BusinessID,
+		ActorID:    event.ActorID,
+		EntityType: string(event.EntityType),
+		EntityID:   event.EntityID,
+		Action:     string(event.Action),
+		Changes:    changes,
+		IP:         event.IP,
+		RequestID:  event.RequestID,
+		CreatedAt:  event.CreatedAt,
+	}
+}
+
+func FromDomainEvents(events []*domain.Event) []EventResponse {
+	result := make([]EventResponse, 0, len(events))
+	for _, event := range events {
+		result = append(result, FromDomainEvent(event))
+	}
+	return result
+}
@@ -0,0 +1,132 @@
+// File: internal/http/handlers/audit/handler.go
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/google/uuid"
+)
+
+// ErrOwnerOnly - route-un sahiblik yoxlaması (middleware.OwnerMiddleware) sahib olmayana qaytarır
+var ErrOwnerOnly = apperr.Forbidden("AUDIT_OWNER_ONLY", "Only business owners can view the audit log")
+
+type Handler struct {
+	service domain.Service
+}
+
+func NewHandler(service domain.Service) Handler {
+	return Handler{service: service}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+// @Summary      List Audit Events
+// @Description  Returns the append-only audit log of administrative changes (business, locations, services, staff, invites and webhook endpoints) for the authenticated business, newest first. Available to business owners (the primary owner and co-owners) only. Each event carries the actor, IP, request ID and a before/after diff of the changed fields; encrypted fields are shown as "[redacted]".
+// @Tags         Audit
+// @Produce      json
+// @Security     BearerAuth
+// @Param        entity_type query string false "Filter by entity type" Enums(business, business_owner, ownership_transfer, location, service, staff_service, staff, staff_invite, webhook_endpoint)
+// @Param        entity_id query string false "Filter by entity ID (UUID)"
+// @Param        action query string false "Filter by action" Enums(created, updated, deactivated, added, removed, assigned, initiated, confirmed, cancelled, invited, resent, revoked, accepted, deleted)
+// @Param        actor_id query string false "Filter by acting user ID (UUID)"
+// @Param        from query string false "Only events at or after this time (RFC 3339)"
+// @Param        to query string false "Only events before this time (RFC 3339)"
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Number of events to skip (cannot be combined with cursor)"
+// @Param        cursor query string false "Opaque cursor from meta.next_cursor of the previous page"
+// @Param        order query string false "Sort direction by created_at" Enums(asc, desc)
+// @Success      200  {object}  SuccessResponse "Audit events retrieved successfully (array of EventResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid filter or pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can view the audit log"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/audit [get]
+func (h Handler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := r.Context().Value(middleware.BusinessKey).(uuid.UUID)
+	if !ok || businessID == uuid.Nil {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	query, err := pagination.ParseQuery(r)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+	filter, err := parseFilter(r)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.ListEvents(r.Context(), businessID, filter, query)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{
+		Success: true,
+		Data:    FromDomainEvents(page.Items),
+		Meta:    pagination.MetaOf(page),
+	})
+}
+
+func parseFilter(r *http.Request) (domain.Filter, error) {
+	values := r.URL.Query()
+	filter := domain.Filter{
+		EntityType: domain.EntityType(values.Get("entity_type")),
+		Action:     domain.Action(values.Get("action")),
+	}
+
+	var details []*apperr.Error
+	if raw := values.Get("entity_id"); raw != "" {
+		if id, err := uuid.Parse(raw); err == nil {
+			filter.EntityID = &id
+		} else {
+			details = append(details, apperr.InvalidField("entity_id", "INVALID_ID", "entity_id must be a UUID"))
+		}
+	}
+	if raw := values.Get("actor_id"); raw != "" {
+		if id, err := uuid.Parse(raw); err == nil {
+			filter.ActorID = &id
+		} else {
+			details = append(details, apperr.InvalidField("actor_id", "INVALID_ID", "actor_id must be a UUID"))
+		}
+	}
+	for _, param := range []struct {
+		name   string
+		target **time.Time
+	}{{"from", &filter.From}, {"to", &filter.To}} {
+		raw := values.Get(param.name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			details = append(details, apperr.InvalidField(param.name, "INVALID_TIME", param.name+" must be an RFC 3339 timestamp"))
+			continue
+		}
+		*param.target = &parsed
+	}
+
+	if len(details) == 1 {
+		return filter, details[0]
+	}
+	if len(details) > 1 {
+		return filter, apperr.InvalidFields(details...)
+	}
+	return filter, nil
+}
@@ -0,0 +1,232 @@
+package auth
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+// @Summary      Change Password
+// @Description  Changes the password of the authenticated user. Requires the current password. All other sessions are revoked; pass the current refresh token to keep this session alive.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body ChangePasswordHTTPRequest true "Current and new password"
+// @Success      200  {object}  SuccessResponseDTO "Password changed, other sessions revoked"
+// @Failure      400  {object}  problem.Problem "Validation error"
+// @Failure      401  {object}  problem.Problem "Unauthorized or current password is incorrect"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/auth/change-password [post]
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := userIDFromContext(ctx)
+	if !ok {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	var httpReq ChangePasswordHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		problem.Write(w, r, problem.ErrInvalidBody)
+		return
+	}
+
+	err := h.authService.ChangePassword(ctx, userID, &auth.ChangePasswordRequest{
+		CurrentPassword: httpReq.CurrentPassword,
+		NewPassword:     httpReq.NewPassword,
+		RefreshToken:    httpReq.RefreshToken,
+	})
+	if err != nil {
+		h.handleAccountError(w, r, "ChangePassword", err)
+		return
+	}
+
+	h.logger.WithContext(r.Context()).Info("ChangePassword: password changed", logger.Field{Key: "user_id", Value: userID.String()})
+	h.sendJSON(w, http.StatusOK, SuccessResponseDTO{
+		Success: true,
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.password_changed"),
+	})
+}
+
+// @Summary      Export Account Data
+// @Description  Returns all personal data of the authenticated user (profile, sessions, staff profiles) as JSON, or as a ZIP archive with format=zip (manifest.json, profile.json, sessions.json and staff_profiles.json; each section appears once).
+// @Tags         Account
+// @Produce      json
+// @Produce      application/zip
+// @Security     BearerAuth
+// @Param        format query string false "json (default) or zip"
+// @Success      200  {object}  auth.UserDataExport "Personal data export"
+// @Failure      400  {object}  problem.Problem "Unsupported format"
+// @Failure      401  {object}  problem.Problem "Unauthorized"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/account/export [get]
+func (h *Handler) ExportAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	userID, ok := userIDFromContext(ctx)
+	if !ok {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "zip" {
+		problem.Write(w, r, apperr.InvalidField("format", "UNSUPPORTED_FORMAT", "format must be json or zip"))
+		return
+	}
+
+	export, err := h.authService.ExportUserData(ctx, userID)
+	if err != nil {
+		h.handleAccountError(w, r, "ExportAccount", err)
+		return
+	}
+
+	h.logger.WithContext(r.Context()).Info("ExportAccount: personal data exported",
+		logger.Field{Key: "user_id", Value: userID.String()},
+		logger.Field{Key: "format", Value: format},
+	)
+
+	if format != "zip" {
+		w.Header().Set("Content-Disposition", `attachment; filename="account-export.json"`)
+		h.sendJSON(w, http.StatusOK, export)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="account-export.zip"`)
+	w.WriteHeader(http.StatusOK)
+	if err := writeExportZip(w, export); err != nil {
+		h.logger.WithContext(r.Context()).Error("ExportAccount: zip write failed", logger.Field{Key: "error", Value: err.Error()})
+	}
+}
+
+// @Summary      Delete Account
+// @Description  Deletes the authenticated user's account. Personal data is anonymised so historical records stay consistent. Sole owners must transfer the business or add a co-owner first.
+// @Tags         Account
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body DeleteAccountHTTPRequest true "Current password"
+// @Success      200  {object}  SuccessResponseDTO "Account deleted"
+// @Failure      400  {object}  problem.Problem "Validation error"
+// @Failure      401  {object}  problem.Problem "Unauthorized or password is incorrect"
+// @Failure      409  {object}  problem.Problem "Ownership transfer required"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/account [delete]
+func (h *Handler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := userIDFromContext(ctx)
+	if !ok {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	var httpReq DeleteAccountHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		problem.Write(w, r, problem.ErrInvalidBody)
+		return
+	}
+
+	if err := h.authService.DeleteAccount(ctx, userID, &auth.DeleteAccountRequest{Password: httpReq.Password}); err != nil {
+		h.handleAccountError(w, r, "DeleteAccount", err)
+		return
+	}
+
+	h.logger.WithContext(r.Context()).Info("DeleteAccount: account anonymised", logger.Field{Key: "user_id", Value: userID.String()})
+	h.sendJSON(w, http.StatusOK, SuccessResponseDTO{
+		Success: true,
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.account_deleted"),
+	})
+}
+
+// @Summary      Update Locale
+// @Description  Saves the preferred language (az, en, ru) of the authenticated user. The saved language takes precedence over Accept-Language for API messages and emails. Fresh tokens carrying the new locale are returned.
+// @Tags         Account
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body UpdateLocaleHTTPRequest true "Preferred locale"
+// @Success      200  {object}  AuthResponseDTO "Locale saved, new tokens issued"
+// @Failure      400  {object}  problem.Problem "Unsupported locale"
+// @Failure      401  {object}  problem.Problem "Unauthorized"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/account/locale [put]
+func (h *Handler) UpdateLocale(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := userIDFromContext(ctx)
+	if !ok {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	var httpReq UpdateLocaleHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		problem.Write(w, r, problem.ErrInvalidBody)
+		return
+	}
+
+	resp, err := h.authService.UpdateLocale(ctx, userID, &auth.UpdateLocaleRequest{Locale: httpReq.Locale})
+	if err != nil {
+		h.handleAccountError(w, r, "UpdateLocale", err)
+		return
+	}
+
+	h.sendJSON(w, http.StatusOK, FromDomainAuthResponse(resp))
+}
+
+func (h *Handler) handleAccountError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	if apperr.KindOf(err) == apperr.KindInternal {
+		h.logger.WithContext(r.Context()).Error(operation+": service error", logger.Field{Key: "error", Value: err.Error()})
+	}
+	problem.Write(w, r, err)
+}
+
+func userIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(middleware.UserIDKey).(uuid.UUID)
+	return userID, ok && userID != uuid.Nil
+}
+
+// writeExportZip - hər bölmə ayrıca faylda; şəxsi məlumat arxivdə yalnız bir dəfə yer alır
+func writeExportZip(w http.ResponseWriter, export *auth.UserDataExport) error {
+	archive := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{"manifest.json", map[string]interface{}{"exported_at": export.ExportedAt}},
+		{"profile.json", export.Profile},
+		{"sessions.json", export.Sessions},
+		{"staff_profiles.json", export.StaffProfiles},
+	}
+	for _, f := range files {
+		file, err := archive.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", f.name, err)
+		}
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(f.content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	return archive.Close()
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
 	"github.com/google/uuid"
 )
@@ -14,6 +15,7 @@ type RegisterHTTPRequest struct {
 	Password string `json:"password" example:"StrongPass123!"`
 	FullName string `json:"full_name" example:"Orkhan Najafli"`
 	Phone    string `json:"phone" example:"+994501234567"`
+	Locale   string `json:"locale,omitempty" example:"az"`
 }
 
 type LoginHTTPRequest struct {
@@ -34,6 +36,20 @@ type ResetPasswordHTTPRequest struct {
 	Password string `json:"password"`
 }
 
+type ChangePasswordHTTPRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+	RefreshToken    string `json:"refresh_token"`
+}
+
+type UpdateLocaleHTTPRequest struct {
+	Locale string `json:"locale" example:"en"`
+}
+
+type DeleteAccountHTTPRequest struct {
+	Password string `json:"password"`
+}
+
 type UserResponseDTO struct {
 	ID            uuid.UUID     `json:"id"`
 	Email         string        `json:"email"`
@@ -45,6 +61,7 @@ type UserResponseDTO struct {
 	IsActive      bool          `json:"is_active"`
 	IsOwner       bool          `json:"is_owner"`
 	EmailVerified bool          `json:"email_verified"`
+	Locale        string        `json:"locale,omitempty"`
 	CreatedAt     time.Time     `json:"created_at"`
 }
 
@@ -62,10 +79,25 @@ type SuccessResponseDTO struct {
 	Message string      `json:"message,omitempty"`
 }
 
-type ErrorResponseDTO struct {
-	Success bool   `json:"success"`
-	Code    string `json:"code"`
-	Message string `json:"message"`
+// validateRegisterHTTPRequest - boş sahələrin hamısını bir cavabda qaytarır
+func validateRegisterHTTPRequest(httpReq *RegisterHTTPRequest) error {
+	var details []*apperr.Error
+	if strings.TrimSpace(httpReq.Email) == "" {
+		details = append(details, apperr.InvalidField("email", "EMAIL_REQUIRED", "email is required"))
+	}
+	if httpReq.Password == "" {
+		details = append(details, apperr.InvalidField("password", "PASSWORD_REQUIRED", "Password is required"))
+	}
+	if strings.TrimSpace(httpReq.FullName) == "" {
+		details = append(details, apperr.InvalidField("full_name", "FULLNAME_REQUIRED", "Full name is required"))
+	}
+	if strings.TrimSpace(httpReq.Phone) == "" {
+		details = append(details, apperr.InvalidField("phone", "PHONE_REQUIRED", "phone is required"))
+	}
+	if len(details) > 0 {
+		return apperr.InvalidFields(details...)
+	}
+	return nil
 }
 
 func ToDomainRegister(httpReq *RegisterHTTPRequest) *auth.RegisterRequest {
@@ -74,6 +106,7 @@ func ToDomainRegister(httpReq *RegisterHTTPRequest) *auth.RegisterRequest {
 		Password: httpReq.Password,
 		FullName: strings.TrimSpace(httpReq.FullName),
 		Phone:    strings.TrimSpace(httpReq.Phone),
+		Locale:   strings.TrimSpace(httpReq.Locale),
 	}
 }
 
@@ -89,6 +122,7 @@ func FromDomainUser(user *auth.User) *UserResponseDTO {
 		IsActive:      user.IsActive,
 		IsOwner:       user.IsOwner,
 		EmailVerified: user.EmailVerified,
+		Locale:        user.Locale,
 		CreatedAt:     user.CreatedAt,
 	}
 }
@@ -102,53 +136,3 @@ func FromDomainAuthResponse(resp *auth.AuthResponse) *AuthResponseDTO {
 		TokenType:    resp.TokenType,
 	}
 }
-
-// Tam error kod xəritəsi (service + validation-la uyğun)
-var ErrorMessages = map[string]string{
-	// Register / login
-	"EMAIL_EXISTS":         "Bu email artıq mövcuddur",
-	"EMAIL_REQUIRED":       "Email tələb olunur",
-	"EMAIL_TOO_LONG":       "Email çox uzundur",
-	"INVALID_EMAIL_FORMAT": "Email formatı yanlışdır",
-
-	"PASSWORD_REQUIRED":  "Parol tələb olunur",
-	"PASSWORD_TOO_SHORT": "Parol minimum 8 simvol olmalıdır",
-	"PASSWORD_TOO_LONG":  "Parol çox uzundur",
-	"PASSWORD_WEAK":      "Parol kifayət qədər güclü deyil",
-
-	"FULLNAME_REQUIRED":  "Tam ad tələb olunur",
-	"FULLNAME_TOO_SHORT": "Tam ad çox qısadır",
-	"FULLNAME_TOO_LONG":  "Tam ad çox uzundur",
-
-	"PHONE_REQUIRED": "Telefon nömrəsi tələb olunur",
-
-	"INVALID_CREDENTIALS": "Email və ya parol yanlışdır",
-	"USER_INACTIVE":       "Akkaunt deaktivdir",
-
-	"INVALID_REFRESH_TOKEN": "Refresh token yanlışdır",
-	"REFRESH_TOKEN_EXPIRED": "Refresh token vaxtı çıxıb",
-	"REFRESH_TOKEN_REVOKED": "Refresh token ləğv edilib",
-	"USER_NOT_FOUND":        "İstifadəçi tapılmadı",
-
-	"INVALID_TOKEN":           "Token yanlış və ya mövcud deyil",
-	"TOKEN_EXPIRED":           "Token vaxtı çıxıb (24 saat)",
-	"TOKEN_ALREADY_USED":      "Token artıq istifadə edilib",
-	"RESET_TOKEN_SAVE_FAILED": "Reset token yadda saxlanmadı",
-	"PASSWORD_HASH_FAILED":    "Parol işlənərkən xəta baş verdi",
-	"PASSWORD_UPDATE_FAILED":  "Parolu yeniləmək alınmadı",
-
-	"VALIDATION_ERROR": "Giriş məlumatları yanlışdır",
-	"INTERNAL_ERROR":   "Daxili server xətası",
-}
-
-func GetErrorResponse(code string) *ErrorResponseDTO {
-	msg, ok := ErrorMessages[code]
-	if !ok {
-		msg = "Internal server error"
-	}
-	return &ErrorResponseDTO{
-		Success: false,
-		Code:    code,
-		Message: msg,
-	}
-}
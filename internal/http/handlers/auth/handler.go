@@ -6,7 +6,10 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
 	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 )
 
@@ -28,10 +31,6 @@ func (h *Handler) sendJSON(w http.ResponseWriter, status int, data interface{})
 	w.WriteHeader(status)
 	json.NewEncoder(w).Encode(data)
 }
-func (h *Handler) sendError(w http.ResponseWriter, status int, code string) {
-	errorDTO := GetErrorResponse(code)
-	h.sendJSON(w, status, errorDTO)
-}
 
 // @Summary      User Registration
 // @Description  Creates a new user account (account-first registration). Initializes a user without a business. After successful registration, user must complete onboarding wizard to create business.
@@ -40,55 +39,44 @@ func (h *Handler) sendError(w http.ResponseWriter, status int, code string) {
 // @Produce      json
 // @Param        request body RegisterHTTPRequest true "Registration data (Email, Password, FullName, Phone)"
 // @Success      201  {object}  AuthResponseDTO "User account created successfully with JWT tokens"
-// @Failure      400  {object}  ErrorResponseDTO "Validation error - missing fields, invalid format, password too short"
-// @Failure      409  {object}  ErrorResponseDTO "Email already exists"
-// @Failure      500  {object}  ErrorResponseDTO "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error - missing fields, invalid format, password too short"
+// @Failure      409  {object}  problem.Problem "Email already exists"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded; see Retry-After and RateLimit-* headers"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/auth/register [post]
 func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
-	h.logger.Info("Register request received",
+	h.logger.WithContext(r.Context()).Info("Register request received",
 		logger.Field{Key: "method", Value: r.Method},
 		logger.Field{Key: "path", Value: r.URL.Path},
 		logger.Field{Key: "remote_addr", Value: r.RemoteAddr},
 	)
 	var httpReq RegisterHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
-		h.logger.Error("Failed to decode register request", logger.Field{Key: "error", Value: err.Error()})
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+		h.logger.WithContext(r.Context()).Error("Failed to decode register request", logger.Field{Key: "error", Value: err.Error()})
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
-	if httpReq.Email == "" || httpReq.Password == "" || httpReq.FullName == "" || httpReq.Phone == "" {
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+	if err := validateRegisterHTTPRequest(&httpReq); err != nil {
+		problem.Write(w, r, err)
 		return
 	}
 	domainReq := ToDomainRegister(&httpReq)
 	authResp, err := h.authService.Register(ctx, domainReq)
-	var bid string = "null"
-	if authResp.User.BusinessID != nil {
-		bid = authResp.User.BusinessID.String()
-	}
 	if err != nil {
-		h.logger.Error("Register: Service error",
+		h.logger.WithContext(r.Context()).Error("Register: Service error",
 			logger.Field{Key: "error", Value: err.Error()},
 			logger.Field{Key: "email", Value: httpReq.Email},
 		)
-
-		if regErr, ok := err.(*auth.RegistrationError); ok {
-			switch regErr.Code {
-			case "EMAIL_EXISTS":
-				h.sendError(w, http.StatusConflict, regErr.Code)
-			case "PASSWORD_TOO_SHORT", "INVALID_EMAIL_FORMAT", "FULLNAME_REQUIRED":
-				h.sendError(w, http.StatusBadRequest, regErr.Code)
-			default:
-				h.sendError(w, http.StatusBadRequest, regErr.Code)
-			}
-			return
-		}
-		h.sendError(w, http.StatusInternalServerError, "INTERNAL_ERROR")
+		problem.Write(w, r, err)
 		return
 	}
-	h.logger.Info("Register: user created successfully",
+	var bid string = "null"
+	if authResp.User.BusinessID != nil {
+		bid = authResp.User.BusinessID.String()
+	}
+	h.logger.WithContext(r.Context()).Info("Register: user created successfully",
 		logger.Field{Key: "user_id", Value: authResp.User.ID.String()},
 		logger.Field{Key: "email", Value: authResp.User.Email},
 		logger.Field{Key: "role", Value: string(authResp.User.Role)},
@@ -106,15 +94,16 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        request body LoginHTTPRequest true "Login credentials (Email, Password)"
 // @Success      200  {object}  AuthResponseDTO "Authentication successful, tokens returned"
-// @Failure      400  {object}  ErrorResponseDTO "Validation error"
-// @Failure      401  {object}  ErrorResponseDTO "Invalid credentials"
-// @Failure      403  {object}  ErrorResponseDTO "User account is inactive"
-// @Failure      500  {object}  ErrorResponseDTO "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error"
+// @Failure      401  {object}  problem.Problem "Invalid credentials"
+// @Failure      403  {object}  problem.Problem "User account is inactive"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded; see Retry-After and RateLimit-* headers"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/auth/login [post]
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
-	h.logger.Info("Login request received",
+	h.logger.WithContext(r.Context()).Info("Login request received",
 		logger.Field{Key: "method", Value: r.Method},
 		logger.Field{Key: "path", Value: r.URL.Path},
 		logger.Field{Key: "remote_addr", Value: r.RemoteAddr},
@@ -122,11 +111,11 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 
 	var httpReq LoginHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
-		h.logger.Error("Login: JSON parse failed",
+		h.logger.WithContext(r.Context()).Error("Login: JSON parse failed",
 			logger.Field{Key: "error", Value: err.Error()},
 			logger.Field{Key: "remote_addr", Value: r.RemoteAddr},
 		)
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 	domainReq := &auth.LoginRequest{
@@ -135,25 +124,14 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 	authResponse, err := h.authService.Login(ctx, domainReq)
 	if err != nil {
-		if authErr, ok := err.(*auth.RegistrationError); ok {
-			switch authErr.Code {
-			case "INVALID_CREDENTIALS":
-				h.sendError(w, http.StatusUnauthorized, authErr.Code) // 401
-			case "USER_INACTIVE":
-				h.sendError(w, http.StatusForbidden, authErr.Code) // 403
-			default:
-				h.sendError(w, http.StatusBadRequest, authErr.Code)
-			}
-			return
-		}
-		h.sendError(w, http.StatusInternalServerError, "INTERNAL_ERROR")
+		problem.Write(w, r, err)
 		return
 	}
 	var bid string = "null"
 	if authResponse.User.BusinessID != nil {
 		bid = authResponse.User.BusinessID.String()
 	}
-	h.logger.Info("Login: User authenticated successfully",
+	h.logger.WithContext(r.Context()).Info("Login: User authenticated successfully",
 		logger.Field{Key: "user_id", Value: authResponse.User.ID.String()},
 		logger.Field{Key: "email", Value: authResponse.User.Email},
 		logger.Field{Key: "role", Value: string(authResponse.User.Role)},
@@ -170,50 +148,39 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        request body RefreshTokenHTTPRequest true "Refresh token (RefreshToken field)"
 // @Success      200  {object}  SuccessResponseDTO "New access token generated successfully with 15-minute expiration"
-// @Failure      400  {object}  ErrorResponseDTO "Validation error"
-// @Failure      401  {object}  ErrorResponseDTO "Invalid, expired, or revoked refresh token; User not found"
-// @Failure      500  {object}  ErrorResponseDTO "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error"
+// @Failure      401  {object}  problem.Problem "Invalid, expired, or revoked refresh token; User not found"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded; see Retry-After and RateLimit-* headers"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/auth/refresh [post]
 func (h *Handler) RefreshAccessToken(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
 
-	h.logger.Info("RefreshToken request received",
+	h.logger.WithContext(r.Context()).Info("RefreshToken request received",
 		logger.Field{Key: "remote_addr", Value: r.RemoteAddr},
 	)
 
 	var httpReq RefreshTokenHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
-		h.logger.Error("RefreshToken: JSON parse failed",
+		h.logger.WithContext(r.Context()).Error("RefreshToken: JSON parse failed",
 			logger.Field{Key: "error", Value: err.Error()},
 		)
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	accessToken, err := h.authService.RefreshAccessToken(ctx, httpReq.RefreshToken)
 	if err != nil {
-		if authErr, ok := err.(*auth.RegistrationError); ok {
-			switch authErr.Code {
-			case "INVALID_REFRESH_TOKEN",
-				"REFRESH_TOKEN_EXPIRED",
-				"REFRESH_TOKEN_REVOKED",
-				"USER_NOT_FOUND":
-				h.sendError(w, http.StatusUnauthorized, authErr.Code)
-			default:
-				h.sendError(w, http.StatusBadRequest, authErr.Code)
-			}
-			return
-		}
 
-		h.logger.Error("RefreshToken: service error",
+		h.logger.WithContext(r.Context()).Error("RefreshToken: service error",
 			logger.Field{Key: "error", Value: err.Error()},
 		)
-		h.sendError(w, http.StatusInternalServerError, "INTERNAL_ERROR")
+		problem.Write(w, r, err)
 		return
 	}
 
-	h.logger.Info("RefreshToken: Token refreshed successfully")
+	h.logger.WithContext(r.Context()).Info("RefreshToken: Token refreshed successfully")
 
 	successResponse := SuccessResponseDTO{
 		Success: true,
@@ -222,7 +189,7 @@ func (h *Handler) RefreshAccessToken(w http.ResponseWriter, r *http.Request) {
 			"expires_in":   900,
 			"token_type":   "Bearer",
 		},
-		Message: "Token yeniləndi",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.token_refreshed"),
 	}
 	h.sendJSON(w, http.StatusOK, successResponse)
 }
@@ -234,19 +201,20 @@ func (h *Handler) RefreshAccessToken(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        request body ForgotPasswordHTTPRequest true "User email address"
 // @Success      200  {object}  SuccessResponseDTO "Password reset email sent successfully"
-// @Failure      400  {object}  ErrorResponseDTO "Validation error - invalid email format"
-// @Failure      500  {object}  ErrorResponseDTO "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid email format"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded; see Retry-After and RateLimit-* headers"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/auth/forgot-password [post]
 func (h *Handler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
-	h.logger.Info("ForgotPassword request received", logger.Field{Key: "remote_addr", Value: r.RemoteAddr})
+	h.logger.WithContext(r.Context()).Info("ForgotPassword request received", logger.Field{Key: "remote_addr", Value: r.RemoteAddr})
 	var httpReq ForgotPasswordHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
-		h.logger.Error("ForgotPassword: JSON parse failed",
+		h.logger.WithContext(r.Context()).Error("ForgotPassword: JSON parse failed",
 			logger.Field{Key: "error", Value: err.Error()},
 		)
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 	domainReq := &auth.ForgotPasswordRequest{
@@ -254,15 +222,15 @@ func (h *Handler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
 	}
 	err := h.authService.ForgotPassword(ctx, domainReq)
 	if err != nil {
-		h.sendError(w, http.StatusInternalServerError, "INTERNAL_ERROR")
+		problem.Write(w, r, err)
 		return
 	}
 	successResp := SuccessResponseDTO{
 		Success: true,
-		Message: "Parol sıfırlama linki email-ə göndərildi",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.password_reset_sent"),
 		Data:    nil,
 	}
-	h.logger.Info("ForgotPassword: Reset email process completed",
+	h.logger.WithContext(r.Context()).Info("ForgotPassword: Reset email process completed",
 		logger.Field{Key: "email", Value: httpReq.Email},
 	)
 	h.sendJSON(w, http.StatusOK, successResp)
@@ -275,23 +243,24 @@ func (h *Handler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        request body ResetPasswordHTTPRequest true "Reset token and new password"
 // @Success      200  {object}  SuccessResponseDTO "Password reset successfully"
-// @Failure      400  {object}  ErrorResponseDTO "Invalid or expired token; Password validation failure; User not found"
-// @Failure      500  {object}  ErrorResponseDTO "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid or expired token; Password validation failure; User not found"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded; see Retry-After and RateLimit-* headers"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/auth/reset-password [post]
 func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
 
-	h.logger.Info("ResetPassword request received",
+	h.logger.WithContext(r.Context()).Info("ResetPassword request received",
 		logger.Field{Key: "remote_addr", Value: r.RemoteAddr},
 	)
 
 	var httpReq ResetPasswordHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
-		h.logger.Error("ResetPassword: JSON parse failed",
+		h.logger.WithContext(r.Context()).Error("ResetPassword: JSON parse failed",
 			logger.Field{Key: "error", Value: err.Error()},
 		)
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
@@ -302,34 +271,19 @@ func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
 
 	err := h.authService.ResetPassword(ctx, domainReq)
 	if err != nil {
-		h.logger.Warn("ResetPassword: Password reset failed",
+		h.logger.WithContext(r.Context()).Warn("ResetPassword: Password reset failed",
 			logger.Field{Key: "error", Value: err.Error()},
 		)
 
-		if authErr, ok := err.(*auth.RegistrationError); ok {
-			switch authErr.Code {
-			case "INVALID_TOKEN",
-				"TOKEN_EXPIRED",
-				"TOKEN_ALREADY_USED",
-				"PASSWORD_TOO_SHORT",
-				"PASSWORD_WEAK",
-				"USER_NOT_FOUND":
-				h.sendError(w, http.StatusBadRequest, authErr.Code)
-			default:
-				h.sendError(w, http.StatusBadRequest, authErr.Code)
-			}
-			return
-		}
-
-		h.sendError(w, http.StatusInternalServerError, "INTERNAL_ERROR")
+		problem.Write(w, r, err)
 		return
 	}
 
-	h.logger.Info("ResetPassword: Password reset successful")
+	h.logger.WithContext(r.Context()).Info("ResetPassword: Password reset successful")
 
 	successResp := SuccessResponseDTO{
 		Success: true,
-		Message: "Parol uğurla sıfırlandı",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.password_reset"),
 		Data:    nil,
 	}
 	h.sendJSON(w, http.StatusOK, successResp)
@@ -342,8 +296,8 @@ func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        request body RefreshTokenHTTPRequest true "Refresh token to revoke"
 // @Success      200  {object}  SuccessResponseDTO "Logout successful, refresh token revoked"
-// @Failure      400  {object}  ErrorResponseDTO "Validation error or invalid refresh token"
-// @Failure      500  {object}  ErrorResponseDTO "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error or invalid refresh token"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/auth/logout [post]
 func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
@@ -351,36 +305,27 @@ func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
 
 	var req RefreshTokenHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	if req.RefreshToken == "" {
-		h.sendError(w, http.StatusBadRequest, "VALIDATION_ERROR")
+		problem.Write(w, r, apperr.InvalidField("refresh_token", "REFRESH_TOKEN_REQUIRED", "Refresh token is required"))
 		return
 	}
 
 	if err := h.authService.RevokeRefreshToken(ctx, req.RefreshToken); err != nil {
-		if authErr, ok := err.(*auth.RegistrationError); ok {
-			switch authErr.Code {
-			case "INVALID_REFRESH_TOKEN":
-				h.sendError(w, http.StatusBadRequest, authErr.Code)
-			default:
-				h.sendError(w, http.StatusBadRequest, authErr.Code)
-			}
-			return
-		}
 
-		h.logger.Error("Failed to revoke token",
+		h.logger.WithContext(r.Context()).Error("Failed to revoke token",
 			logger.Field{Key: "error", Value: err.Error()},
 		)
-		h.sendError(w, http.StatusInternalServerError, "INTERNAL_ERROR")
+		problem.Write(w, r, err)
 		return
 	}
 
 	success := SuccessResponseDTO{
 		Success: true,
-		Message: "Çıxış edildi",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.logged_out"),
 		Data:    nil,
 	}
 	h.sendJSON(w, http.StatusOK, success)
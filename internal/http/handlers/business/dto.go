@@ -4,7 +4,10 @@ package business
 import (
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/onboarding"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
 	"github.com/google/uuid"
 )
 
@@ -35,20 +38,53 @@ type BusinessHTTPResponse struct {
 	Phone           string    `json:"phone"`
 	BusinessType    string    `json:"business_type"`
 	IsActive        bool      `json:"is_active"`
+	Version         int       `json:"version"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 }
 
-type ErrorHTTPResponse struct {
-	Error   string `json:"error"`
-	Code    string `json:"code"`
-	Message string `json:"message"`
+type OnboardingHTTPResponse struct {
+	Business   *BusinessHTTPResponse `json:"business"`
+	LocationID uuid.UUID             `json:"location_id"`
+	StaffID    uuid.UUID             `json:"staff_id"`
+	Auth       *auth.AuthResponse    `json:"auth"`
+}
+
+type AddCoOwnerHTTPRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type InitiateTransferHTTPRequest struct {
+	ToUserID uuid.UUID `json:"to_user_id"`
+}
+
+type ConfirmTransferHTTPRequest struct {
+	Token string `json:"token"`
+}
+
+type BusinessOwnerHTTPResponse struct {
+	UserID    uuid.UUID `json:"user_id"`
+	FullName  string    `json:"full_name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OwnershipTransferHTTPResponse struct {
+	ID         uuid.UUID `json:"id"`
+	BusinessID uuid.UUID `json:"business_id"`
+	FromUserID uuid.UUID `json:"from_user_id"`
+	ToUserID   uuid.UUID `json:"to_user_id"`
+	Status     string    `json:"status"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type SuccessHTTPResponse struct {
-	Success bool        `json:"success"`
-	Data    interface{} `json:"data,omitempty"`
-	Message string      `json:"message,omitempty"`
+	Success bool             `json:"success"`
+	Data    interface{}      `json:"data,omitempty"`
+	Meta    *pagination.Meta `json:"meta,omitempty"`
+	Message string           `json:"message,omitempty"`
 }
 
 func ToBusinessHTTPResponse(business *business.Business) *BusinessHTTPResponse {
@@ -65,11 +101,21 @@ func ToBusinessHTTPResponse(business *business.Business) *BusinessHTTPResponse {
 		Phone:           business.Phone,
 		BusinessType:    string(business.BusinessType),
 		IsActive:        business.IsActive,
+		Version:         business.Version,
 		CreatedAt:       business.CreatedAt,
 		UpdatedAt:       business.UpdatedAt,
 	}
 }
 
+func ToOnboardingHTTPResponse(result *onboarding.Result) *OnboardingHTTPResponse {
+	return &OnboardingHTTPResponse{
+		Business:   ToBusinessHTTPResponse(result.Business),
+		LocationID: result.Location.ID,
+		StaffID:    result.StaffProfile.ID,
+		Auth:       result.Auth,
+	}
+}
+
 func (request *CreateSoloBusinessHTTPRequest) ToCreateBusinessRequest() *business.CreateBusinessRequest {
 	return &business.CreateBusinessRequest{
 		Name:            request.Name,
@@ -97,3 +143,45 @@ func (request *UpdateBusinessHTTPRequest) ToUpdateBusinessRequest() *business.Up
 		Phone:    request.Phone,
 	}
 }
+
+func (request *AddCoOwnerHTTPRequest) ToAddCoOwnerRequest() *business.AddCoOwnerRequest {
+	return &business.AddCoOwnerRequest{
+		UserID: request.UserID,
+	}
+}
+
+func (request *InitiateTransferHTTPRequest) ToInitiateTransferRequest() *business.InitiateTransferRequest {
+	return &business.InitiateTransferRequest{
+		ToUserID: request.ToUserID,
+	}
+}
+
+func ToBusinessOwnerHTTPResponses(owners []*business.BusinessOwner) []BusinessOwnerHTTPResponse {
+	responses := make([]BusinessOwnerHTTPResponse, 0, len(owners))
+	for _, owner := range owners {
+		responses = append(responses, BusinessOwnerHTTPResponse{
+			UserID:    owner.UserID,
+			FullName:  owner.FullName,
+			Email:     owner.Email,
+			Role:      string(owner.Role),
+			CreatedAt: owner.CreatedAt,
+		})
+	}
+	return responses
+}
+
+func ToOwnershipTransferHTTPResponse(transfer *business.OwnershipTransfer) *OwnershipTransferHTTPResponse {
+	if transfer == nil {
+		return nil
+	}
+
+	return &OwnershipTransferHTTPResponse{
+		ID:         transfer.ID,
+		BusinessID: transfer.BusinessID,
+		FromUserID: transfer.FromUserID,
+		ToUserID:   transfer.ToUserID,
+		Status:     string(transfer.Status),
+		ExpiresAt:  transfer.ExpiresAt,
+		CreatedAt:  transfer.CreatedAt,
+	}
+}
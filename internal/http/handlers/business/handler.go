@@ -8,109 +8,117 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/onboarding"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/etag"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 	"github.com/google/uuid"
 )
 
 type BusinessHandler struct {
-	businessService business.Service
+	businessService   business.Service
+	onboardingService onboarding.Service
+	logger            logger.Logger
 }
 
-func NewBusinessHandler(businessService business.Service) *BusinessHandler {
+func NewBusinessHandler(
+	businessService business.Service,
+	onboardingService onboarding.Service,
+	appLogger logger.Logger,
+) *BusinessHandler {
 	return &BusinessHandler{
-		businessService: businessService,
+		businessService:   businessService,
+		onboardingService: onboardingService,
+		logger:            appLogger,
 	}
 }
 
 // @Summary      Create Solo Business
-// @Description  Creates a solo practitioner business for the authenticated user. Automatically generates business, location, and active staff records. User role set to solo_practitioner.
+// @Description  Creates a solo practitioner business for the authenticated user. Business, default location, the owner's active staff profile and the user update (business_id, is_owner, role solo_practitioner) are written in one transaction. Returns fresh tokens carrying the new business_id.
 // @Tags         Business
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
 // @Param        request body CreateSoloBusinessHTTPRequest true "Solo business data (BusinessName, Phone, ServiceCategory, Industry)"
-// @Success      201  {object}  BusinessHTTPResponse "Solo business created successfully"
-// @Failure      400  {object}  ErrorHTTPResponse "Validation error - invalid or missing required fields"
-// @Failure      401  {object}  ErrorHTTPResponse "Unauthorized - user not authenticated"
-// @Failure      409  {object}  ErrorHTTPResponse "Conflict - business already exists for user"
-// @Failure      500  {object}  ErrorHTTPResponse "Internal server error"
+// @Success      201  {object}  OnboardingHTTPResponse "Solo business created successfully, new tokens returned"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid or missing required fields"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      409  {object}  problem.Problem "Conflict - business already exists for user"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/businesses/solo [post]
 func (handler *BusinessHandler) CreateSoloBusiness(writer http.ResponseWriter, request *http.Request) {
-	if request.Method != http.MethodPost {
-		handler.respondWithError(writer, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed")
-		return
-	}
-
 	ctx := request.Context()
 	userID, err := handler.extractUserIDFromContext(ctx)
 	if err != nil {
-		handler.respondWithError(writer, http.StatusUnauthorized, "UNAUTHORIZED", err.Error())
+		problem.Write(writer, request, problem.ErrUnauthenticated)
 		return
 	}
 
 	var httpRequest CreateSoloBusinessHTTPRequest
 	if err := json.NewDecoder(request.Body).Decode(&httpRequest); err != nil {
-		handler.respondWithError(writer, http.StatusBadRequest, "INVALID_REQUEST_BODY", "Invalid request body")
+		problem.Write(writer, request, problem.ErrInvalidBody)
 		return
 	}
 	defer request.Body.Close()
 
 	domainRequest := httpRequest.ToCreateBusinessRequest()
 
-	businessEntity, err := handler.businessService.CreateBusiness(ctx, userID, domainRequest)
+	result, err := handler.onboardingService.CreateBusiness(ctx, userID, domainRequest)
 	if err != nil {
-		handler.handleDomainError(writer, err)
+		problem.Write(writer, request, err)
 		return
 	}
 
-	response := ToBusinessHTTPResponse(businessEntity)
-	handler.respondWithJSON(writer, http.StatusCreated, response)
+	response := ToOnboardingHTTPResponse(result)
+	handler.respondWithJSON(writer, request, http.StatusCreated, response)
 }
 
 // @Summary      Create Multi-Staff Business
-// @Description  Creates a multi-staff business for the authenticated user. Owner can invite staff members later. Staff management handled through separate endpoints.
+// @Description  Creates a multi-staff business for the authenticated user in one transaction together with a default location, the owner's staff profile and the user update (business_id, is_owner, role provider_owner). Returns fresh tokens carrying the new business_id. Owner can invite staff members later.
 // @Tags         Business
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
 // @Param        request body CreateMultiBusinessHTTPRequest true "Multi-staff business data (BusinessName, Phone, ServiceCategory, Industry)"
-// @Success      201  {object}  BusinessHTTPResponse "Multi-staff business created successfully"
-// @Failure      400  {object}  ErrorHTTPResponse "Validation error - invalid or missing required fields"
-// @Failure      401  {object}  ErrorHTTPResponse "Unauthorized - user not authenticated"
-// @Failure      409  {object}  ErrorHTTPResponse "Conflict - business already exists for user"
-// @Failure      500  {object}  ErrorHTTPResponse "Internal server error"
+// @Success      201  {object}  OnboardingHTTPResponse "Multi-staff business created successfully, new tokens returned"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid or missing required fields"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      409  {object}  problem.Problem "Conflict - business already exists for user"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/businesses/multi [post]
 func (handler *BusinessHandler) CreateMultiBusiness(writer http.ResponseWriter, request *http.Request) {
-	if request.Method != http.MethodPost {
-		handler.respondWithError(writer, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed")
-		return
-	}
-
 	ctx := request.Context()
 
 	userID, err := handler.extractUserIDFromContext(ctx)
 	if err != nil {
-		handler.respondWithError(writer, http.StatusUnauthorized, "UNAUTHORIZED", err.Error())
+		problem.Write(writer, request, problem.ErrUnauthenticated)
 		return
 	}
 
 	var httpRequest CreateMultiBusinessHTTPRequest
 	if err := json.NewDecoder(request.Body).Decode(&httpRequest); err != nil {
-		handler.respondWithError(writer, http.StatusBadRequest, "INVALID_REQUEST_BODY", "Invalid request body")
+		problem.Write(writer, request, problem.ErrInvalidBody)
 		return
 	}
 	defer request.Body.Close()
 
 	domainRequest := httpRequest.ToCreateBusinessRequest()
 
-	businessEntity, err := handler.businessService.CreateBusiness(ctx, userID, domainRequest)
+	result, err := handler.onboardingService.CreateBusiness(ctx, userID, domainRequest)
 	if err != nil {
-		handler.handleDomainError(writer, err)
+		problem.Write(writer, request, err)
 		return
 	}
 
-	response := ToBusinessHTTPResponse(businessEntity)
-	handler.respondWithJSON(writer, http.StatusCreated, response)
+	response := ToOnboardingHTTPResponse(result)
+	handler.respondWithJSON(writer, request, http.StatusCreated, response)
 }
 
 // @Summary      Get My Business
@@ -120,32 +128,28 @@ func (handler *BusinessHandler) CreateMultiBusiness(writer http.ResponseWriter,
 // @Produce      json
 // @Security     BearerAuth
 // @Success      200  {object}  BusinessHTTPResponse "Business details retrieved successfully"
-// @Failure      401  {object}  ErrorHTTPResponse "Unauthorized - user not authenticated"
-// @Failure      404  {object}  ErrorHTTPResponse "Business not found for user"
-// @Failure      500  {object}  ErrorHTTPResponse "Internal server error"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      404  {object}  problem.Problem "Business not found for user"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/business [get]
 func (handler *BusinessHandler) GetBusiness(writer http.ResponseWriter, request *http.Request) {
-	if request.Method != http.MethodGet {
-		handler.respondWithError(writer, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed")
-		return
-	}
-
 	ctx := request.Context()
 
 	userID, err := handler.extractUserIDFromContext(ctx)
 	if err != nil {
-		handler.respondWithError(writer, http.StatusUnauthorized, "UNAUTHORIZED", err.Error())
+		problem.Write(writer, request, problem.ErrUnauthenticated)
 		return
 	}
 
 	businessEntity, err := handler.businessService.GetBusinessByOwner(ctx, userID)
 	if err != nil {
-		handler.handleDomainError(writer, err)
+		problem.Write(writer, request, err)
 		return
 	}
 
+	etag.Set(writer, businessEntity.Version)
 	response := ToBusinessHTTPResponse(businessEntity)
-	handler.respondWithJSON(writer, http.StatusOK, response)
+	handler.respondWithJSON(writer, request, http.StatusOK, response)
 }
 
 // @Summary      Get Business by ID
@@ -156,39 +160,35 @@ func (handler *BusinessHandler) GetBusiness(writer http.ResponseWriter, request
 // @Security     BearerAuth
 // @Param        id path string true "Business ID (UUID format)"
 // @Success      200  {object}  BusinessHTTPResponse "Business details retrieved successfully"
-// @Failure      400  {object}  ErrorHTTPResponse "Invalid business ID format"
-// @Failure      401  {object}  ErrorHTTPResponse "Unauthorized - user not authenticated"
-// @Failure      404  {object}  ErrorHTTPResponse "Business not found"
-// @Failure      500  {object}  ErrorHTTPResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid business ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      404  {object}  problem.Problem "Business not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/businesses/{id} [get]
 func (handler *BusinessHandler) GetBusinessByID(writer http.ResponseWriter, request *http.Request) {
-	if request.Method != http.MethodGet {
-		handler.respondWithError(writer, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed")
-		return
-	}
-
 	ctx := request.Context()
 
 	businessIDParam := handler.extractIDFromPath(request.URL.Path, "/api/v1/businesses/")
 	if businessIDParam == "" {
-		handler.respondWithError(writer, http.StatusBadRequest, "INVALID_BUSINESS_ID", "Business ID is required")
+		problem.Write(writer, request, apperr.Validation("INVALID_BUSINESS_ID", "Business ID is required"))
 		return
 	}
 
 	businessID, err := uuid.Parse(businessIDParam)
 	if err != nil {
-		handler.respondWithError(writer, http.StatusBadRequest, "INVALID_BUSINESS_ID", "Invalid business ID format")
+		problem.Write(writer, request, apperr.Validation("INVALID_BUSINESS_ID", "Invalid business ID format"))
 		return
 	}
 
 	businessEntity, err := handler.businessService.GetBusinessByID(ctx, businessID)
 	if err != nil {
-		handler.handleDomainError(writer, err)
+		problem.Write(writer, request, err)
 		return
 	}
 
+	etag.Set(writer, businessEntity.Version)
 	response := ToBusinessHTTPResponse(businessEntity)
-	handler.respondWithJSON(writer, http.StatusOK, response)
+	handler.respondWithJSON(writer, request, http.StatusOK, response)
 }
 
 // @Summary      Update Business
@@ -198,43 +198,308 @@ func (handler *BusinessHandler) GetBusinessByID(writer http.ResponseWriter, requ
 // @Produce      json
 // @Security     BearerAuth
 // @Param        request body UpdateBusinessHTTPRequest true "Business update data (BusinessName, Phone, ServiceCategory, Industry - all optional)"
-// @Success      200  {object}  SuccessHTTPResponse "Business updated successfully"
-// @Failure      400  {object}  ErrorHTTPResponse "Validation error - invalid field values"
-// @Failure      401  {object}  ErrorHTTPResponse "Unauthorized - user not authenticated or not owner"
-// @Failure      404  {object}  ErrorHTTPResponse "Business not found"
-// @Failure      500  {object}  ErrorHTTPResponse "Internal server error"
+// @Param        If-Match header string true "ETag from the last GET; * skips the version check"
+// @Success      200  {object}  SuccessHTTPResponse "Business updated successfully (data is BusinessHTTPResponse, ETag carries the new version)"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid field values"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or not owner"
+// @Failure      404  {object}  problem.Problem "Business not found"
+// @Failure      412  {object}  problem.Problem "Modified by another request; body carries the current representation in current"
+// @Failure      428  {object}  problem.Problem "If-Match header missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/business [put]
 func (handler *BusinessHandler) UpdateBusiness(writer http.ResponseWriter, request *http.Request) {
-	if request.Method != http.MethodPut {
-		handler.respondWithError(writer, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed")
+	ctx := request.Context()
+
+	businessID, err := handler.extractBusinessIDFromContext(ctx)
+	if err != nil {
+		problem.Write(writer, request, problem.ErrUnauthenticated)
+		return
+	}
+
+	version, err := etag.IfMatch(request)
+	if err != nil {
+		handler.writeUpdateError(writer, request, err, businessID)
 		return
 	}
 
+	var httpRequest UpdateBusinessHTTPRequest
+	if err := json.NewDecoder(request.Body).Decode(&httpRequest); err != nil {
+		problem.Write(writer, request, problem.ErrInvalidBody)
+		return
+	}
+	defer request.Body.Close()
+
+	domainRequest := httpRequest.ToUpdateBusinessRequest()
+	domainRequest.Version = version
+
+	updated, err := handler.businessService.UpdateBusiness(ctx, businessID, domainRequest)
+	if err != nil {
+		handler.writeUpdateError(writer, request, err, businessID)
+		return
+	}
+
+	etag.Set(writer, updated.Version)
+	handler.respondWithJSON(writer, request, http.StatusOK, SuccessHTTPResponse{
+		Success: true,
+		Data:    ToBusinessHTTPResponse(updated),
+		Message: i18n.T(i18n.FromContext(request.Context()), "message.business_updated"),
+	})
+}
+
+// writeUpdateError - versiya konfliktində 412 cavabına biznesin cari halı və ETag-ı əlavə olunur
+func (handler *BusinessHandler) writeUpdateError(writer http.ResponseWriter, request *http.Request, err error, businessID uuid.UUID) {
+	if apperr.KindOf(err) == apperr.KindPreconditionFailed {
+		if current, getErr := handler.businessService.GetBusinessByID(request.Context(), businessID); getErr == nil {
+			etag.Set(writer, current.Version)
+			problem.WriteCurrent(writer, request, err, ToBusinessHTTPResponse(current))
+			return
+		}
+	}
+	problem.Write(writer, request, err)
+}
+
+// @Summary      List Business Owners
+// @Description  Returns the primary owner and all co-owners of the authenticated user's business.
+// @Tags         Business
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        search query string false "Case-insensitive search on full name or email"
+// @Param        sort query string false "created_at or name (default created_at)"
+// @Param        order query string false "asc or desc (default asc)"
+// @Success      200  {object}  SuccessHTTPResponse "Owners retrieved successfully (array of BusinessOwnerHTTPResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/business/owners [get]
+func (handler *BusinessHandler) ListOwners(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
 	businessID, err := handler.extractBusinessIDFromContext(ctx)
 	if err != nil {
-		handler.respondWithError(writer, http.StatusUnauthorized, "UNAUTHORIZED", err.Error())
+		problem.Write(writer, request, problem.ErrUnauthenticated)
 		return
 	}
 
-	var httpRequest UpdateBusinessHTTPRequest
+	query, err := pagination.ParseQuery(request)
+	if err != nil {
+		problem.Write(writer, request, err)
+		return
+	}
+
+	page, err := handler.businessService.ListOwners(ctx, businessID, query)
+	if err != nil {
+		problem.Write(writer, request, err)
+		return
+	}
+
+	handler.respondWithJSON(writer, request, http.StatusOK, SuccessHTTPResponse{
+		Success: true,
+		Data:    ToBusinessOwnerHTTPResponses(page.Items),
+		Meta:    pagination.MetaOf(page),
+	})
+}
+
+// @Summary      Add Co-Owner
+// @Description  Grants co-owner rights to an active staff member. Only the primary owner can add co-owners. Co-owners keep the business from being left ownerless if the primary owner account is deleted.
+// @Tags         Business
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
+// @Param        request body AddCoOwnerHTTPRequest true "Staff member user ID"
+// @Success      201  {object}  SuccessHTTPResponse "Co-owner added successfully"
+// @Failure      400  {object}  problem.Problem "Validation error or user is not active staff"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      403  {object}  problem.Problem "Only the primary owner can add co-owners"
+// @Failure      409  {object}  problem.Problem "User is already an owner"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/business/co-owners [post]
+func (handler *BusinessHandler) AddCoOwner(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	userID, businessID, err := handler.extractIdentityFromContext(ctx)
+	if err != nil {
+		problem.Write(writer, request, problem.ErrUnauthenticated)
+		return
+	}
+
+	var httpRequest AddCoOwnerHTTPRequest
 	if err := json.NewDecoder(request.Body).Decode(&httpRequest); err != nil {
-		handler.respondWithError(writer, http.StatusBadRequest, "INVALID_REQUEST_BODY", "Invalid request body")
+		problem.Write(writer, request, problem.ErrInvalidBody)
 		return
 	}
 	defer request.Body.Close()
 
-	domainRequest := httpRequest.ToUpdateBusinessRequest()
+	if err := handler.businessService.AddCoOwner(ctx, businessID, userID, httpRequest.ToAddCoOwnerRequest()); err != nil {
+		problem.Write(writer, request, err)
+		return
+	}
+
+	handler.respondWithJSON(writer, request, http.StatusCreated, SuccessHTTPResponse{
+		Success: true,
+		Message: i18n.T(i18n.FromContext(request.Context()), "message.co_owner_added"),
+	})
+}
 
-	if err := handler.businessService.UpdateBusiness(ctx, businessID, domainRequest); err != nil {
-		handler.handleDomainError(writer, err)
+// @Summary      Remove Co-Owner
+// @Description  Revokes co-owner rights. Only the primary owner can remove co-owners; the primary owner itself cannot be removed.
+// @Tags         Business
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path string true "Co-owner user ID (UUID format)"
+// @Success      200  {object}  SuccessHTTPResponse "Co-owner removed successfully"
+// @Failure      400  {object}  problem.Problem "Invalid user ID or primary owner"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      403  {object}  problem.Problem "Only the primary owner can remove co-owners"
+// @Failure      404  {object}  problem.Problem "Co-owner not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/business/co-owners/{user_id} [delete]
+func (handler *BusinessHandler) RemoveCoOwner(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	userID, businessID, err := handler.extractIdentityFromContext(ctx)
+	if err != nil {
+		problem.Write(writer, request, problem.ErrUnauthenticated)
+		return
+	}
+
+	coOwnerID, err := uuid.Parse(request.PathValue("user_id"))
+	if err != nil {
+		problem.Write(writer, request, apperr.Validation("INVALID_USER_ID", "Invalid user ID format"))
 		return
 	}
 
-	handler.respondWithJSON(writer, http.StatusOK, SuccessHTTPResponse{
+	if err := handler.businessService.RemoveCoOwner(ctx, businessID, userID, coOwnerID); err != nil {
+		problem.Write(writer, request, err)
+		return
+	}
+
+	handler.respondWithJSON(writer, request, http.StatusOK, SuccessHTTPResponse{
+		Success: true,
+		Message: i18n.T(i18n.FromContext(request.Context()), "message.co_owner_removed"),
+	})
+}
+
+// @Summary      Initiate Ownership Transfer
+// @Description  Starts transferring the business to an active staff member. The recipient receives an email with a confirmation link valid for 72 hours. Only one transfer can be pending per business.
+// @Tags         Business
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
+// @Param        request body InitiateTransferHTTPRequest true "Recipient user ID"
+// @Success      201  {object}  OwnershipTransferHTTPResponse "Ownership transfer initiated"
+// @Failure      400  {object}  problem.Problem "Validation error or recipient is not active staff"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      403  {object}  problem.Problem "Only the primary owner can transfer ownership"
+// @Failure      409  {object}  problem.Problem "Another transfer is already pending"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/business/ownership-transfers [post]
+func (handler *BusinessHandler) InitiateOwnershipTransfer(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	userID, businessID, err := handler.extractIdentityFromContext(ctx)
+	if err != nil {
+		problem.Write(writer, request, problem.ErrUnauthenticated)
+		return
+	}
+
+	var httpRequest InitiateTransferHTTPRequest
+	if err := json.NewDecoder(request.Body).Decode(&httpRequest); err != nil {
+		problem.Write(writer, request, problem.ErrInvalidBody)
+		return
+	}
+	defer request.Body.Close()
+
+	transfer, err := handler.businessService.InitiateOwnershipTransfer(ctx, businessID, userID, httpRequest.ToInitiateTransferRequest())
+	if err != nil {
+		problem.Write(writer, request, err)
+		return
+	}
+
+	handler.respondWithJSON(writer, request, http.StatusCreated, ToOwnershipTransferHTTPResponse(transfer))
+}
+
+// @Summary      Cancel Ownership Transfer
+// @Description  Cancels the pending ownership transfer. Only the primary owner can cancel.
+// @Tags         Business
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Transfer ID (UUID format)"
+// @Success      200  {object}  SuccessHTTPResponse "Ownership transfer cancelled"
+// @Failure      400  {object}  problem.Problem "Invalid transfer ID"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      403  {object}  problem.Problem "Only the primary owner can cancel"
+// @Failure      404  {object}  problem.Problem "Pending transfer not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/business/ownership-transfers/{id} [delete]
+func (handler *BusinessHandler) CancelOwnershipTransfer(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	userID, businessID, err := handler.extractIdentityFromContext(ctx)
+	if err != nil {
+		problem.Write(writer, request, problem.ErrUnauthenticated)
+		return
+	}
+
+	transferID, err := uuid.Parse(request.PathValue("id"))
+	if err != nil {
+		problem.Write(writer, request, apperr.Validation("INVALID_TRANSFER_ID", "Invalid transfer ID format"))
+		return
+	}
+
+	if err := handler.businessService.CancelOwnershipTransfer(ctx, businessID, userID, transferID); err != nil {
+		problem.Write(writer, request, err)
+		return
+	}
+
+	handler.respondWithJSON(writer, request, http.StatusOK, SuccessHTTPResponse{
+		Success: true,
+		Message: i18n.T(i18n.FromContext(request.Context()), "message.ownership_transfer_cancelled"),
+	})
+}
+
+// @Summary      Confirm Ownership Transfer
+// @Description  The recipient confirms the transfer with the token from the email. The previous owner becomes a regular staff member and the new owner gets the owner role. Both users' refresh tokens are revoked, so they must log in again to get tokens with the new role.
+// @Tags         Business
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body ConfirmTransferHTTPRequest true "Transfer token"
+// @Success      200  {object}  SuccessHTTPResponse "Ownership transferred successfully"
+// @Failure      400  {object}  problem.Problem "Invalid or expired token"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated"
+// @Failure      403  {object}  problem.Problem "Transfer was issued to another user"
+// @Failure      409  {object}  problem.Problem "Transfer is no longer pending"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/business/ownership-transfers/confirm [post]
+func (handler *BusinessHandler) ConfirmOwnershipTransfer(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	userID, err := handler.extractUserIDFromContext(ctx)
+	if err != nil {
+		problem.Write(writer, request, problem.ErrUnauthenticated)
+		return
+	}
+
+	var httpRequest ConfirmTransferHTTPRequest
+	if err := json.NewDecoder(request.Body).Decode(&httpRequest); err != nil {
+		problem.Write(writer, request, problem.ErrInvalidBody)
+		return
+	}
+	defer request.Body.Close()
+
+	if err := handler.businessService.ConfirmOwnershipTransfer(ctx, userID, httpRequest.Token); err != nil {
+		problem.Write(writer, request, err)
+		return
+	}
+
+	handler.respondWithJSON(writer, request, http.StatusOK, SuccessHTTPResponse{
 		Success: true,
-		Message: "Business updated successfully",
+		Message: i18n.T(i18n.FromContext(request.Context()), "message.ownership_transferred"),
 	})
 }
 
@@ -248,101 +513,65 @@ func (handler *BusinessHandler) extractIDFromPath(path, prefix string) string {
 }
 
 func (handler *BusinessHandler) extractUserIDFromContext(ctx context.Context) (uuid.UUID, error) {
-	userIDValue := ctx.Value("user_id")
+	userIDValue := ctx.Value(middleware.UserIDKey)
 	if userIDValue == nil {
 		return uuid.Nil, fmt.Errorf("user ID not found in context")
 	}
 
-	userIDString, ok := userIDValue.(string)
-	if !ok {
+	userID, ok := userIDValue.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
 		return uuid.Nil, fmt.Errorf("user ID has invalid type")
 	}
 
-	userID, err := uuid.Parse(userIDString)
-	if err != nil {
-		return uuid.Nil, fmt.Errorf("invalid user ID format: %w", err)
-	}
-
 	return userID, nil
 }
 
 func (handler *BusinessHandler) extractBusinessIDFromContext(ctx context.Context) (uuid.UUID, error) {
-	businessIDValue := ctx.Value("business_id")
+	businessIDValue := ctx.Value(middleware.BusinessKey)
 	if businessIDValue == nil {
 		return uuid.Nil, fmt.Errorf("business ID not found in context")
 	}
 
-	businessIDString, ok := businessIDValue.(string)
+	businessID, ok := businessIDValue.(uuid.UUID)
 	if !ok {
 		return uuid.Nil, fmt.Errorf("business ID has invalid type")
 	}
 
-	if businessIDString == "" {
+	if businessID == uuid.Nil {
 		return uuid.Nil, fmt.Errorf("business ID is empty")
 	}
 
-	businessID, err := uuid.Parse(businessIDString)
-	if err != nil {
-		return uuid.Nil, fmt.Errorf("invalid business ID format: %w", err)
-	}
-
 	return businessID, nil
 }
 
-func (handler *BusinessHandler) handleDomainError(writer http.ResponseWriter, err error) {
-	if businessError, ok := err.(*business.BusinessError); ok {
-		statusCode := handler.mapErrorCodeToHTTPStatus(businessError.Code)
-		handler.respondWithError(writer, statusCode, businessError.Code, businessError.Message)
-		return
+func (handler *BusinessHandler) extractIdentityFromContext(ctx context.Context) (uuid.UUID, uuid.UUID, error) {
+	userID, err := handler.extractUserIDFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
 	}
 
-	handler.respondWithError(writer, http.StatusInternalServerError, "INTERNAL_ERROR", "An internal error occurred")
-}
+	businessID, err := handler.extractBusinessIDFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
 
-func (handler *BusinessHandler) mapErrorCodeToHTTPStatus(errorCode string) int {
-	errorStatusMap := map[string]int{
-		"INVALID_OWNER_ID":           http.StatusBadRequest,
-		"INVALID_BUSINESS_ID":        http.StatusBadRequest,
-		"INVALID_REQUEST":            http.StatusBadRequest,
-		"INVALID_DATA":               http.StatusBadRequest,
-		"BUSINESS_NAME_REQUIRED":     http.StatusBadRequest,
-		"BUSINESS_NAME_TOO_SHORT":    http.StatusBadRequest,
-		"BUSINESS_NAME_TOO_LONG":     http.StatusBadRequest,
-		"PHONE_REQUIRED":             http.StatusBadRequest,
-		"PHONE_INVALID":              http.StatusBadRequest,
-		"SERVICE_CATEGORY_REQUIRED":  http.StatusBadRequest,
-		"SERVICE_CATEGORY_TOO_SHORT": http.StatusBadRequest,
-		"SERVICE_CATEGORY_TOO_LONG":  http.StatusBadRequest,
-		"INDUSTRY_REQUIRED":          http.StatusBadRequest,
-		"INDUSTRY_TOO_SHORT":         http.StatusBadRequest,
-		"INDUSTRY_TOO_LONG":          http.StatusBadRequest,
-		"INVALID_BUSINESS_TYPE":      http.StatusBadRequest,
-		"BUSINESS_NOT_FOUND":         http.StatusNotFound,
-	}
-
-	if status, exists := errorStatusMap[errorCode]; exists {
-		return status
-	}
-
-	return http.StatusInternalServerError
+	return userID, businessID, nil
 }
 
-func (handler *BusinessHandler) respondWithJSON(writer http.ResponseWriter, statusCode int, payload interface{}) {
+func (handler *BusinessHandler) respondWithJSON(
+	writer http.ResponseWriter,
+	request *http.Request,
+	statusCode int,
+	payload interface{},
+) {
 	writer.Header().Set("Content-Type", "application/json")
 	writer.WriteHeader(statusCode)
 
 	if payload != nil {
 		if err := json.NewEncoder(writer).Encode(payload); err != nil {
-			fmt.Printf("Error encoding JSON response: %v\n", err)
+			handler.logger.WithContext(request.Context()).Error("Failed to encode JSON response",
+				logger.Field{Key: "error", Value: err.Error()},
+			)
 		}
 	}
 }
-
-func (handler *BusinessHandler) respondWithError(writer http.ResponseWriter, statusCode int, errorCode, message string) {
-	errorResponse := ErrorHTTPResponse{
-		Error:   "error",
-		Code:    errorCode,
-		Message: message,
-	}
-	handler.respondWithJSON(writer, statusCode, errorResponse)
-}
@@ -0,0 +1,63 @@
+// File: internal/http/handlers/health/handler.go
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/health"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+)
+
+const readinessTimeout = 3 * time.Second
+
+type Handler struct {
+	checks []health.Checker
+	logger logger.Logger
+}
+
+func NewHandler(appLogger logger.Logger, checks ...health.Checker) Handler {
+	return Handler{checks: checks, logger: appLogger}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+// @Summary      Liveness
+// @Description  Proses işləyir və sorğu qəbul edə bilir. Asılılıqları yoxlamır.
+// @Tags         Health
+// @Produce      json
+// @Success      200  {object}  health.Report
+// @Router       /healthz [get]
+func (h Handler) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, health.Report{Status: health.StatusOK, Checks: map[string]health.CheckResult{}})
+}
+
+// @Summary      Readiness
+// @Description  Prosesin asılılıqları yoxlanılır (API - Postgres; worker - Postgres və job queue). Hər hansı biri uğursuz olarsa 503 qaytarılır; xəta təfərrüatı yalnız log-a yazılır.
+// @Tags         Health
+// @Produce      json
+// @Success      200  {object}  health.Report
+// @Failure      503  {object}  health.Report
+// @Router       /readyz [get]
+func (h Handler) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := health.Run(r.Context(), readinessTimeout, h.checks...)
+	if !report.Healthy() {
+		for name, result := range report.Checks {
+			if result.Err != nil {
+				h.logger.WithContext(r.Context()).Warn("Readiness check failed",
+					logger.Field{Key: "check", Value: name},
+					logger.Field{Key: "error", Value: result.Err.Error()},
+				)
+			}
+		}
+		writeJSON(w, http.StatusServiceUnavailable, report)
+		return
+	}
+	writeJSON(w, http.StatusOK, report)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/location"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
 	"github.com/google/uuid"
 )
 
@@ -31,20 +32,16 @@ type LocationResponse struct {
 	City       *string   `json:"city,omitempty"`
 	Phone      *string   `json:"phone,omitempty"`
 	IsActive   bool      `json:"is_active"`
+	Version    int       `json:"version"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 type SuccessResponse struct {
-	Success bool        `json:"success"`
-	Data    interface{} `json:"data,omitempty"`
-	Message string      `json:"message,omitempty"`
-}
-
-type ErrorResponse struct {
-	Success bool        `json:"success"`
-	Error   string      `json:"error"`
-	Details interface{} `json:"details,omitempty"`
+	Success bool             `json:"success"`
+	Data    interface{}      `json:"data,omitempty"`
+	Meta    *pagination.Meta `json:"meta,omitempty"`
+	Message string           `json:"message,omitempty"`
 }
 
 func ToDomainCreateRequest(req CreateLocationHTTPRequest) *domain.CreateLocationRequest {
@@ -74,6 +71,7 @@ func FromDomainLocation(loc *domain.Location) LocationResponse {
 		City:       loc.City,
 		Phone:      loc.Phone,
 		IsActive:   loc.IsActive,
+		Version:    loc.Version,
 		CreatedAt:  loc.CreatedAt,
 		UpdatedAt:  loc.UpdatedAt,
 	}
@@ -6,8 +6,13 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/location"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/etag"
 	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
 	"github.com/google/uuid"
 )
 
@@ -25,15 +30,6 @@ func writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	_ = json.NewEncoder(w).Encode(data)
 }
 
-func writeJSONError(w http.ResponseWriter, status int, message string, details interface{}) {
-	resp := ErrorResponse{
-		Success: false,
-		Error:   message,
-		Details: details,
-	}
-	writeJSON(w, status, resp)
-}
-
 func getBusinessIDFromContext(r *http.Request) (uuid.UUID, error) {
 	v := r.Context().Value(middleware.BusinessKey)
 	if v == nil {
@@ -57,40 +53,37 @@ func getBusinessIDFromContext(r *http.Request) (uuid.UUID, error) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
 // @Param        request body CreateLocationHTTPRequest true "Location data (Name, Address, City, State, Country, PostalCode, Latitude, Longitude, PhoneNumber)"
 // @Success      201  {object}  SuccessResponse "Location created successfully with generated UUID"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid or missing required fields"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid or missing required fields"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/locations [post]
 func (h Handler) CreateLocation(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	var req CreateLocationHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	domainReq := ToDomainCreateRequest(req)
 	loc, err := h.service.CreateLocation(r.Context(), businessID, domainReq)
 	if err != nil {
-		if locErr, ok := err.(*domain.LocationError); ok {
-			writeJSONError(w, http.StatusBadRequest, locErr.Message, locErr.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to create location", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
 		Data:    FromDomainLocation(loc),
-		Message: "Location created successfully",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.location_created"),
 	}
 	writeJSON(w, http.StatusCreated, resp)
 }
@@ -101,26 +94,41 @@ func (h Handler) CreateLocation(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "active (default), inactive or all"
+// @Param        search query string false "Case-insensitive search on name"
+// @Param        sort query string false "created_at or name (default created_at)"
+// @Param        order query string false "asc or desc (default desc; asc when sorting by name)"
 // @Success      200  {object}  SuccessResponse "Locations retrieved successfully (array of LocationHTTPResponse)"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/locations [get]
 func (h Handler) ListLocations(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
-	locs, err := h.service.ListLocations(r.Context(), businessID)
+	query, err := pagination.ParseQuery(r)
 	if err != nil {
-		writeJSONError(w, http.StatusInternalServerError, "Failed to list locations", err.Error())
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.ListLocations(r.Context(), businessID, query)
+	if err != nil {
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Data:    FromDomainLocations(locs),
+		Data:    FromDomainLocations(page.Items),
+		Meta:    pagination.MetaOf(page),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -133,35 +141,32 @@ func (h Handler) ListLocations(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Location ID (UUID format)"
 // @Success      200  {object}  SuccessResponse "Location details retrieved successfully"
-// @Failure      400  {object}  ErrorResponse "Invalid location ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Location not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid location ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Location not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/locations/{id} [get]
 func (h Handler) GetLocation(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	locID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid location ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid location ID"))
 		return
 	}
 
 	loc, err := h.service.GetLocation(r.Context(), locID, businessID)
 	if err != nil {
-		if locErr, ok := err.(*domain.LocationError); ok && locErr.Code == "NOT_FOUND" {
-			writeJSONError(w, http.StatusNotFound, locErr.Message, locErr.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to get location", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
+	etag.Set(w, loc.Version)
 	resp := SuccessResponse{
 		Success: true,
 		Data:    FromDomainLocation(loc),
@@ -177,53 +182,70 @@ func (h Handler) GetLocation(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Location ID (UUID format)"
 // @Param        request body UpdateLocationHTTPRequest true "Location update data (all fields optional - Name, Address, City, State, Country, PostalCode, Latitude, Longitude, PhoneNumber)"
-// @Success      200  {object}  SuccessResponse "Location updated successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid field values or invalid location ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Location not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Param        If-Match header string true "ETag from the last GET; * skips the version check"
+// @Success      200  {object}  SuccessResponse "Location updated successfully (data is LocationResponse, ETag carries the new version)"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid field values or invalid location ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Location not found"
+// @Failure      412  {object}  problem.Problem "Modified by another request; body carries the current representation in current"
+// @Failure      428  {object}  problem.Problem "If-Match header missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/locations/{id} [put]
 func (h Handler) UpdateLocation(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	locID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid location ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid location ID"))
+		return
+	}
+
+	version, err := etag.IfMatch(r)
+	if err != nil {
+		h.writeUpdateError(w, r, err, locID, businessID)
 		return
 	}
 
 	var req UpdateLocationHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	domainReq := ToDomainUpdateRequest(req)
-	if err := h.service.UpdateLocation(r.Context(), locID, businessID, domainReq); err != nil {
-		if locErr, ok := err.(*domain.LocationError); ok {
-			status := http.StatusBadRequest
-			if locErr.Code == "NOT_FOUND" {
-				status = http.StatusNotFound
-			}
-			writeJSONError(w, status, locErr.Message, locErr.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to update location", err.Error())
+	domainReq.Version = version
+	loc, err := h.service.UpdateLocation(r.Context(), locID, businessID, domainReq)
+	if err != nil {
+		h.writeUpdateError(w, r, err, locID, businessID)
 		return
 	}
 
+	etag.Set(w, loc.Version)
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Location updated successfully",
+		Data:    FromDomainLocation(loc),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.location_updated"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
 
+// writeUpdateError - versiya konfliktində 412 cavabına filialın cari halı və ETag-ı əlavə olunur
+func (h Handler) writeUpdateError(w http.ResponseWriter, r *http.Request, err error, id, businessID uuid.UUID) {
+	if apperr.KindOf(err) == apperr.KindPreconditionFailed {
+		if current, getErr := h.service.GetLocation(r.Context(), id, businessID); getErr == nil {
+			etag.Set(w, current.Version)
+			problem.WriteCurrent(w, r, err, FromDomainLocation(current))
+			return
+		}
+	}
+	problem.Write(w, r, err)
+}
+
 // @Summary      Deactivate Location
 // @Description  Soft-deletes a location by marking it as inactive. Location is not permanently deleted - it remains in database for historical records. Deactivated locations cannot be used for new bookings. Location must belong to authenticated business.
 // @Tags         Location
@@ -232,41 +254,33 @@ func (h Handler) UpdateLocation(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Location ID (UUID format)"
 // @Success      200  {object}  SuccessResponse "Location deactivated successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error or invalid location ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Location not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error or invalid location ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Location not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/locations/{id} [delete]
 func (h Handler) DeactivateLocation(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	locID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid location ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid location ID"))
 		return
 	}
 
 	if err := h.service.DeactivateLocation(r.Context(), locID, businessID); err != nil {
-		if locErr, ok := err.(*domain.LocationError); ok {
-			status := http.StatusBadRequest
-			if locErr.Code == "NOT_FOUND" {
-				status = http.StatusNotFound
-			}
-			writeJSONError(w, status, locErr.Message, locErr.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to deactivate location", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Location deactivated successfully",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.location_deactivated"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -0,0 +1,92 @@
+// File: internal/http/handlers/notification/dto.go
+package notification
+
+import (
+	"time"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/google/uuid"
+)
+
+// UpdatePreferencesHTTPRequest - göndərilməyən sahələr dəyişmir; sakit saatları söndürmək üçün hər ikisi "" olmalıdır
+type UpdatePreferencesHTTPRequest struct {
+	EmailEnabled    *bool   `json:"email_enabled,omitempty" example:"true"`
+	SMSEnabled      *bool   `json:"sms_enabled,omitempty" example:"false"`
+	QuietHoursStart *string `json:"quiet_hours_start,omitempty" example:"22:00"`
+	QuietHoursEnd   *string `json:"quiet_hours_end,omitempty" example:"08:00"`
+	Timezone        *string `json:"timezone,omitempty" example:"Asia/Baku"`
+}
+
+type PreferencesResponse struct {
+	EmailEnabled    bool       `json:"email_enabled"`
+	SMSEnabled      bool       `json:"sms_enabled"`
+	QuietHoursStart string     `json:"quiet_hours_start,omitempty" example:"22:00"`
+	QuietHoursEnd   string     `json:"quiet_hours_end,omitempty" example:"08:00"`
+	Timezone        string     `json:"timezone" example:"Asia/Baku"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+type NotificationResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Template    string     `json:"template" example:"password_reset"`
+	Channel     string     `json:"channel" example:"email"`
+	Locale      string     `json:"locale" example:"az"`
+	Status      string     `json:"status" example:"sent"`
+	Attempts    int        `json:"attempts"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	LastError   string     `json:"last_error,omitempty"`
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type SuccessResponse struct {
+	Success bool             `json:"success"`
+	Data    interface{}      `json:"data,omitempty"`
+	Meta    *pagination.Meta `json:"meta,omitempty"`
+	Message string           `json:"message,omitempty"`
+}
+
+func ToDomainUpdateRequest(req UpdatePreferencesHTTPRequest) *domain.UpdatePreferencesRequest {
+	return &domain.UpdatePreferencesRequest{
+		EmailEnabled:    req.EmailEnabled,
+		SMSEnabled:      req.SMSEnabled,
+		QuietHoursStart: req.QuietHoursStart,
+		QuietHoursEnd:   req.QuietHoursEnd,
+		Timezone:        req.Timezone,
+	}
+}
+
+func FromDomainPreferences(preferences *domain.Preferences) PreferencesResponse {
+	response := PreferencesResponse{
+		EmailEnabled:    preferences.EmailEnabled,
+		SMSEnabled:      preferences.SMSEnabled,
+		QuietHoursStart: preferences.QuietHoursStart,
+		QuietHoursEnd:   preferences.QuietHoursEnd,
+		Timezone:        preferences.Timezone,
+	}
+	// Saxlanmamış (standart) seçimlərin tarixi yoxdur
+	if !preferences.UpdatedAt.IsZero() {
+		response.UpdatedAt = &preferences.UpdatedAt
+	}
+	return response
+}
+
+func FromDomainNotifications(notifications []*domain.Notification) []NotificationResponse {
+	result := make([]NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		result = append(result, NotificationResponse{
+			ID:          n.ID,
+			Template:    string(n.Template),
+			Channel:     string(n.Channel),
+			Locale:      string(n.Locale),
+			Status:      string(n.Status),
+			Attempts:    n.Attempts,
+			ScheduledAt: n.ScheduledAt,
+			LastError:   n.LastError,
+			SentAt:      n.SentAt,
+			CreatedAt:   n.CreatedAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,138 @@
+// File: internal/http/handlers/notification/handler.go
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	service domain.Service
+}
+
+func NewHandler(service domain.Service) Handler {
+	return Handler{service: service}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func currentUser(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := r.Context().Value(middleware.UserIDKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// @Summary      Get Notification Preferences
+// @Description  Returns the channel preferences and quiet hours of the authenticated user. Defaults (all channels on, no quiet hours, Asia/Baku) are returned until the user saves their own.
+// @Tags         Account
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  SuccessResponse "Preferences retrieved successfully (data is PreferencesResponse)"
+// @Failure      401  {object}  problem.Problem "Unauthorized"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/account/notification-preferences [get]
+func (h Handler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	preferences, err := h.service.GetPreferences(r.Context(), userID)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{Success: true, Data: FromDomainPreferences(preferences)})
+}
+
+// @Summary      Update Notification Preferences
+// @Description  Updates channel opt-outs and quiet hours. Omitted fields keep their value; send empty quiet_hours_start and quiet_hours_end to turn quiet hours off. Quiet hours may cross midnight (22:00-08:00) and are evaluated in the given IANA timezone; notifications due during them are held until they end. Security messages (password reset, ownership transfer) ignore channel opt-outs, and password reset links also ignore quiet hours.
+// @Tags         Account
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body UpdatePreferencesHTTPRequest true "Channel switches, quiet hours (HH:MM) and timezone"
+// @Success      200  {object}  SuccessResponse "Preferences saved (data is PreferencesResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid quiet hours or timezone"
+// @Failure      401  {object}  problem.Problem "Unauthorized"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/account/notification-preferences [put]
+func (h Handler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdatePreferencesHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, r, problem.ErrInvalidBody)
+		return
+	}
+
+	preferences, err := h.service.UpdatePreferences(r.Context(), userID, ToDomainUpdateRequest(req))
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{
+		Success: true,
+		Data:    FromDomainPreferences(preferences),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.preferences_updated"),
+	})
+}
+
+// @Summary      List Notifications
+// @Description  Returns the notification log of the authenticated user, newest first: template, channel, delivery status and attempts. Recipient addresses and message contents are not included.
+// @Tags         Account
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "pending, sent, failed, suppressed or all (default all)"
+// @Param        order query string false "asc or desc (by created_at)"
+// @Success      200  {object}  SuccessResponse "Notifications retrieved successfully (array of NotificationResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/account/notifications [get]
+func (h Handler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	userID, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	query, err := pagination.ParseQuery(r)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.ListNotifications(r.Context(), userID, query)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{
+		Success: true,
+		Data:    FromDomainNotifications(page.Items),
+		Meta:    pagination.MetaOf(page),
+	})
+}
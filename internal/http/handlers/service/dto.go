@@ -6,7 +6,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/service"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
 	"github.com/google/uuid"
 )
 
@@ -18,10 +20,18 @@ type ServiceResponse struct {
 	DurationMinutes int       `json:"duration_minutes"`
 	Price           float64   `json:"price"`
 	IsActive        bool      `json:"is_active"`
+	Version         int       `json:"version"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 }
 
+type CreateServiceHTTPRequest struct {
+	Name            string  `json:"name"`
+	Description     string  `json:"description"`
+	DurationMinutes int     `json:"duration_minutes"`
+	Price           float64 `json:"price"`
+}
+
 type UpdateServiceHTTPRequest struct {
 	Name            string  `json:"name"`
 	Description     string  `json:"description"`
@@ -34,15 +44,10 @@ type AssignServicesHTTPRequest struct {
 }
 
 type SuccessResponse struct {
-	Success bool        `json:"success"`
-	Data    interface{} `json:"data,omitempty"`
-	Message string      `json:"message,omitempty"`
-}
-
-type ErrorResponse struct {
-	Success bool        `json:"success"`
-	Error   string      `json:"error"`
-	Details interface{} `json:"details,omitempty"`
+	Success bool             `json:"success"`
+	Data    interface{}      `json:"data,omitempty"`
+	Meta    *pagination.Meta `json:"meta,omitempty"`
+	Message string           `json:"message,omitempty"`
 }
 
 func FromDomainService(svc *domain.Service) ServiceResponse {
@@ -54,6 +59,7 @@ func FromDomainService(svc *domain.Service) ServiceResponse {
 		DurationMinutes: svc.DurationMinutes,
 		Price:           svc.Price,
 		IsActive:        svc.IsActive,
+		Version:         svc.Version,
 		CreatedAt:       svc.CreatedAt,
 		UpdatedAt:       svc.UpdatedAt,
 	}
@@ -67,6 +73,15 @@ func FromDomainServices(list []*domain.Service) []ServiceResponse {
 	return res
 }
 
+func ToDomainCreateServiceRequest(req CreateServiceHTTPRequest) *domain.CreateServiceRequest {
+	return &domain.CreateServiceRequest{
+		Name:            strings.TrimSpace(req.Name),
+		Description:     strings.TrimSpace(req.Description),
+		DurationMinutes: req.DurationMinutes,
+		Price:           req.Price,
+	}
+}
+
 func ToDomainUpdateServiceRequest(req UpdateServiceHTTPRequest) *domain.UpdateServiceRequest {
 	return &domain.UpdateServiceRequest{
 		Name:            strings.TrimSpace(req.Name),
@@ -85,12 +100,12 @@ func ParseServiceIDs(ids []string) ([]uuid.UUID, error) {
 		}
 		id, err := uuid.Parse(clean)
 		if err != nil {
-			return nil, fmt.Errorf("invalid service id %q: %w", raw, err)
+			return nil, apperr.InvalidField("service_ids", "INVALID_SERVICE_ID", fmt.Sprintf("invalid service id %q", raw))
 		}
 		result = append(result, id)
 	}
 	if len(result) == 0 {
-		return nil, fmt.Errorf("no valid service ids provided")
+		return nil, apperr.InvalidField("service_ids", "SERVICE_LIST_EMPTY", "no valid service ids provided")
 	}
 	return result, nil
 }
@@ -6,8 +6,13 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/service"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/etag"
 	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
 	"github.com/google/uuid"
 )
 
@@ -25,15 +30,6 @@ func writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	_ = json.NewEncoder(w).Encode(data)
 }
 
-func writeJSONError(w http.ResponseWriter, status int, message string, details interface{}) {
-	resp := ErrorResponse{
-		Success: false,
-		Error:   message,
-		Details: details,
-	}
-	writeJSON(w, status, resp)
-}
-
 func getBusinessIDFromContext(r *http.Request) (uuid.UUID, error) {
 	v := r.Context().Value(middleware.BusinessKey)
 	if v == nil {
@@ -52,26 +48,41 @@ func getBusinessIDFromContext(r *http.Request) (uuid.UUID, error) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "active (default), inactive or all"
+// @Param        search query string false "Case-insensitive search on name"
+// @Param        sort query string false "created_at or name (default created_at)"
+// @Param        order query string false "asc or desc (default desc; asc when sorting by name)"
 // @Success      200  {object}  SuccessResponse "Services retrieved successfully (array of ServiceHTTPResponse)"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/services [get]
 func (h Handler) ListServices(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	query, err := pagination.ParseQuery(r)
+	if err != nil {
+		problem.Write(w, r, err)
 		return
 	}
 
-	services, err := h.service.ListServices(r.Context(), businessID)
+	page, err := h.service.ListServices(r.Context(), businessID, query)
 	if err != nil {
-		writeJSONError(w, http.StatusInternalServerError, "Failed to list services", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Data:    FromDomainServices(services),
+		Data:    FromDomainServices(page.Items),
+		Meta:    pagination.MetaOf(page),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -84,35 +95,32 @@ func (h Handler) ListServices(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Service ID (UUID format)"
 // @Success      200  {object}  SuccessResponse "Service details retrieved successfully"
-// @Failure      400  {object}  ErrorResponse "Invalid service ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Service not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid service ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Service not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/services/{id} [get]
 func (h Handler) GetService(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	svcID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid service ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid service ID"))
 		return
 	}
 
 	svc, err := h.service.GetService(r.Context(), svcID, businessID)
 	if err != nil {
-		if se, ok := err.(*domain.ServiceError); ok && se.Code == "NOT_FOUND" {
-			writeJSONError(w, http.StatusNotFound, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to get service", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
+	etag.Set(w, svc.Version)
 	resp := SuccessResponse{
 		Success: true,
 		Data:    FromDomainService(svc),
@@ -120,6 +128,47 @@ func (h Handler) GetService(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, resp)
 }
 
+// @Summary      Create Service
+// @Description  Creates a new service for the authenticated business. Active service names are unique per business (case-insensitive).
+// @Tags         Service
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
+// @Param        request body CreateServiceHTTPRequest true "Service data (Name, Description, DurationMinutes, Price)"
+// @Success      201  {object}  SuccessResponse "Service created successfully (ServiceResponse)"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid field values"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      409  {object}  problem.Problem "Service with this name already exists"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/services [post]
+func (h Handler) CreateService(w http.ResponseWriter, r *http.Request) {
+	businessID, err := getBusinessIDFromContext(r)
+	if err != nil {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	var req CreateServiceHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, r, problem.ErrInvalidBody)
+		return
+	}
+
+	svc, err := h.service.CreateService(r.Context(), businessID, ToDomainCreateServiceRequest(req))
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	resp := SuccessResponse{
+		Success: true,
+		Data:    FromDomainService(svc),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.service_created"),
+	}
+	writeJSON(w, http.StatusCreated, resp)
+}
+
 // @Summary      Update Service
 // @Description  Updates service details for authenticated business. Supports partial updates - only provided fields are modified. Service must belong to business. Updates name, description, duration, pricing, and status.
 // @Tags         Service
@@ -128,54 +177,71 @@ func (h Handler) GetService(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Service ID (UUID format)"
 // @Param        request body UpdateServiceHTTPRequest true "Service update data (all fields optional - Name, Description, Duration, Price, IsActive)"
-// @Success      200  {object}  SuccessResponse "Service updated successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid field values or invalid service ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Service not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Param        If-Match header string true "ETag from the last GET; * skips the version check"
+// @Success      200  {object}  SuccessResponse "Service updated successfully (data is ServiceResponse, ETag carries the new version)"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid field values or invalid service ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Service not found"
+// @Failure      412  {object}  problem.Problem "Modified by another request; body carries the current representation in current"
+// @Failure      428  {object}  problem.Problem "If-Match header missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/services/{id} [put]
 func (h Handler) UpdateService(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	svcID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid service ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid service ID"))
+		return
+	}
+
+	version, err := etag.IfMatch(r)
+	if err != nil {
+		h.writeUpdateError(w, r, err, svcID, businessID)
 		return
 	}
 
 	var req UpdateServiceHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	domainReq := ToDomainUpdateServiceRequest(req)
+	domainReq.Version = version
 
-	if err := h.service.UpdateService(r.Context(), svcID, businessID, domainReq); err != nil {
-		if se, ok := err.(*domain.ServiceError); ok {
-			status := http.StatusBadRequest
-			if se.Code == "NOT_FOUND" {
-				status = http.StatusNotFound
-			}
-			writeJSONError(w, status, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to update service", err.Error())
+	svc, err := h.service.UpdateService(r.Context(), svcID, businessID, domainReq)
+	if err != nil {
+		h.writeUpdateError(w, r, err, svcID, businessID)
 		return
 	}
 
+	etag.Set(w, svc.Version)
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Service updated successfully",
+		Data:    FromDomainService(svc),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.service_updated"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
 
+// writeUpdateError - versiya konfliktində 412 cavabına xidmətin cari halı və ETag-ı əlavə olunur
+func (h Handler) writeUpdateError(w http.ResponseWriter, r *http.Request, err error, id, businessID uuid.UUID) {
+	if apperr.KindOf(err) == apperr.KindPreconditionFailed {
+		if current, getErr := h.service.GetService(r.Context(), id, businessID); getErr == nil {
+			etag.Set(w, current.Version)
+			problem.WriteCurrent(w, r, err, FromDomainService(current))
+			return
+		}
+	}
+	problem.Write(w, r, err)
+}
+
 // @Summary      Deactivate Service
 // @Description  Soft-deletes a service by marking it as inactive. Service is not permanently deleted - remains in database for historical records. Deactivated services cannot be booked. Service must belong to authenticated business.
 // @Tags         Service
@@ -184,41 +250,33 @@ func (h Handler) UpdateService(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Service ID (UUID format)"
 // @Success      200  {object}  SuccessResponse "Service deactivated successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error or invalid service ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Service not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error or invalid service ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Service not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/services/{id} [delete]
 func (h Handler) DeactivateService(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	svcID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid service ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid service ID"))
 		return
 	}
 
 	if err := h.service.DeactivateService(r.Context(), svcID, businessID); err != nil {
-		if se, ok := err.(*domain.ServiceError); ok {
-			status := http.StatusBadRequest
-			if se.Code == "NOT_FOUND" {
-				status = http.StatusNotFound
-			}
-			writeJSONError(w, status, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to deactivate service", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Service deactivated successfully",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.service_deactivated"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -232,48 +290,44 @@ func (h Handler) DeactivateService(w http.ResponseWriter, r *http.Request) {
 // @Param        staff_id path string true "Staff Member ID (UUID format)"
 // @Param        request body AssignServicesHTTPRequest true "Service IDs to assign (ServiceIDs array of UUID strings)"
 // @Success      200  {object}  SuccessResponse "Services assigned to staff successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid staff ID format, invalid service IDs, or staff/service not found"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid staff ID format, invalid service IDs, or staff/service not found"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/{staff_id}/services [post]
 func (h Handler) AssignServicesToStaff(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	staffStr := r.PathValue("staff_id")
 	staffID, err := uuid.Parse(staffStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid staff ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid staff ID"))
 		return
 	}
 
 	var req AssignServicesHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	serviceIDs, err := ParseServiceIDs(req.ServiceIDs)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Validation error", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	if err := h.service.AssignServicesToStaff(r.Context(), businessID, staffID, serviceIDs); err != nil {
-		if se, ok := err.(*domain.ServiceError); ok {
-			writeJSONError(w, http.StatusBadRequest, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to assign services to staff", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Services assigned to staff successfully",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.services_assigned"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -285,34 +339,48 @@ func (h Handler) AssignServicesToStaff(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Security     BearerAuth
 // @Param        staff_id path string true "Staff Member ID (UUID format)"
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "active (default), inactive or all"
+// @Param        search query string false "Case-insensitive search on name"
+// @Param        sort query string false "created_at or name (default name)"
+// @Param        order query string false "asc or desc (default asc)"
 // @Success      200  {object}  SuccessResponse "Staff services retrieved successfully (array of ServiceHTTPResponse)"
-// @Failure      400  {object}  ErrorResponse "Invalid staff ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid staff ID format or pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/{staff_id}/services [get]
 func (h Handler) GetStaffServices(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	staffStr := r.PathValue("staff_id")
 	staffID, err := uuid.Parse(staffStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid staff ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid staff ID"))
 		return
 	}
 
-	services, err := h.service.GetStaffServices(r.Context(), businessID, staffID)
+	query, err := pagination.ParseQuery(r)
 	if err != nil {
-		writeJSONError(w, http.StatusInternalServerError, "Failed to get staff services", err.Error())
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.GetStaffServices(r.Context(), businessID, staffID, query)
+	if err != nil {
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Data:    FromDomainServices(services),
+		Data:    FromDomainServices(page.Items),
+		Meta:    pagination.MetaOf(page),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -326,14 +394,14 @@ func (h Handler) GetStaffServices(w http.ResponseWriter, r *http.Request) {
 // @Param        staff_id path string true "Staff Member ID (UUID format)"
 // @Param        service_id path string true "Service ID (UUID format)"
 // @Success      200  {object}  SuccessResponse "Service removed from staff successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid staff/service ID format or service not assigned to staff"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid staff/service ID format or service not assigned to staff"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/{staff_id}/services/{service_id} [delete]
 func (h Handler) RemoveServiceFromStaff(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
@@ -342,28 +410,24 @@ func (h Handler) RemoveServiceFromStaff(w http.ResponseWriter, r *http.Request)
 
 	staffID, err := uuid.Parse(staffStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid staff ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid staff ID"))
 		return
 	}
 
 	serviceID, err := uuid.Parse(serviceStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid service ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid service ID"))
 		return
 	}
 
 	if err := h.service.RemoveServiceFromStaff(r.Context(), businessID, staffID, serviceID); err != nil {
-		if se, ok := err.(*domain.ServiceError); ok {
-			writeJSONError(w, http.StatusBadRequest, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to remove service from staff", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Service removed from staff successfully",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.service_unassigned"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
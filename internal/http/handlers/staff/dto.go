@@ -6,7 +6,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
 	"github.com/google/uuid"
 )
 
@@ -40,6 +42,17 @@ type ValidateInviteHTTPRequest struct {
 type AcceptInviteHTTPRequest struct {
 	Token    string `json:"token"`
 	Password string `json:"password"`
+	FullName string `json:"full_name"`
+	Phone    string `json:"phone"`
+}
+
+func (r *AcceptInviteHTTPRequest) ToDomain() *domain.AcceptInviteRequest {
+	return &domain.AcceptInviteRequest{
+		Token:    r.Token,
+		Password: r.Password,
+		FullName: r.FullName,
+		Phone:    r.Phone,
+	}
 }
 
 type StaffProfileResponse struct {
@@ -53,6 +66,7 @@ type StaffProfileResponse struct {
 	Bio        string             `json:"bio"`
 	HourlyRate float64            `json:"hourly_rate"`
 	Status     domain.StaffStatus `json:"status"`
+	Version    int                `json:"version"`
 	JoinedAt   time.Time          `json:"joined_at"`
 	CreatedAt  time.Time          `json:"created_at"`
 	UpdatedAt  time.Time          `json:"updated_at"`
@@ -74,8 +88,15 @@ type StaffWithUserResponse struct {
 }
 
 type InviteResponse struct {
-	Token     string    `json:"token"`
-	ExpiresAt time.Time `json:"expires_at"`
+	ID         uuid.UUID           `json:"id"`
+	Email      string              `json:"email,omitempty"`
+	Phone      string              `json:"phone,omitempty"`
+	Role       domain.StaffRole    `json:"role"`
+	LocationID *uuid.UUID          `json:"location_id,omitempty"`
+	Status     domain.InviteStatus `json:"status"`
+	ExpiresAt  time.Time           `json:"expires_at"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
 }
 
 type InviteDetailsResponse struct {
@@ -88,21 +109,16 @@ type InviteDetailsResponse struct {
 }
 
 type SuccessResponse struct {
-	Success bool        `json:"success"`
-	Data    interface{} `json:"data,omitempty"`
-	Message string      `json:"message,omitempty"`
-}
-
-type ErrorResponse struct {
-	Success bool        `json:"success"`
-	Error   string      `json:"error"`
-	Details interface{} `json:"details,omitempty"`
+	Success bool             `json:"success"`
+	Data    interface{}      `json:"data,omitempty"`
+	Meta    *pagination.Meta `json:"meta,omitempty"`
+	Message string           `json:"message,omitempty"`
 }
 
 func ToDomainCreateStaffRequest(req CreateStaffHTTPRequest) (*domain.CreateStaffRequest, error) {
 	userID, err := uuid.Parse(strings.TrimSpace(req.UserID))
 	if err != nil {
-		return nil, fmt.Errorf("invalid user_id: %w", err)
+		return nil, apperr.InvalidField("user_id", "INVALID_USER_ID", fmt.Sprintf("invalid user_id %q", req.UserID))
 	}
 
 	role, err := parseRole(req.Role)
@@ -110,7 +126,7 @@ func ToDomainCreateStaffRequest(req CreateStaffHTTPRequest) (*domain.CreateStaff
 		return nil, err
 	}
 
-	locID, err := parseOptionalUUID(req.LocationID)
+	locID, err := parseOptionalUUID("location_id", req.LocationID)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +145,7 @@ func ToDomainUpdateStaffRequest(req UpdateStaffHTTPRequest) (*domain.UpdateStaff
 		return nil, err
 	}
 
-	locID, err := parseOptionalUUID(req.LocationID)
+	locID, err := parseOptionalUUID("location_id", req.LocationID)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +166,7 @@ func ToDomainInviteStaffRequest(req InviteStaffHTTPRequest) (*domain.InviteStaff
 		return nil, err
 	}
 
-	locID, err := parseOptionalUUID(req.LocationID)
+	locID, err := parseOptionalUUID("location_id", req.LocationID)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +191,7 @@ func FromDomainStaffProfile(p *domain.StaffProfile) StaffProfileResponse {
 		Bio:        p.Bio,
 		HourlyRate: p.HourlyRate,
 		Status:     p.Status,
+		Version:    p.Version,
 		JoinedAt:   p.JoinedAt,
 		CreatedAt:  p.CreatedAt,
 		UpdatedAt:  p.UpdatedAt,
@@ -202,6 +219,28 @@ func FromDomainStaffWithUser(list []*domain.StaffWithUser) []StaffWithUserRespon
 	return res
 }
 
+func FromDomainInvite(inv *domain.BusinessInvite) InviteResponse {
+	return InviteResponse{
+		ID:         inv.ID,
+		Email:      inv.InvitedEmail,
+		Phone:      inv.InvitedPhone,
+		Role:       inv.Role,
+		LocationID: inv.LocationID,
+		Status:     inv.Status(time.Now()),
+		ExpiresAt:  inv.ExpiresAt,
+		CreatedAt:  inv.CreatedAt,
+		UpdatedAt:  inv.UpdatedAt,
+	}
+}
+
+func FromDomainInvites(list []*domain.BusinessInvite) []InviteResponse {
+	res := make([]InviteResponse, 0, len(list))
+	for _, inv := range list {
+		res = append(res, FromDomainInvite(inv))
+	}
+	return res
+}
+
 func FromDomainInviteDetails(inv *domain.BusinessInvite) InviteDetailsResponse {
 	return InviteDetailsResponse{
 		BusinessID: inv.BusinessID,
@@ -222,18 +261,18 @@ func parseRole(roleStr string) (domain.StaffRole, error) {
 	case "staff":
 		return domain.StaffRoleStaff, nil
 	default:
-		return "", fmt.Errorf("invalid role %q, valid values: admin, manager, staff", roleStr)
+		return "", apperr.InvalidField("role", "INVALID_ROLE", fmt.Sprintf("invalid role %q, valid values: admin, manager, staff", roleStr))
 	}
 }
 
-func parseOptionalUUID(idStr string) (*uuid.UUID, error) {
+func parseOptionalUUID(field, idStr string) (*uuid.UUID, error) {
 	clean := strings.TrimSpace(idStr)
 	if clean == "" {
 		return nil, nil
 	}
 	parsed, err := uuid.Parse(clean)
 	if err != nil {
-		return nil, fmt.Errorf("invalid uuid %q: %w", idStr, err)
+		return nil, apperr.InvalidField(field, "INVALID_ID", fmt.Sprintf("invalid uuid %q", idStr))
 	}
 	return &parsed, nil
 }
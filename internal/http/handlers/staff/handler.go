@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/etag"
 	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
 	"github.com/google/uuid"
 )
 
@@ -26,15 +30,6 @@ func writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	_ = json.NewEncoder(w).Encode(data)
 }
 
-func writeJSONError(w http.ResponseWriter, status int, message string, details interface{}) {
-	resp := ErrorResponse{
-		Success: false,
-		Error:   message,
-		Details: details,
-	}
-	writeJSON(w, status, resp)
-}
-
 func getBusinessIDFromContext(r *http.Request) (uuid.UUID, error) {
 	v := r.Context().Value(middleware.BusinessKey)
 	if v == nil {
@@ -65,45 +60,42 @@ func getUserIDFromContext(r *http.Request) (uuid.UUID, error) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
 // @Param        request body CreateStaffHTTPRequest true "Staff profile data (FirstName, LastName, Email, Phone, Specializations - optional)"
 // @Success      201  {object}  SuccessResponse "Staff profile created successfully with generated UUID"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid or missing required fields"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid or missing required fields"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff [post]
 func (h Handler) CreateStaffProfile(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	var req CreateStaffHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	domainReq, err := ToDomainCreateStaffRequest(req)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Validation error", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	profile, err := h.service.CreateStaffProfile(r.Context(), businessID, domainReq)
 	if err != nil {
-		if se, ok := err.(*domain.StaffError); ok {
-			writeJSONError(w, http.StatusBadRequest, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to create staff profile", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
 		Data:    FromDomainStaffProfile(profile),
-		Message: "Staff profile created successfully",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.staff_created"),
 	}
 	writeJSON(w, http.StatusCreated, resp)
 }
@@ -114,26 +106,41 @@ func (h Handler) CreateStaffProfile(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "active, pending, inactive or all (default: everything except inactive)"
+// @Param        search query string false "Case-insensitive search on full name"
+// @Param        sort query string false "created_at or name (default created_at)"
+// @Param        order query string false "asc or desc (default desc; asc when sorting by name)"
 // @Success      200  {object}  SuccessResponse "Staff list retrieved successfully (array of StaffWithUserHTTPResponse)"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff [get]
 func (h Handler) ListStaff(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
-	list, err := h.service.ListStaff(r.Context(), businessID)
+	query, err := pagination.ParseQuery(r)
 	if err != nil {
-		writeJSONError(w, http.StatusInternalServerError, "Failed to list staff", err.Error())
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.ListStaff(r.Context(), businessID, query)
+	if err != nil {
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Data:    FromDomainStaffWithUser(list),
+		Data:    FromDomainStaffWithUser(page.Items),
+		Meta:    pagination.MetaOf(page),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -146,35 +153,32 @@ func (h Handler) ListStaff(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Staff ID (UUID format)"
 // @Success      200  {object}  SuccessResponse "Staff details retrieved successfully"
-// @Failure      400  {object}  ErrorResponse "Invalid staff ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Staff member not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid staff ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Staff member not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/{id} [get]
 func (h Handler) GetStaff(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	staffID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid staff ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid staff ID"))
 		return
 	}
 
 	profile, err := h.service.GetStaff(r.Context(), staffID, businessID)
 	if err != nil {
-		if se, ok := err.(*domain.StaffError); ok && se.Code == "NOT_FOUND" {
-			writeJSONError(w, http.StatusNotFound, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to get staff", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
+	etag.Set(w, profile.Version)
 	resp := SuccessResponse{
 		Success: true,
 		Data:    FromDomainStaffProfile(profile),
@@ -190,58 +194,75 @@ func (h Handler) GetStaff(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Staff ID (UUID format)"
 // @Param        request body UpdateStaffHTTPRequest true "Staff update data (all fields optional - FirstName, LastName, Email, Phone, Specializations, IsActive)"
-// @Success      200  {object}  SuccessResponse "Staff member updated successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid field values or invalid staff ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Staff member not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Param        If-Match header string true "ETag from the last GET; * skips the version check"
+// @Success      200  {object}  SuccessResponse "Staff member updated successfully (data is StaffProfileResponse, ETag carries the new version)"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid field values or invalid staff ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Staff member not found"
+// @Failure      412  {object}  problem.Problem "Modified by another request; body carries the current representation in current"
+// @Failure      428  {object}  problem.Problem "If-Match header missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/{id} [put]
 func (h Handler) UpdateStaff(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	staffID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid staff ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid staff ID"))
+		return
+	}
+
+	version, err := etag.IfMatch(r)
+	if err != nil {
+		h.writeUpdateError(w, r, err, staffID, businessID)
 		return
 	}
 
 	var req UpdateStaffHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	domainReq, err := ToDomainUpdateStaffRequest(req)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Validation error", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
+	domainReq.Version = version
 
-	if err := h.service.UpdateStaff(r.Context(), staffID, businessID, domainReq); err != nil {
-		if se, ok := err.(*domain.StaffError); ok {
-			status := http.StatusBadRequest
-			if se.Code == "NOT_FOUND" {
-				status = http.StatusNotFound
-			}
-			writeJSONError(w, status, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to update staff", err.Error())
+	profile, err := h.service.UpdateStaff(r.Context(), staffID, businessID, domainReq)
+	if err != nil {
+		h.writeUpdateError(w, r, err, staffID, businessID)
 		return
 	}
 
+	etag.Set(w, profile.Version)
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Staff updated successfully",
+		Data:    FromDomainStaffProfile(profile),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.staff_updated"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
 
+// writeUpdateError - versiya konfliktində 412 cavabına işçinin cari halı və ETag-ı əlavə olunur
+func (h Handler) writeUpdateError(w http.ResponseWriter, r *http.Request, err error, id, businessID uuid.UUID) {
+	if apperr.KindOf(err) == apperr.KindPreconditionFailed {
+		if current, getErr := h.service.GetStaff(r.Context(), id, businessID); getErr == nil {
+			etag.Set(w, current.Version)
+			problem.WriteCurrent(w, r, err, FromDomainStaffProfile(current))
+			return
+		}
+	}
+	problem.Write(w, r, err)
+}
+
 // @Summary      Deactivate Staff Member
 // @Description  Soft-deletes a staff member by marking as inactive. Staff member is not permanently deleted - remains in database for historical booking records. Deactivated staff cannot book new appointments. Staff member must belong to authenticated business.
 // @Tags         Staff
@@ -250,98 +271,203 @@ func (h Handler) UpdateStaff(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        id path string true "Staff ID (UUID format)"
 // @Success      200  {object}  SuccessResponse "Staff member deactivated successfully"
-// @Failure      400  {object}  ErrorResponse "Validation error or invalid staff ID format"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      404  {object}  ErrorResponse "Staff member not found"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Validation error or invalid staff ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Staff member not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/{id} [delete]
 func (h Handler) DeactivateStaff(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	idStr := r.PathValue("id")
 	staffID, err := uuid.Parse(idStr)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid staff ID", err.Error())
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid staff ID"))
 		return
 	}
 
 	if err := h.service.DeactivateStaff(r.Context(), staffID, businessID); err != nil {
-		if se, ok := err.(*domain.StaffError); ok {
-			status := http.StatusBadRequest
-			if se.Code == "NOT_FOUND" {
-				status = http.StatusNotFound
-			}
-			writeJSONError(w, status, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to deactivate staff", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Staff deactivated successfully",
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.staff_deactivated"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
 
 // @Summary      Invite Staff Member
-// @Description  Sends invitation to join business as staff member (zero-knowledge invitation flow). Generates unique invitation token valid for 7 days. Invitee receives token and can accept by creating password and joining business. Used for multi-staff businesses to onboard team members.
+// @Description  Sends invitation to join business as staff member (zero-knowledge invitation flow). Generates unique invitation token valid for 7 days and delivers the accept link by email and/or SMS. Only a hash of the token is stored and the token is never returned to the caller.
 // @Tags         Staff
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
 // @Param        request body InviteStaffHTTPRequest true "Invitation details (FirstName, LastName, Email, Phone, Role - provider_owner, staff, customer)"
-// @Success      201  {object}  SuccessResponse "Invitation created successfully with token and expiration"
-// @Failure      400  {object}  ErrorResponse "Validation error - invalid email format or staff already invited"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or business_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Success      201  {object}  SuccessResponse "Invitation created and delivered"
+// @Failure      400  {object}  problem.Problem "Validation error - invalid email format or staff already invited"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/invites [post]
 func (h Handler) InviteStaff(w http.ResponseWriter, r *http.Request) {
 	businessID, err := getBusinessIDFromContext(r)
 	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+		problem.Write(w, r, problem.ErrUnauthenticated)
 		return
 	}
 
 	var req InviteStaffHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	domainReq, err := ToDomainInviteStaffRequest(req)
 	if err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Validation error", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
-	token, err := h.service.InviteStaff(r.Context(), businessID, domainReq)
+	invite, err := h.service.InviteStaff(r.Context(), businessID, domainReq)
 	if err != nil {
-		if se, ok := err.(*domain.StaffError); ok {
-			writeJSONError(w, http.StatusBadRequest, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to create invite", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
-	expiresAt := time.Now().Add(7 * 24 * time.Hour)
 	resp := SuccessResponse{
 		Success: true,
-		Data: InviteResponse{
-			Token:     token,
-			ExpiresAt: expiresAt,
-		},
-		Message: "Invite created successfully",
+		Data:    FromDomainInvite(invite),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.invite_sent"),
 	}
 	writeJSON(w, http.StatusCreated, resp)
 }
 
+// @Summary      List Pending Invitations
+// @Description  Lists invitations of the authenticated business that are neither accepted nor revoked. Expired invitations are included (status=expired) so they can be resent. Tokens are never returned.
+// @Tags         Staff
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "pending, expired, accepted, revoked or all (default: not accepted and not revoked)"
+// @Param        search query string false "Case-insensitive search on invited email"
+// @Param        sort query string false "created_at or name (default created_at)"
+// @Param        order query string false "asc or desc (default desc; asc when sorting by name)"
+// @Success      200  {object}  SuccessResponse "Pending invitations (array of InviteResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/staff/invites [get]
+func (h Handler) ListInvites(w http.ResponseWriter, r *http.Request) {
+	businessID, err := getBusinessIDFromContext(r)
+	if err != nil {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	query, err := pagination.ParseQuery(r)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.ListInvites(r.Context(), businessID, query)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	resp := SuccessResponse{
+		Success: true,
+		Data:    FromDomainInvites(page.Items),
+		Meta:    pagination.MetaOf(page),
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// @Summary      Resend Invitation
+// @Description  Issues a new token for a pending invitation, extends its expiry by 7 days and delivers it again by email and/or SMS. The previous link stops working.
+// @Tags         Staff
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Invite ID (UUID format)"
+// @Success      200  {object}  SuccessResponse "Invitation resent"
+// @Failure      400  {object}  problem.Problem "Invalid invite ID, invite already accepted or revoked"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Invite not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/staff/invites/{id}/resend [post]
+func (h Handler) ResendInvite(w http.ResponseWriter, r *http.Request) {
+	businessID, err := getBusinessIDFromContext(r)
+	if err != nil {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	inviteID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid invite ID"))
+		return
+	}
+
+	invite, err := h.service.ResendInvite(r.Context(), inviteID, businessID)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	resp := SuccessResponse{
+		Success: true,
+		Data:    FromDomainInvite(invite),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.invite_resent"),
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// @Summary      Revoke Invitation
+// @Description  Revokes a pending invitation so its link can no longer be accepted.
+// @Tags         Staff
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Invite ID (UUID format)"
+// @Success      200  {object}  SuccessResponse "Invitation revoked"
+// @Failure      400  {object}  problem.Problem "Invalid invite ID, invite already accepted or revoked"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      404  {object}  problem.Problem "Invite not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/staff/invites/{id} [delete]
+func (h Handler) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	businessID, err := getBusinessIDFromContext(r)
+	if err != nil {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return
+	}
+
+	inviteID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid invite ID"))
+		return
+	}
+
+	if err := h.service.RevokeInvite(r.Context(), inviteID, businessID); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	resp := SuccessResponse{
+		Success: true,
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.invite_revoked"),
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
 // @Summary      Validate Invitation Token
 // @Description  Validates staff invitation token before acceptance. Returns invitation details if token is valid and not expired. No authentication required - used during invitation onboarding flow. Token must be valid and within 7-day expiration window.
 // @Tags         Staff
@@ -349,23 +475,20 @@ func (h Handler) InviteStaff(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        request body ValidateInviteHTTPRequest true "Invitation token to validate"
 // @Success      200  {object}  SuccessResponse "Token is valid with invitation details (FirstName, LastName, Email, BusinessName)"
-// @Failure      400  {object}  ErrorResponse "Invalid, expired, or already-used invitation token"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Failure      400  {object}  problem.Problem "Invalid, expired, or already-used invitation token"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded; see Retry-After and RateLimit-* headers"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/invites/validate [post]
 func (h Handler) ValidateInviteToken(w http.ResponseWriter, r *http.Request) {
 	var req ValidateInviteHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
 	invite, err := h.service.ValidateInviteToken(r.Context(), req.Token)
 	if err != nil {
-		if se, ok := err.(*domain.StaffError); ok {
-			writeJSONError(w, http.StatusBadRequest, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to validate invite", err.Error())
+		problem.Write(w, r, err)
 		return
 	}
 
@@ -377,42 +500,41 @@ func (h Handler) ValidateInviteToken(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary      Accept Invitation (Staff Onboarding)
-// @Description  Completes staff member onboarding by accepting invitation and setting password. User must be authenticated (user_id from context). Uses zero-knowledge invitation flow - staff sets their own password without server storing temporary passwords. Staff becomes active member after acceptance. Token is marked as used and cannot be reused.
+// @Description  Accepts a staff invitation. Without a bearer token a new account is created for the invited email (role staff; the email counts as verified only when the invite was sent to that email alone) using the given password and full name. With a bearer token the signed-in account is linked instead and its email must match the invited email. User, staff profile and invite are updated in a single transaction and fresh tokens are returned.
 // @Tags         Staff
 // @Accept       json
 // @Produce      json
-// @Security     BearerAuth
-// @Param        request body AcceptInviteHTTPRequest true "Invitation token and password for account setup"
-// @Success      200  {object}  SuccessResponse "Invitation accepted successfully, staff member activated"
-// @Failure      400  {object}  ErrorResponse "Invalid or expired token, password validation failure"
-// @Failure      401  {object}  ErrorResponse "Unauthorized - user not authenticated or user_id missing"
-// @Failure      500  {object}  ErrorResponse "Internal server error"
+// @Param        request body AcceptInviteHTTPRequest true "Invitation token; password and full_name are required when creating a new account"
+// @Success      200  {object}  SuccessResponse "Invitation accepted, data contains access and refresh tokens"
+// @Failure      400  {object}  problem.Problem "Invalid or expired token, password validation failure"
+// @Failure      401  {object}  problem.Problem "Invalid bearer token"
+// @Failure      403  {object}  problem.Problem "Signed-in account does not match the invited email"
+// @Failure      409  {object}  problem.Problem "Email already registered or user already a member"
+// @Failure      429  {object}  problem.Problem "Rate limit exceeded; see Retry-After and RateLimit-* headers"
+// @Failure      500  {object}  problem.Problem "Internal server error"
 // @Router       /api/v1/staff/invites/accept [post]
 func (h Handler) AcceptInvite(w http.ResponseWriter, r *http.Request) {
-	userID, err := getUserIDFromContext(r)
-	if err != nil {
-		writeJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
-		return
+	var currentUserID *uuid.UUID
+	if userID, ok := r.Context().Value(middleware.UserIDKey).(uuid.UUID); ok {
+		currentUserID = &userID
 	}
 
 	var req AcceptInviteHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSONError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		problem.Write(w, r, problem.ErrInvalidBody)
 		return
 	}
 
-	if err := h.service.AcceptInvite(r.Context(), userID, req.Token, req.Password); err != nil {
-		if se, ok := err.(*domain.StaffError); ok {
-			writeJSONError(w, http.StatusBadRequest, se.Message, se.Code)
-			return
-		}
-		writeJSONError(w, http.StatusInternalServerError, "Failed to accept invite", err.Error())
+	authResp, err := h.service.AcceptInvite(r.Context(), currentUserID, req.ToDomain())
+	if err != nil {
+		problem.Write(w, r, err)
 		return
 	}
 
 	resp := SuccessResponse{
 		Success: true,
-		Message: "Invite accepted successfully",
+		Data:    authResp,
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.invite_accepted"),
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
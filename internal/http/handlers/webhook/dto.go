@@ -0,0 +1,145 @@
+// File: internal/http/handlers/webhook/dto.go
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/google/uuid"
+)
+
+type CreateEndpointHTTPRequest struct {
+	URL         string   `json:"url" example:"https://example.com/hooks/booking"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types" example:"staff.created,staff.updated"`
+}
+
+type UpdateEndpointHTTPRequest struct {
+	URL         string   `json:"url" example:"https://example.com/hooks/booking"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types" example:"staff.created,staff.updated"`
+	IsActive    *bool    `json:"is_active,omitempty"`
+}
+
+type EndpointResponse struct {
+	ID          uuid.UUID `json:"id"`
+	BusinessID  uuid.UUID `json:"business_id"`
+	URL         string    `json:"url"`
+	Description string    `json:"description,omitempty"`
+	EventTypes  []string  `json:"event_types"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreatedEndpointResponse - secret yalnız yaradılma cavabında qaytarılır
+type CreatedEndpointResponse struct {
+	EndpointResponse
+	Secret string `json:"secret" example:"whsec_3f6c..."`
+}
+
+type DeliveryResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	EndpointID     uuid.UUID       `json:"endpoint_id"`
+	EventID        uuid.UUID       `json:"event_id"`
+	EventType      string          `json:"event_type" example:"staff.created"`
+	Status         string          `json:"status" example:"succeeded"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty" example:"200"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DurationMs     *int64          `json:"duration_ms,omitempty"`
+	RedeliveryOf   *uuid.UUID      `json:"redelivery_of,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type SuccessResponse struct {
+	Success bool             `json:"success"`
+	Data    interface{}      `json:"data,omitempty"`
+	Meta    *pagination.Meta `json:"meta,omitempty"`
+	Message string           `json:"message,omitempty"`
+}
+
+func toEventTypes(values []string) []events.Type {
+	result := make([]events.Type, 0, len(values))
+	for _, value := range values {
+		result = append(result, events.Type(value))
+	}
+	return result
+}
+
+func ToDomainCreateRequest(req CreateEndpointHTTPRequest) *domain.CreateEndpointRequest {
+	return &domain.CreateEndpointRequest{
+		URL:         req.URL,
+		Description: req.Description,
+		EventTypes:  toEventTypes(req.EventTypes),
+	}
+}
+
+func ToDomainUpdateRequest(req UpdateEndpointHTTPRequest) *domain.UpdateEndpointRequest {
+	return &domain.UpdateEndpointRequest{
+		URL:         req.URL,
+		Description: req.Description,
+		EventTypes:  toEventTypes(req.EventTypes),
+		IsActive:    req.IsActive,
+	}
+}
+
+func FromDomainEndpoint(endpoint *domain.Endpoint) EndpointResponse {
+	eventTypes := make([]string, 0, len(endpoint.EventTypes))
+	for _, t := range endpoint.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+	return EndpointResponse{
+		ID:          endpoint.ID,
+		BusinessID:  endpoint.BusinessID,
+		URL:         endpoint.URL,
+		Description: endpoint.Description,
+		EventTypes:  eventTypes,
+		IsActive:    endpoint.IsActive,
+		CreatedAt:   endpoint.CreatedAt,
+		UpdatedAt:   endpoint.UpdatedAt,
+	}
+}
+
+func FromDomainEndpoints(endpoints []*domain.Endpoint) []EndpointResponse {
+	result := make([]EndpointResponse, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, FromDomainEndpoint(endpoint))
+	}
+	return result
+}
+
+func FromDomainDelivery(delivery *domain.Delivery) DeliveryResponse {
+	return DeliveryResponse{
+		ID:             delivery.ID,
+		EndpointID:     delivery.EndpointID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DurationMs:     delivery.DurationMs,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		DeliveredAt:    delivery.DeliveredAt,
+		Payload:        delivery.Payload,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func FromDomainDeliveries(deliveries []*domain.Delivery) []DeliveryResponse {
+	result := make([]DeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, FromDomainDelivery(delivery))
+	}
+	return result
+}
@@ -0,0 +1,328 @@
+// File: internal/http/handlers/webhook/handler.go
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/pagination"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/google/uuid"
+)
+
+// ErrOwnerOnly - route-un sahiblik yoxlaması (middleware.OwnerMiddleware) sahib olmayana qaytarır
+var ErrOwnerOnly = apperr.Forbidden("WEBHOOK_OWNER_ONLY", "Only business owners can manage webhooks")
+
+type Handler struct {
+	service domain.Service
+}
+
+func NewHandler(service domain.Service) Handler {
+	return Handler{service: service}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+// authorize - biznes konteksti olmalıdır; uğursuz halda cavab yazılır.
+// Sahiblik route-da OwnerMiddleware ilə yoxlanır.
+func authorize(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	businessID, ok := r.Context().Value(middleware.BusinessKey).(uuid.UUID)
+	if !ok || businessID == uuid.Nil {
+		problem.Write(w, r, problem.ErrUnauthenticated)
+		return uuid.Nil, false
+	}
+	return businessID, true
+}
+
+func pathID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		problem.Write(w, r, apperr.Validation("INVALID_ID", "Invalid ID"))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// @Summary      Create Webhook Endpoint
+// @Description  Registers a URL that receives signed POST requests for the selected event types. The response carries the signing secret; it is shown only once. Each request has Webhook-Id (event ID, stable across retries), Webhook-Event, Webhook-Timestamp and Webhook-Signature: t=<unix>,v1=<hex HMAC-SHA256(secret, "<t>.<body>")> headers. Any non-2xx response or timeout is retried with exponential backoff (30s doubling, 8 attempts over about two hours). Available to business owners (the primary owner and co-owners) only.
+// @Tags         Webhook
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        Idempotency-Key header string false "Unique key per logical request; retries with the same key and body replay the stored response for 24h"
+// @Param        request body CreateEndpointHTTPRequest true "Endpoint URL, optional description and subscribed event types"
+// @Success      201  {object}  SuccessResponse "Endpoint created (data is CreatedEndpointResponse including secret)"
+// @Failure      400  {object}  problem.Problem "Invalid URL or unknown event type"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can manage webhooks"
+// @Failure      409  {object}  problem.Problem "Maximum number of endpoints reached"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/webhooks [post]
+func (h Handler) CreateEndpoint(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := authorize(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateEndpointHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, r, problem.ErrInvalidBody)
+		return
+	}
+
+	endpoint, err := h.service.CreateEndpoint(r.Context(), businessID, ToDomainCreateRequest(req))
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, SuccessResponse{
+		Success: true,
+		Data:    CreatedEndpointResponse{EndpointResponse: FromDomainEndpoint(endpoint), Secret: endpoint.Secret},
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.webhook_created"),
+	})
+}
+
+// @Summary      List Webhook Endpoints
+// @Description  Returns the webhook endpoints of the authenticated business. Secrets are never included.
+// @Tags         Webhook
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "active, inactive or all (default all)"
+// @Param        search query string false "Case-insensitive search on URL and description"
+// @Param        sort query string false "created_at or name (URL) (default created_at)"
+// @Param        order query string false "asc or desc"
+// @Success      200  {object}  SuccessResponse "Endpoints retrieved successfully (array of EndpointResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can manage webhooks"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/webhooks [get]
+func (h Handler) ListEndpoints(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := authorize(w, r)
+	if !ok {
+		return
+	}
+
+	query, err := pagination.ParseQuery(r)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.ListEndpoints(r.Context(), businessID, query)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{
+		Success: true,
+		Data:    FromDomainEndpoints(page.Items),
+		Meta:    pagination.MetaOf(page),
+	})
+}
+
+// @Summary      Get Webhook Endpoint
+// @Description  Returns a single webhook endpoint of the authenticated business.
+// @Tags         Webhook
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Endpoint ID (UUID format)"
+// @Success      200  {object}  SuccessResponse "Endpoint details (data is EndpointResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid endpoint ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can manage webhooks"
+// @Failure      404  {object}  problem.Problem "Endpoint not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/webhooks/{id} [get]
+func (h Handler) GetEndpoint(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := authorize(w, r)
+	if !ok {
+		return
+	}
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	endpoint, err := h.service.GetEndpoint(r.Context(), id, businessID)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{Success: true, Data: FromDomainEndpoint(endpoint)})
+}
+
+// @Summary      Update Webhook Endpoint
+// @Description  Replaces the URL, description and event subscriptions of an endpoint. is_active pauses or resumes deliveries; when omitted the current state is kept. Pending deliveries are held while the endpoint is inactive and sent after it is re-activated; events raised while it is inactive are not queued.
+// @Tags         Webhook
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Endpoint ID (UUID format)"
+// @Param        request body UpdateEndpointHTTPRequest true "Endpoint data"
+// @Success      200  {object}  SuccessResponse "Endpoint updated (data is EndpointResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid URL, unknown event type or invalid endpoint ID"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can manage webhooks"
+// @Failure      404  {object}  problem.Problem "Endpoint not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/webhooks/{id} [put]
+func (h Handler) UpdateEndpoint(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := authorize(w, r)
+	if !ok {
+		return
+	}
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateEndpointHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, r, problem.ErrInvalidBody)
+		return
+	}
+
+	endpoint, err := h.service.UpdateEndpoint(r.Context(), id, businessID, ToDomainUpdateRequest(req))
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{
+		Success: true,
+		Data:    FromDomainEndpoint(endpoint),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.webhook_updated"),
+	})
+}
+
+// @Summary      Delete Webhook Endpoint
+// @Description  Permanently deletes an endpoint together with its delivery log. Pending deliveries are dropped.
+// @Tags         Webhook
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Endpoint ID (UUID format)"
+// @Success      200  {object}  SuccessResponse "Endpoint deleted"
+// @Failure      400  {object}  problem.Problem "Invalid endpoint ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can manage webhooks"
+// @Failure      404  {object}  problem.Problem "Endpoint not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/webhooks/{id} [delete]
+func (h Handler) DeleteEndpoint(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := authorize(w, r)
+	if !ok {
+		return
+	}
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteEndpoint(r.Context(), id, businessID); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{
+		Success: true,
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.webhook_deleted"),
+	})
+}
+
+// @Summary      List Webhook Deliveries
+// @Description  Returns the delivery log of an endpoint, newest first: status, attempt count, next retry time, last response status and (truncated) body, error and duration, and the signed payload.
+// @Tags         Webhook
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Endpoint ID (UUID format)"
+// @Param        limit query int false "Page size (1-100, default 20)"
+// @Param        offset query int false "Rows to skip; cannot be combined with cursor"
+// @Param        cursor query string false "meta.next_cursor from the previous page"
+// @Param        status query string false "pending, succeeded, failed or all (default all)"
+// @Param        order query string false "Sort direction by created_at" Enums(asc, desc)
+// @Success      200  {object}  SuccessResponse "Deliveries retrieved successfully (array of DeliveryResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid endpoint ID or pagination parameters"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can manage webhooks"
+// @Failure      404  {object}  problem.Problem "Endpoint not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/webhooks/{id}/deliveries [get]
+func (h Handler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := authorize(w, r)
+	if !ok {
+		return
+	}
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	query, err := pagination.ParseQuery(r)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	page, err := h.service.ListDeliveries(r.Context(), id, businessID, query)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SuccessResponse{
+		Success: true,
+		Data:    FromDomainDeliveries(page.Items),
+		Meta:    pagination.MetaOf(page),
+	})
+}
+
+// @Summary      Redeliver Webhook
+// @Description  Queues a new delivery of the same payload to the endpoint; the worker sends it on its next run. The original delivery stays unchanged in the log and the new one references it in redelivery_of. Works for succeeded and failed deliveries alike.
+// @Tags         Webhook
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Delivery ID (UUID format)"
+// @Success      202  {object}  SuccessResponse "Redelivery queued (data is DeliveryResponse)"
+// @Failure      400  {object}  problem.Problem "Invalid delivery ID format"
+// @Failure      401  {object}  problem.Problem "Unauthorized - user not authenticated or business_id missing"
+// @Failure      403  {object}  problem.Problem "Only business owners can manage webhooks"
+// @Failure      404  {object}  problem.Problem "Delivery not found"
+// @Failure      500  {object}  problem.Problem "Internal server error"
+// @Router       /api/v1/webhooks/deliveries/{id}/redeliver [post]
+func (h Handler) Redeliver(w http.ResponseWriter, r *http.Request) {
+	businessID, ok := authorize(w, r)
+	if !ok {
+		return
+	}
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.service.Redeliver(r.Context(), id, businessID)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, SuccessResponse{
+		Success: true,
+		Data:    FromDomainDelivery(delivery),
+		Message: i18n.T(i18n.FromContext(r.Context()), "message.webhook_redelivery_queued"),
+	})
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+)
+
+// statusRecorder - cavabın status kodunu və ölçüsünü yadda saxlayır
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	bytes       int
+	wroteHeader bool
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status = status
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	n, err := rec.ResponseWriter.Write(b)
+	rec.bytes += n
+	return n, err
+}
+
+// Unwrap - http.ResponseController Flush/Hijack üçün əsl writer-ə çata bilsin
+func (rec *statusRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+func AccessLogMiddleware(appLogger logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			fields := []logger.Field{
+				{Key: "method", Value: r.Method},
+				{Key: "path", Value: r.URL.Path},
+				{Key: "status", Value: rec.status},
+				{Key: "latency_ms", Value: time.Since(start).Milliseconds()},
+				{Key: "bytes", Value: rec.bytes},
+				{Key: "remote_addr", Value: r.RemoteAddr},
+				{Key: "user_agent", Value: r.UserAgent()},
+			}
+			log := appLogger.WithContext(r.Context())
+			switch {
+			case rec.status >= http.StatusInternalServerError:
+				log.Error("HTTP request", fields...)
+			case rec.status >= http.StatusBadRequest:
+				log.Warn("HTTP request", fields...)
+			case r.URL.Path == "/healthz" || r.URL.Path == "/readyz":
+				log.Debug("HTTP request", fields...)
+			default:
+				log.Info("HTTP request", fields...)
+			}
+		})
+	}
+}
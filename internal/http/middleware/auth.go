@@ -2,11 +2,16 @@ package middleware
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"strings"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
 	authDomain "github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 )
 
 type contextKey string
@@ -23,34 +28,68 @@ func AuthMiddleware(tokenManager authDomain.TokenManager) func(http.Handler) htt
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
-				sendError(w, http.StatusUnauthorized, "NO_TOKEN", "Authorization header tələb olunur")
+				problem.Write(w, r, apperr.Unauthorized("NO_TOKEN", "Authorization header is required"))
 				return
 			}
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				sendError(w, http.StatusUnauthorized, "INVALID_TOKEN_FORMAT", "Token formatı yanlışdır")
-				return
-			}
-			token := parts[1]
-			claims, err := tokenManager.ValidateAccessToken(token)
-			if err != nil {
-				sendError(w, http.StatusUnauthorized, "INVALID_TOKEN", "Token etibarsızdır")
+			authenticate(tokenManager, next, w, r, authHeader)
+		})
+	}
+}
+
+// OptionalAuthMiddleware - header yoxdursa sorğunu anonim buraxır, varsa AuthMiddleware kimi yoxlayır
+func OptionalAuthMiddleware(tokenManager authDomain.TokenManager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			if authHeader == "" {
+				next.ServeHTTP(w, r)
 				return
 			}
-			ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
-			ctx = context.WithValue(ctx, RoleKey, string(claims.Role))
-			ctx = context.WithValue(ctx, BusinessKey, claims.BusinessID)
-			next.ServeHTTP(w, r.WithContext(ctx))
+			authenticate(tokenManager, next, w, r, authHeader)
 		})
 	}
 }
 
+func authenticate(
+	tokenManager authDomain.TokenManager,
+	next http.Handler,
+	w http.ResponseWriter,
+	r *http.Request,
+	authHeader string,
+) {
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		problem.Write(w, r, apperr.Unauthorized("INVALID_TOKEN_FORMAT", "Authorization header must be a Bearer token"))
+		return
+	}
+	token := parts[1]
+	claims, err := tokenManager.ValidateAccessToken(token)
+	if err != nil {
+		problem.Write(w, r, apperr.Unauthorized("INVALID_TOKEN", "Access token is invalid or expired"))
+		return
+	}
+	ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, RoleKey, string(claims.Role))
+	ctx = audit.WithActor(ctx, claims.UserID)
+	logFields := []logger.Field{{Key: "user_id", Value: claims.UserID.String()}}
+	if claims.BusinessID != nil {
+		ctx = context.WithValue(ctx, BusinessKey, *claims.BusinessID)
+		ctx = tenant.WithBusinessID(ctx, *claims.BusinessID)
+		logFields = append(logFields, logger.Field{Key: "business_id", Value: claims.BusinessID.String()})
+	}
+	if locale, ok := i18n.Parse(claims.Locale); ok {
+		ctx = i18n.WithLocale(ctx, locale)
+	}
+	ctx = logger.ContextWithFields(ctx, logFields...)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
 func RoleMiddleware(allowedRoles ...string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			roleVal := r.Context().Value(RoleKey)
 			if roleVal != nil {
-				sendError(w, http.StatusForbidden, "NO_ROLE", "Rol məlumatı tapılmadı")
+				problem.Write(w, r, apperr.Forbidden("NO_ROLE", "Role is missing from the token"))
 				return
 			}
 			userRole := roleVal.(string)
@@ -62,20 +101,10 @@ func RoleMiddleware(allowedRoles ...string) func(http.Handler) http.Handler {
 				}
 			}
 			if !allowed {
-				sendError(w, http.StatusForbidden, "FORBIDDEN", "İcazəsiz giriş")
+				problem.Write(w, r, apperr.Forbidden("FORBIDDEN", "Access denied"))
 				return
 			}
 			next.ServeHTTP(w, r)
 		})
 	}
 }
-
-func sendError(w http.ResponseWriter, status int, code, msg string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"success": false,
-		"code":    code,
-		"message": msg,
-	})
-}
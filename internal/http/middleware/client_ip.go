@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+)
+
+type clientIPKey struct{}
+
+// ClientIPMiddleware - klientin IP-sini bir dəfə müəyyən edir; rate limit və audit jurnalı onu kontekstdən oxuyur.
+// RequestIDMiddleware-dən sonra gəlməlidir.
+func ClientIPMiddleware(trustProxy bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := resolveClientIP(r, trustProxy)
+			ctx := context.WithValue(r.Context(), clientIPKey{}, ip)
+			ctx = audit.WithOrigin(ctx, audit.Origin{IP: ip, RequestID: RequestIDFromContext(ctx)})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ClientIPFromContext - middleware tətbiq olunmayıbsa TCP bağlantısının ünvanı qaytarılır
+func ClientIPFromContext(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return resolveClientIP(r, false)
+}
+
+// resolveClientIP - proxy-yə etibar olunursa X-Forwarded-For-un son elementi (proxy-nin özünün əlavə etdiyi),
+// əks halda TCP bağlantısının ünvanı
+func resolveClientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			parts := strings.Split(forwarded, ",")
+			if ip := strings.TrimSpace(parts[len(parts)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
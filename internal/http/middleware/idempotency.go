@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/idempotency"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotentRequestBytes = 1 << 20
+)
+
+// replayedHeaders - təkrar cavabda bərpa olunan header-lər
+var replayedHeaders = []string{"Content-Type", "Content-Language", "ETag", "Location"}
+
+var errIdempotentBodyTooLarge = apperr.Validation("REQUEST_TOO_LARGE", "Request body is too large for an idempotent request")
+
+// responseCapture - cavabı klientə yazır və eyni zamanda saxlamaq üçün yaddaşda toplayır
+type responseCapture struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (c *responseCapture) WriteHeader(status int) {
+	if !c.wroteHeader {
+		c.status = status
+		c.wroteHeader = true
+	}
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *responseCapture) Write(b []byte) (int, error) {
+	if !c.wroteHeader {
+		c.WriteHeader(http.StatusOK)
+	}
+	c.body.Write(b)
+	return c.ResponseWriter.Write(b)
+}
+
+func (c *responseCapture) Unwrap() http.ResponseWriter {
+	return c.ResponseWriter
+}
+
+// IdempotencyMiddleware - Idempotency-Key header-i olan POST sorğularının cavabını TTL müddətinə saxlayır.
+// AuthMiddleware-dən sonra gəlməlidir: açarlar istifadəçi üzrə ayrılır. Header yoxdursa sorğu olduğu kimi keçir.
+// 5xx cavablar saxlanmır, açar buraxılır ki, klient yenidən cəhd edə bilsin.
+func IdempotencyMiddleware(repo idempotency.Repository, appLogger logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			userID, authenticated := r.Context().Value(UserIDKey).(uuid.UUID)
+			if r.Method != http.MethodPost || key == "" || !authenticated {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !idempotency.ValidKey(key) {
+				problem.Write(w, r, idempotency.ErrInvalidKey)
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentRequestBytes+1))
+			if err != nil {
+				problem.Write(w, r, problem.ErrInvalidBody)
+				return
+			}
+			if len(body) > maxIdempotentRequestBytes {
+				problem.Write(w, r, errIdempotentBodyTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			now := time.Now().UTC()
+			record := &idempotency.Record{
+				UserID:      userID,
+				Key:         key,
+				Method:      r.Method,
+				Path:        r.URL.Path,
+				Fingerprint: fingerprint(r, body),
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(idempotency.TTL),
+			}
+			existing, acquired, err := repo.Acquire(r.Context(), record)
+			if err != nil {
+				problem.Write(w, r, err)
+				return
+			}
+			if !acquired {
+				replay(w, r, existing, record.Fingerprint)
+				return
+			}
+
+			log := appLogger.WithContext(r.Context())
+			// Cavab klientə yazıldıqdan sonra saxlanır; klient bağlantını kəssə də qeyd tamamlanmalıdır
+			storeCtx := context.WithoutCancel(r.Context())
+			capture := &responseCapture{ResponseWriter: w, status: http.StatusOK}
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := repo.Release(storeCtx, userID, key); err != nil {
+					log.Warn("Failed to release idempotency key", logger.Field{Key: "error", Value: err.Error()})
+				}
+			}()
+
+			next.ServeHTTP(capture, r)
+
+			if capture.status >= http.StatusInternalServerError {
+				return
+			}
+			response := &idempotency.Response{
+				StatusCode: capture.status,
+				Headers:    make(map[string]string, len(replayedHeaders)),
+				Body:       capture.body.Bytes(),
+			}
+			for _, name := range replayedHeaders {
+				if value := capture.Header().Get(name); value != "" {
+					response.Headers[name] = value
+				}
+			}
+			if err := repo.Complete(storeCtx, userID, key, response); err != nil {
+				log.Warn("Failed to store idempotent response", logger.Field{Key: "error", Value: err.Error()})
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+// replay - eyni açarla gələn təkrar sorğuya saxlanmış cavabı qaytarır
+func replay(w http.ResponseWriter, r *http.Request, existing *idempotency.Record, fingerprint string) {
+	if existing.Fingerprint != fingerprint {
+		problem.Write(w, r, idempotency.ErrKeyReused)
+		return
+	}
+	if existing.Response == nil {
+		w.Header().Set("Retry-After", "1")
+		problem.Write(w, r, idempotency.ErrInProgress)
+		return
+	}
+	for name, value := range existing.Response.Headers {
+		w.Header().Set(name, value)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(existing.Response.StatusCode)
+	_, _ = w.Write(existing.Response.Body)
+}
+
+// fingerprint - metod, path, query və body-nin SHA-256 heşi; eyni açar başqa sorğu ilə istifadə oluna bilməz
+func fingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(strings.Join([]string{r.Method, r.URL.RequestURI()}, " ")))
+	hash.Write([]byte{'\n'})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
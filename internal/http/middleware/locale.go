@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+)
+
+// LocaleMiddleware - Accept-Language-dən sorğunun dilini seçir.
+// Token-də istifadəçinin saxlanmış dili varsa AuthMiddleware onu üstün tutur.
+func LocaleMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Language")
+		locale := i18n.Negotiate(r.Header.Get("Accept-Language"))
+		next.ServeHTTP(w, r.WithContext(i18n.WithLocale(r.Context(), locale)))
+	})
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestObserver - HTTP metriklərini yazan adapter (infrastructure/metrics)
+type RequestObserver interface {
+	ObserveRequest(method, route string, status int, duration time.Duration)
+}
+
+// MetricsMiddleware - ServeMux-u birbaşa əhatə etməlidir: route pattern (r.Pattern)
+// mux tərəfindən eyni request üzərində yazılır. Label kardinallığı path yox, pattern ilə məhdudlaşır.
+func MetricsMiddleware(observer RequestObserver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			defer func() {
+				status := rec.status
+				p := recover()
+				if p != nil {
+					status = http.StatusInternalServerError
+				}
+				route := r.Pattern
+				if _, path, found := strings.Cut(route, " "); found {
+					route = path
+				}
+				if route == "" {
+					route = "unmatched"
+				}
+				observer.ObserveRequest(r.Method, route, status, time.Since(start))
+				if p != nil {
+					panic(p)
+				}
+			}()
+
+			next.ServeHTTP(rec, r)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/google/uuid"
+)
+
+// OwnerChecker - business_owners üzrə yoxlama (business domeni)
+type OwnerChecker interface {
+	IsOwner(ctx context.Context, businessID, userID uuid.UUID) (bool, error)
+}
+
+// OwnerMiddleware - yalnız biznesin sahibləri (əsas sahib və co-owner-lər) keçir.
+// JWT-dəki rola baxılmır: sahiblik təhvil verildikdə və ya co-owner əlavə/silindikdə rol köhnə qalır.
+// AuthMiddleware-dən sonra gəlməlidir; denied - sahib olmayana qaytarılan xəta.
+func OwnerMiddleware(checker OwnerChecker, denied error) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			businessID, ok := r.Context().Value(BusinessKey).(uuid.UUID)
+			if !ok || businessID == uuid.Nil {
+				problem.Write(w, r, problem.ErrUnauthenticated)
+				return
+			}
+			userID, _ := r.Context().Value(UserIDKey).(uuid.UUID)
+
+			owner, err := checker.IsOwner(r.Context(), businessID, userID)
+			if err != nil {
+				problem.Write(w, r, err)
+				return
+			}
+			if !owner {
+				problem.Write(w, r, denied)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/ratelimit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+// RateLimiter - route-lara token bucket kvotası tətbiq edir. store nil-dirsə limit söndürülüb.
+type RateLimiter struct {
+	store     ratelimit.Store
+	overrides map[string]ratelimit.Limit
+	logger    logger.Logger
+}
+
+func NewRateLimiter(
+	store ratelimit.Store,
+	overrides map[string]ratelimit.Limit,
+	appLogger logger.Logger,
+) *RateLimiter {
+	return &RateLimiter{store: store, overrides: overrides, logger: appLogger}
+}
+
+// Limit - policy üçün middleware. User/business scope-lu policy AuthMiddleware-dən sonra gəlməlidir;
+// kontekstdə istifadəçi (biznes) yoxdursa kvota IP üzrə hesablanır.
+// Store xəta qaytarsa sorğu buraxılır (fail open) - limiter əlçatmaz olanda API dayanmamalıdır.
+func (l *RateLimiter) Limit(policy ratelimit.Policy) func(http.Handler) http.Handler {
+	if override, ok := l.overrides[policy.Name]; ok {
+		policy.Limit = override
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if l.store == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			decision, err := l.store.Take(r.Context(), l.key(r, policy), policy.Limit, time.Now().UTC())
+			if err != nil {
+				l.logger.WithContext(r.Context()).Warn("Rate limiter unavailable, request allowed",
+					logger.Field{Key: "policy", Value: policy.Name},
+					logger.Field{Key: "error", Value: err.Error()},
+				)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			setRateLimitHeaders(w, policy, decision)
+			if !decision.Allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+				problem.Write(w, r, ratelimit.ErrRateLimited)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (l *RateLimiter) key(r *http.Request, policy ratelimit.Policy) string {
+	scope, value := ratelimit.ScopeIP, ClientIPFromContext(r)
+	switch policy.Scope {
+	case ratelimit.ScopeBusiness:
+		if businessID, ok := r.Context().Value(BusinessKey).(uuid.UUID); ok {
+			scope, value = ratelimit.ScopeBusiness, businessID.String()
+			break
+		}
+		fallthrough
+	case ratelimit.ScopeUser:
+		if userID, ok := r.Context().Value(UserIDKey).(uuid.UUID); ok {
+			scope, value = ratelimit.ScopeUser, userID.String()
+		}
+	}
+	return policy.Name + ":" + string(scope) + ":" + value
+}
+
+// setRateLimitHeaders - bir sorğuya bir neçə policy tətbiq olunursa klientə ən az qalan kvota göstərilir
+func setRateLimitHeaders(w http.ResponseWriter, policy ratelimit.Policy, decision ratelimit.Decision) {
+	header := w.Header()
+	if current, err := strconv.Atoi(header.Get("RateLimit-Remaining")); err == nil && current < decision.Remaining {
+		return
+	}
+	header.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	header.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.ResetAfter)))
+	header.Set("RateLimit-Policy", strconv.Itoa(policy.Limit.Requests)+";w="+strconv.Itoa(ceilSeconds(policy.Limit.Per)))
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/http/problem"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+)
+
+// RecoverMiddleware - handler-də panic baş verərsə prosesi yıxmır, problem+json 500 qaytarır
+func RecoverMiddleware(appLogger logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				if p == http.ErrAbortHandler {
+					panic(p)
+				}
+				appLogger.WithContext(r.Context()).Error("Panic recovered",
+					logger.Field{Key: "panic", Value: fmt.Sprint(p)},
+					logger.Field{Key: "method", Value: r.Method},
+					logger.Field{Key: "path", Value: r.URL.Path},
+					logger.Field{Key: "stack", Value: string(debug.Stack())},
+				)
+				if !rec.wroteHeader {
+					problem.Write(w, r, apperr.Internal("INTERNAL_ERROR", "panic recovered"))
+				}
+			}()
+			next.ServeHTTP(rec, r)
+		})
+	}
+}
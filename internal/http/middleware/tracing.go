@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware - gələn traceparent-i qəbul edir, server span açır və trace_id-ni
+// log context-inə yazır ki, loglar trace ilə əlaqələndirilsin.
+func TracingMiddleware(next http.Handler) http.Handler {
+	withTraceID := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if sc := trace.SpanContextFromContext(r.Context()); sc.IsValid() {
+			ctx := logger.ContextWithFields(r.Context(), logger.Field{Key: "trace_id", Value: sc.TraceID().String()})
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+	return otelhttp.NewHandler(withTraceID, "http.request",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "HTTP " + r.Method
+		}),
+	)
+}
+
+// TraceRouteMiddleware - MetricsMiddleware kimi ServeMux-u birbaşa əhatə edir: sorğu
+// route-a uyğunlaşdıqdan sonra span adı "GET /api/v1/staff/{id}" formasına gətirilir.
+func TraceRouteMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		if r.Pattern == "" {
+			return
+		}
+		span := trace.SpanFromContext(r.Context())
+		span.SetName(r.Pattern)
+		span.SetAttributes(attribute.String("http.route", r.Pattern))
+	})
+}
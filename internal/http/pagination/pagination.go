@@ -0,0 +1,64 @@
+// File: internal/http/pagination/pagination.go
+package pagination
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+)
+
+// Meta - siyahı cavablarında səhifələmə məlumatı; növbəti səhifə üçün next_cursor göndərilir
+type Meta struct {
+	Limit      int    `json:"limit" example:"20"`
+	Offset     int    `json:"offset,omitempty" example:"0"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoiY3JlYXRlZF9hdCIs..."`
+	HasMore    bool   `json:"has_more" example:"true"`
+}
+
+// ParseQuery - limit, offset, cursor, status, search, sort və order query parametrlərini oxuyur.
+// Default-lar və icazə verilən status-lar domain servisində tətbiq olunur.
+func ParseQuery(r *http.Request) (listing.Query, error) {
+	values := r.URL.Query()
+	query := listing.Query{
+		Status: values.Get("status"),
+		Search: values.Get("search"),
+		Sort:   listing.SortField(strings.ToLower(values.Get("sort"))),
+		Order:  listing.Order(strings.ToLower(values.Get("order"))),
+	}
+
+	if raw := values.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			return query, apperr.InvalidField("limit", "INVALID_LIMIT", "limit must be between 1 and 100")
+		}
+		query.Limit = limit
+	}
+	if raw := values.Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return query, apperr.InvalidField("offset", "INVALID_OFFSET", "offset cannot be negative")
+		}
+		query.Offset = offset
+	}
+	if raw := values.Get("cursor"); raw != "" {
+		cursor, err := listing.DecodeCursor(raw)
+		if err != nil {
+			return query, err
+		}
+		query.Cursor = cursor
+	}
+
+	return query, nil
+}
+
+func MetaOf[T any](page *listing.Page[T]) *Meta {
+	return &Meta{
+		Limit:      page.Limit,
+		Offset:     page.Offset,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}
+}
@@ -0,0 +1,144 @@
+// File: internal/http/problem/problem.go
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+)
+
+// ContentType - RFC 7807 cavablarının media tipi
+const ContentType = "application/problem+json"
+
+// FieldError - validation xətasında konkret sahə
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Problem - RFC 7807 cavabı, code/request_id/errors extension üzvləridir
+type Problem struct {
+	Type      string       `json:"type" example:"/problems/validation"`
+	Title     string       `json:"title" example:"Validation failed"`
+	Status    int          `json:"status" example:"400"`
+	Detail    string       `json:"detail,omitempty" example:"Email formatı yanlışdır"`
+	Instance  string       `json:"instance,omitempty" example:"/api/v1/auth/register"`
+	Code      string       `json:"code" example:"INVALID_EMAIL_FORMAT"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+	// Current - 412 cavabında resursun cari halı, klient dəyişikliyi onun üzərində təkrarlaya bilər
+	Current interface{} `json:"current,omitempty" swaggertype:"object"`
+}
+
+// Handler-lərin ortaq xətaları
+var (
+	ErrInvalidBody     = apperr.Validation("INVALID_REQUEST_BODY", "Invalid request body")
+	ErrUnauthenticated = apperr.Unauthorized("UNAUTHORIZED", "Authentication required")
+)
+
+// internalLogger - daxili xətaların səbəbi klientə getmir, yalnız log-a yazılır
+var internalLogger logger.Logger
+
+// SetLogger - app qurulanda bir dəfə çağırılır
+func SetLogger(appLogger logger.Logger) {
+	internalLogger = appLogger
+}
+
+var statuses = map[apperr.Kind]int{
+	apperr.KindValidation:   http.StatusBadRequest,
+	apperr.KindUnauthorized: http.StatusUnauthorized,
+	apperr.KindForbidden:    http.StatusForbidden,
+	apperr.KindNotFound:     http.StatusNotFound,
+	apperr.KindConflict:     http.StatusConflict,
+	apperr.KindInternal:     http.StatusInternalServerError,
+
+	apperr.KindPreconditionFailed:   http.StatusPreconditionFailed,
+	apperr.KindPreconditionRequired: http.StatusPreconditionRequired,
+	apperr.KindTooManyRequests:      http.StatusTooManyRequests,
+}
+
+// New - xətanı Problem-ə çevirir. Daxili xətaların mətni klientə göstərilmir.
+func New(r *http.Request, err error) Problem {
+	appErr, ok := apperr.As(err)
+	if !ok || appErr.Kind == apperr.KindInternal {
+		logInternal(r, err)
+		code := "INTERNAL_ERROR"
+		if ok {
+			code = appErr.Code
+		}
+		appErr = apperr.Internal(code, "")
+	}
+	kind := appErr.Kind
+	status, known := statuses[kind]
+	if !known {
+		kind, status = apperr.KindInternal, http.StatusInternalServerError
+	}
+
+	locale := i18n.FromContext(r.Context())
+	p := Problem{
+		Type:     "/problems/" + string(kind),
+		Title:    i18n.T(locale, "problem."+string(kind)),
+		Status:   status,
+		Detail:   message(locale, appErr.Code, appErr.Message),
+		Instance: r.URL.Path,
+		Code:     appErr.Code,
+	}
+	if requestID, ok := logger.FieldFromContext(r.Context(), "request_id"); ok {
+		p.RequestID, _ = requestID.(string)
+	}
+	if appErr.Field != "" {
+		p.Errors = append(p.Errors, fieldError(locale, appErr))
+	}
+	for _, detail := range appErr.Details {
+		p.Errors = append(p.Errors, fieldError(locale, detail))
+	}
+	return p
+}
+
+// Write - xətanı application/problem+json kimi yazır
+func Write(w http.ResponseWriter, r *http.Request, err error) {
+	write(w, r, New(r, err))
+}
+
+// WriteCurrent - xətanı resursun cari təmsili ilə birlikdə yazır (412 Precondition Failed)
+func WriteCurrent(w http.ResponseWriter, r *http.Request, err error, current interface{}) {
+	p := New(r, err)
+	p.Current = current
+	write(w, r, p)
+}
+
+func write(w http.ResponseWriter, r *http.Request, p Problem) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Language", string(i18n.FromContext(r.Context())))
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+func logInternal(r *http.Request, err error) {
+	if internalLogger == nil || err == nil {
+		return
+	}
+	internalLogger.WithContext(r.Context()).Error("Request failed",
+		logger.Field{Key: "path", Value: r.URL.Path},
+		logger.Field{Key: "error", Value: err.Error()},
+	)
+}
+
+func fieldError(locale i18n.Locale, e *apperr.Error) FieldError {
+	return FieldError{Field: e.Field, Code: e.Code, Message: message(locale, e.Code, e.Message)}
+}
+
+// message - kataloqda kod varsa sorğunun dilində mətn, yoxdursa domain mesajı
+func message(locale i18n.Locale, code, fallback string) string {
+	if msg, ok := i18n.Message(locale, code); ok {
+		return msg
+	}
+	if fallback == "" {
+		return i18n.T(locale, "INTERNAL_ERROR")
+	}
+	return fallback
+}
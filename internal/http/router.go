@@ -4,34 +4,82 @@ import (
 	"net/http"
 
 	authDomain "github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/idempotency"
+	auditHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/audit"
 	authHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/auth"
 	businessHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/business"
+	healthHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/health"
 	locationHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/location"
+	notificationHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/notification"
 	serviceHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/service"
 	staffHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/staff"
+	webhookHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/webhook"
 	"github.com/OrkhanNajaf1i/booking-service/internal/http/middleware"
 	"github.com/OrkhanNajaf1i/booking-service/internal/http/routes"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
 type Handlers struct {
-	Business *businessHandler.BusinessHandler
-	Auth     *authHandler.Handler
-	Location locationHandler.Handler
-	Staff    staffHandler.Handler
-	Service  serviceHandler.Handler
+	Business     *businessHandler.BusinessHandler
+	Auth         *authHandler.Handler
+	Location     locationHandler.Handler
+	Staff        staffHandler.Handler
+	Service      serviceHandler.Handler
+	Health       healthHandler.Handler
+	Audit        auditHandler.Handler
+	Webhook      webhookHandler.Handler
+	Notification notificationHandler.Handler
 }
 
-func NewRouter(h Handlers, tokenManager authDomain.TokenManager) *http.ServeMux {
+func NewRouter(
+	h Handlers,
+	tokenManager authDomain.TokenManager,
+	ownerChecker middleware.OwnerChecker,
+	idempotencyRepo idempotency.Repository,
+	rateLimiter *middleware.RateLimiter,
+	appLogger logger.Logger,
+) *http.ServeMux {
 	mux := http.NewServeMux()
-	routes.RegisterAuthRoutes(mux, h.Auth)
-	authMiddleware := middleware.AuthMiddleware(tokenManager)
+	authenticate := middleware.AuthMiddleware(tokenManager)
+	userLimit := rateLimiter.Limit(routes.UserRateLimit)
+	businessLimit := rateLimiter.Limit(routes.BusinessRateLimit)
+	idempotent := middleware.IdempotencyMiddleware(idempotencyRepo, appLogger)
+	// Qorunan route-lar: autentifikasiya → istifadəçi və biznes kvotası → Idempotency-Key (POST).
+	// Kvota və açarlar autentifikasiyadan sonra istifadəçi (biznes) üzrə yoxlanır.
+	authMiddleware := func(next http.Handler) http.Handler {
+		return authenticate(userLimit(businessLimit(idempotent(next))))
+	}
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(tokenManager)
+	routes.RegisterHealthRoutes(mux, h.Health)
+	routes.RegisterAuthRoutes(mux, h.Auth, authMiddleware, rateLimiter.Limit)
 	routes.RegisterBusinessRoutes(mux, h.Business, authMiddleware)
 	routes.RegisterLocationRoutes(mux, h.Location, authMiddleware)
-	routes.RegisterStaffRoutes(mux, h.Staff, authMiddleware)
+	routes.RegisterStaffRoutes(mux, h.Staff, authMiddleware, optionalAuthMiddleware, rateLimiter.Limit)
 	routes.RegisterServiceRoutes(mux, h.Service, authMiddleware)
+	routes.RegisterAuditRoutes(mux, h.Audit, authMiddleware, middleware.OwnerMiddleware(ownerChecker, auditHandler.ErrOwnerOnly))
+	routes.RegisterWebhookRoutes(mux, h.Webhook, authMiddleware, middleware.OwnerMiddleware(ownerChecker, webhookHandler.ErrOwnerOnly))
+	routes.RegisterNotificationRoutes(mux, h.Notification, authMiddleware)
 	mux.Handle("GET /swagger/", httpSwagger.Handler(
 		httpSwagger.URL("/swagger/doc.json"),
 	))
 	return mux
 }
+
+// WithMiddleware - bütün sorğulara tətbiq olunan zəncir:
+// tracing → request ID → client IP → locale → access log → panic recover → metrics → route span adı
+func WithMiddleware(
+	handler http.Handler,
+	appLogger logger.Logger,
+	observer middleware.RequestObserver,
+	trustProxyHeaders bool,
+) http.Handler {
+	handler = middleware.TraceRouteMiddleware(handler)
+	handler = middleware.MetricsMiddleware(observer)(handler)
+	handler = middleware.RecoverMiddleware(appLogger)(handler)
+	handler = middleware.AccessLogMiddleware(appLogger)(handler)
+	handler = middleware.LocaleMiddleware(handler)
+	handler = middleware.ClientIPMiddleware(trustProxyHeaders)(handler)
+	handler = middleware.RequestIDMiddleware(handler)
+	return middleware.TracingMiddleware(handler)
+}
@@ -0,0 +1,17 @@
+// File: internal/http/routes/audit_routes.go
+package routes
+
+import (
+	"net/http"
+
+	auditHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/audit"
+)
+
+func RegisterAuditRoutes(
+	mux *http.ServeMux,
+	handler auditHandler.Handler,
+	authMiddleware func(http.Handler) http.Handler,
+	ownerMiddleware func(http.Handler) http.Handler,
+) {
+	mux.Handle("GET /api/v1/audit", authMiddleware(ownerMiddleware(http.HandlerFunc(handler.ListEvents))))
+}
@@ -3,14 +3,31 @@ package routes
 import (
 	"net/http"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/ratelimit"
 	"github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/auth"
 )
 
-func RegisterAuthRoutes(mux *http.ServeMux, h *auth.Handler) {
-	mux.HandleFunc("POST /api/v1/auth/register", h.Register)
-	mux.HandleFunc("POST /api/v1/auth/login", h.Login)
-	mux.HandleFunc("POST /api/v1/auth/refresh", h.RefreshAccessToken)
-	mux.HandleFunc("POST /api/v1/auth/forgot-password", h.ForgotPassword)
-	mux.HandleFunc("POST /api/v1/auth/reset-password", h.ResetPassword)
+func RegisterAuthRoutes(
+	mux *http.ServeMux,
+	h *auth.Handler,
+	authMiddleware func(http.Handler) http.Handler,
+	rateLimit RateLimit,
+) {
+	protected := func(handlerFunc http.HandlerFunc) http.Handler {
+		return authMiddleware(http.HandlerFunc(handlerFunc))
+	}
+	limited := func(policy ratelimit.Policy, handlerFunc http.HandlerFunc) http.Handler {
+		return rateLimit(policy)(handlerFunc)
+	}
+	mux.Handle("POST /api/v1/auth/register", limited(registerLimit, h.Register))
+	mux.Handle("POST /api/v1/auth/login", limited(loginLimit, h.Login))
+	mux.Handle("POST /api/v1/auth/refresh", limited(refreshLimit, h.RefreshAccessToken))
+	mux.Handle("POST /api/v1/auth/forgot-password", limited(forgotPasswordLimit, h.ForgotPassword))
+	mux.Handle("POST /api/v1/auth/reset-password", limited(resetPasswordLimit, h.ResetPassword))
 	mux.HandleFunc("POST /api/v1/auth/logout", h.Logout)
+
+	mux.Handle("POST /api/v1/auth/change-password", protected(h.ChangePassword))
+	mux.Handle("GET /api/v1/account/export", protected(h.ExportAccount))
+	mux.Handle("DELETE /api/v1/account", protected(h.DeleteAccount))
+	mux.Handle("PUT /api/v1/account/locale", protected(h.UpdateLocale))
 }
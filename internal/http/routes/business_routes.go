@@ -20,4 +20,11 @@ func RegisterBusinessRoutes(
 	mux.Handle("GET /api/v1/business", protected(handler.GetBusiness))
 	mux.Handle("GET /api/v1/businesses/{id}", protected(handler.GetBusinessByID))
 	mux.Handle("PUT /api/v1/business", protected(handler.UpdateBusiness))
+
+	mux.Handle("GET /api/v1/business/owners", protected(handler.ListOwners))
+	mux.Handle("POST /api/v1/business/co-owners", protected(handler.AddCoOwner))
+	mux.Handle("DELETE /api/v1/business/co-owners/{user_id}", protected(handler.RemoveCoOwner))
+	mux.Handle("POST /api/v1/business/ownership-transfers", protected(handler.InitiateOwnershipTransfer))
+	mux.Handle("POST /api/v1/business/ownership-transfers/confirm", protected(handler.ConfirmOwnershipTransfer))
+	mux.Handle("DELETE /api/v1/business/ownership-transfers/{id}", protected(handler.CancelOwnershipTransfer))
 }
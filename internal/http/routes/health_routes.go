@@ -0,0 +1,13 @@
+// File: internal/http/routes/health_routes.go
+package routes
+
+import (
+	"net/http"
+
+	healthHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/health"
+)
+
+func RegisterHealthRoutes(mux *http.ServeMux, handler healthHandler.Handler) {
+	mux.HandleFunc("GET /healthz", handler.Liveness)
+	mux.HandleFunc("GET /readyz", handler.Readiness)
+}
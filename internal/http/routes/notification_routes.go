@@ -0,0 +1,21 @@
+// File: internal/http/routes/notification_routes.go
+package routes
+
+import (
+	"net/http"
+
+	notificationHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/notification"
+)
+
+func RegisterNotificationRoutes(
+	mux *http.ServeMux,
+	handler notificationHandler.Handler,
+	authMiddleware func(http.Handler) http.Handler,
+) {
+	protected := func(handlerFunc http.HandlerFunc) http.Handler {
+		return authMiddleware(http.HandlerFunc(handlerFunc))
+	}
+	mux.Handle("GET /api/v1/account/notification-preferences", protected(handler.GetPreferences))
+	mux.Handle("PUT /api/v1/account/notification-preferences", protected(handler.UpdatePreferences))
+	mux.Handle("GET /api/v1/account/notifications", protected(handler.ListNotifications))
+}
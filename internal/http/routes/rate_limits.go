@@ -0,0 +1,29 @@
+// File: internal/http/routes/rate_limits.go
+package routes
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/ratelimit"
+)
+
+// RateLimit - policy üçün middleware qaytarır (middleware.RateLimiter.Limit)
+type RateLimit func(policy ratelimit.Policy) func(http.Handler) http.Handler
+
+// Autentifikasiyasız route-ların IP üzrə kvotaları; APP_RATE_LIMITS ilə ad üzrə dəyişdirilə bilər
+var (
+	registerLimit       = ratelimit.Policy{Name: "auth_register", Scope: ratelimit.ScopeIP, Limit: ratelimit.Limit{Requests: 10, Per: time.Hour}}
+	loginLimit          = ratelimit.Policy{Name: "auth_login", Scope: ratelimit.ScopeIP, Limit: ratelimit.Limit{Requests: 10, Per: time.Minute}}
+	refreshLimit        = ratelimit.Policy{Name: "auth_refresh", Scope: ratelimit.ScopeIP, Limit: ratelimit.Limit{Requests: 30, Per: time.Minute}}
+	forgotPasswordLimit = ratelimit.Policy{Name: "auth_forgot_password", Scope: ratelimit.ScopeIP, Limit: ratelimit.Limit{Requests: 5, Per: time.Hour}}
+	resetPasswordLimit  = ratelimit.Policy{Name: "auth_reset_password", Scope: ratelimit.ScopeIP, Limit: ratelimit.Limit{Requests: 10, Per: time.Hour}}
+	inviteValidateLimit = ratelimit.Policy{Name: "invite_validate", Scope: ratelimit.ScopeIP, Limit: ratelimit.Limit{Requests: 20, Per: time.Minute}}
+	inviteAcceptLimit   = ratelimit.Policy{Name: "invite_accept", Scope: ratelimit.ScopeIP, Limit: ratelimit.Limit{Requests: 10, Per: time.Minute}}
+)
+
+// Autentifikasiyalı bütün route-lar üçün istifadəçi və biznes (tenant) kvotaları
+var (
+	UserRateLimit     = ratelimit.Policy{Name: "user", Scope: ratelimit.ScopeUser, Limit: ratelimit.Limit{Requests: 300, Per: time.Minute}}
+	BusinessRateLimit = ratelimit.Policy{Name: "business", Scope: ratelimit.ScopeBusiness, Limit: ratelimit.Limit{Requests: 1200, Per: time.Minute}}
+)
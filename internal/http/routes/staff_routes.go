@@ -11,6 +11,8 @@ func RegisterStaffRoutes(
 	mux *http.ServeMux,
 	h staffHandler.Handler,
 	authMiddleware func(http.Handler) http.Handler,
+	optionalAuthMiddleware func(http.Handler) http.Handler,
+	rateLimit RateLimit,
 ) {
 	protected := func(handlerFunc http.HandlerFunc) http.Handler {
 		return authMiddleware(http.HandlerFunc(handlerFunc))
@@ -22,6 +24,9 @@ func RegisterStaffRoutes(
 	mux.Handle("PUT /api/v1/staff/{id}", protected(h.UpdateStaff))
 	mux.Handle("DELETE /api/v1/staff/{id}", protected(h.DeactivateStaff))
 	mux.Handle("POST /api/v1/staff/invites", protected(h.InviteStaff))
-	mux.Handle("POST /api/v1/staff/invites/accept", protected(h.AcceptInvite))
-	mux.Handle("POST /api/v1/staff/invites/validate", http.HandlerFunc(h.ValidateInviteToken))
+	mux.Handle("GET /api/v1/staff/invites", protected(h.ListInvites))
+	mux.Handle("POST /api/v1/staff/invites/{id}/resend", protected(h.ResendInvite))
+	mux.Handle("DELETE /api/v1/staff/invites/{id}", protected(h.RevokeInvite))
+	mux.Handle("POST /api/v1/staff/invites/accept", rateLimit(inviteAcceptLimit)(optionalAuthMiddleware(http.HandlerFunc(h.AcceptInvite))))
+	mux.Handle("POST /api/v1/staff/invites/validate", rateLimit(inviteValidateLimit)(http.HandlerFunc(h.ValidateInviteToken)))
 }
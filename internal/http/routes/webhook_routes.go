@@ -0,0 +1,26 @@
+// File: internal/http/routes/webhook_routes.go
+package routes
+
+import (
+	"net/http"
+
+	webhookHandler "github.com/OrkhanNajaf1i/booking-service/internal/http/handlers/webhook"
+)
+
+func RegisterWebhookRoutes(
+	mux *http.ServeMux,
+	handler webhookHandler.Handler,
+	authMiddleware func(http.Handler) http.Handler,
+	ownerMiddleware func(http.Handler) http.Handler,
+) {
+	protected := func(handlerFunc http.HandlerFunc) http.Handler {
+		return authMiddleware(ownerMiddleware(http.HandlerFunc(handlerFunc)))
+	}
+	mux.Handle("POST /api/v1/webhooks", protected(handler.CreateEndpoint))
+	mux.Handle("GET /api/v1/webhooks", protected(handler.ListEndpoints))
+	mux.Handle("GET /api/v1/webhooks/{id}", protected(handler.GetEndpoint))
+	mux.Handle("PUT /api/v1/webhooks/{id}", protected(handler.UpdateEndpoint))
+	mux.Handle("DELETE /api/v1/webhooks/{id}", protected(handler.DeleteEndpoint))
+	mux.Handle("GET /api/v1/webhooks/{id}/deliveries", protected(handler.ListDeliveries))
+	mux.Handle("POST /api/v1/webhooks/deliveries/{id}/redeliver", protected(handler.Redeliver))
+}
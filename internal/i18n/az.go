@@ -0,0 +1,210 @@
+// File: internal/i18n/az.go
+package i18n
+
+var az = map[string]string{
+	// Problem başlıqları
+	"problem.validation":            "Validasiya xətası",
+	"problem.unauthorized":          "Avtorizasiya tələb olunur",
+	"problem.forbidden":             "İcazə yoxdur",
+	"problem.not_found":             "Tapılmadı",
+	"problem.conflict":              "Konflikt",
+	"problem.internal":              "Daxili server xətası",
+	"problem.precondition_failed":   "Versiya uyğun gəlmir",
+	"problem.precondition_required": "If-Match tələb olunur",
+	"problem.too_many_requests":     "Çox sayda sorğu",
+
+	// Register / login
+	"EMAIL_EXISTS":         "Bu email artıq mövcuddur",
+	"EMAIL_REQUIRED":       "Email tələb olunur",
+	"EMAIL_TOO_LONG":       "Email çox uzundur",
+	"EMAIL_INVALID":        "Email formatı yanlışdır",
+	"INVALID_EMAIL_FORMAT": "Email formatı yanlışdır",
+
+	"PASSWORD_REQUIRED":  "Parol tələb olunur",
+	"PASSWORD_TOO_SHORT": "Parol minimum 8 simvol olmalıdır",
+	"PASSWORD_TOO_LONG":  "Parol çox uzundur",
+	"PASSWORD_WEAK":      "Parol kifayət qədər güclü deyil",
+
+	"FULLNAME_REQUIRED":  "Tam ad tələb olunur",
+	"FULLNAME_TOO_SHORT": "Tam ad çox qısadır",
+	"FULLNAME_TOO_LONG":  "Tam ad çox uzundur",
+
+	"PHONE_REQUIRED": "Telefon nömrəsi tələb olunur",
+	"PHONE_INVALID":  "Telefon formatı yanlışdır (nümunə: +994501234567)",
+
+	"INVALID_CREDENTIALS": "Email və ya parol yanlışdır",
+	"USER_INACTIVE":       "Akkaunt deaktivdir",
+
+	"INVALID_REFRESH_TOKEN":  "Refresh token yanlışdır",
+	"REFRESH_TOKEN_EXPIRED":  "Refresh token vaxtı çıxıb",
+	"REFRESH_TOKEN_REVOKED":  "Refresh token ləğv edilib",
+	"REFRESH_TOKEN_REQUIRED": "Refresh token tələb olunur",
+	"USER_NOT_FOUND":         "İstifadəçi tapılmadı",
+
+	"INVALID_TOKEN":           "Token yanlış və ya mövcud deyil",
+	"TOKEN_EXPIRED":           "Token vaxtı çıxıb",
+	"TOKEN_ALREADY_USED":      "Token artıq istifadə edilib",
+	"TOKEN_USED":              "Token artıq istifadə edilib",
+	"TOKEN_REVOKED":           "Token ləğv edilib",
+	"RESET_TOKEN_SAVE_FAILED": "Reset token yadda saxlanmadı",
+	"PASSWORD_HASH_FAILED":    "Parol işlənərkən xəta baş verdi",
+	"PASSWORD_HASHING_FAILED": "Parol işlənərkən xəta baş verdi",
+	"PASSWORD_UPDATE_FAILED":  "Parolu yeniləmək alınmadı",
+
+	"CURRENT_PASSWORD_REQUIRED":   "Cari parol tələb olunur",
+	"INVALID_CURRENT_PASSWORD":    "Cari parol yanlışdır",
+	"PASSWORD_UNCHANGED":          "Yeni parol cari paroldan fərqli olmalıdır",
+	"OWNERSHIP_TRANSFER_REQUIRED": "Hesabı silməzdən əvvəl biznesi təhvil verin və ya co-owner əlavə edin",
+	"UNSUPPORTED_FORMAT":          "Format json və ya zip olmalıdır",
+	"INVALID_LOCALE":              "Dil az, en və ya ru olmalıdır",
+
+	// Middleware
+	"UNAUTHORIZED":         "Avtorizasiya tələb olunur",
+	"NO_TOKEN":             "Authorization header tələb olunur",
+	"INVALID_TOKEN_FORMAT": "Token formatı yanlışdır",
+	"NO_ROLE":              "Rol məlumatı tapılmadı",
+	"FORBIDDEN":            "İcazəsiz giriş",
+
+	// Business
+	"BUSINESS_NAME_REQUIRED":      "Biznes adı tələb olunur",
+	"BUSINESS_NAME_TOO_SHORT":     "Biznes adı ən azı 2 simvol olmalıdır",
+	"BUSINESS_NAME_TOO_LONG":      "Biznes adı çox uzundur",
+	"SERVICE_CATEGORY_REQUIRED":   "Xidmət kateqoriyası tələb olunur",
+	"SERVICE_CATEGORY_TOO_SHORT":  "Xidmət kateqoriyası ən azı 3 simvol olmalıdır",
+	"SERVICE_CATEGORY_TOO_LONG":   "Xidmət kateqoriyası 50 simvoldan çox ola bilməz",
+	"INDUSTRY_REQUIRED":           "Sahə tələb olunur",
+	"INDUSTRY_TOO_SHORT":          "Sahə ən azı 3 simvol olmalıdır",
+	"INDUSTRY_TOO_LONG":           "Sahə 50 simvoldan çox ola bilməz",
+	"INVALID_BUSINESS_TYPE":       "Biznes tipi yanlışdır",
+	"BUSINESS_NOT_FOUND":          "Biznes tapılmadı",
+	"INVALID_BUSINESS":            "Biznes ID-si boş ola bilməz",
+	"INVALID_BUSINESS_ID":         "Biznes ID-si yanlışdır",
+	"INVALID_OWNER_ID":            "Sahib ID-si boş ola bilməz",
+	"INVALID_USER":                "İstifadəçi ID-si boş ola bilməz",
+	"INVALID_USER_ID":             "İstifadəçi ID-si yanlışdır",
+	"INVALID_TRANSFER_ID":         "Təhvil ID-si yanlışdır",
+	"TRANSFER_TO_SELF":            "Sahibliyi özünüzə təhvil verə bilməzsiniz",
+	"RECIPIENT_NOT_ACTIVE_STAFF":  "İstifadəçi bu biznesin aktiv işçisi olmalıdır",
+	"CANNOT_REMOVE_PRIMARY_OWNER": "Əsas sahib silinə bilməz, əvvəlcə sahibliyi təhvil verin",
+	"NOT_BUSINESS_OWNER":          "Bu əməliyyatı yalnız biznes sahibi edə bilər",
+	"TRANSFER_RECIPIENT_MISMATCH": "Bu təhvil başqa istifadəçiyə göndərilib",
+	"OWNER_NOT_FOUND":             "Co-owner tapılmadı",
+	"TRANSFER_NOT_FOUND":          "Gözləyən təhvil tapılmadı",
+	"ALREADY_OWNER":               "İstifadəçi artıq bu biznesin sahibidir",
+	"TRANSFER_ALREADY_PENDING":    "Başqa təhvil artıq gözləyir",
+	"TRANSFER_NOT_PENDING":        "Təhvil artıq gözləmədə deyil",
+	"TRANSFER_STALE":              "Təhvil başladıqdan sonra biznes sahibi dəyişib",
+	"ALREADY_ONBOARDED":           "İstifadəçinin artıq biznesi var",
+	"ALREADY_IN_BUSINESS":         "İstifadəçi artıq başqa biznesə aiddir",
+
+	// Location
+	"LOCATION_NAME_REQUIRED":  "Filial adı tələb olunur",
+	"LOCATION_NAME_TOO_SHORT": "Filial adı ən azı 2 simvol olmalıdır",
+	"LOCATION_NAME_TOO_LONG":  "Filial adı 100 simvoldan çox ola bilməz",
+	"NAME_REQUIRED":           "Ad tələb olunur",
+	"NAME_TOO_SHORT":          "Ad ən azı 2 simvol olmalıdır",
+	"NAME_TOO_LONG":           "Ad 100 simvoldan çox ola bilməz",
+
+	// Staff
+	"ALREADY_STAFF":           "İstifadəçi artıq bu biznesin üzvüdür",
+	"CONTACT_REQUIRED":        "Email və ya telefon tələb olunur",
+	"INVALID_ROLE":            "İşçi rolu yanlışdır",
+	"INVALID_STAFF":           "İşçi ID-si boş ola bilməz",
+	"INVITE_ALREADY_ACCEPTED": "Dəvət artıq qəbul edilib",
+	"INVITE_EMAIL_MISMATCH":   "Bu dəvət başqa hesaba göndərilib",
+	"INVITE_NOT_FOUND":        "Dəvət tapılmadı",
+	"INVITE_REVOKED":          "Dəvət ləğv edilib",
+	"LOGIN_REQUIRED":          "SMS ilə göndərilən dəvəti qəbul etmək üçün daxil olun",
+	"TITLE_REQUIRED":          "Vəzifə tələb olunur",
+	"TITLE_TOO_SHORT":         "Vəzifə ən azı 2 simvol olmalıdır",
+	"TITLE_TOO_LONG":          "Vəzifə 50 simvoldan çox ola bilməz",
+
+	// Service
+	"DURATION_INVALID":      "Müddət sıfırdan böyük olmalıdır",
+	"DURATION_TOO_LONG":     "Müddət 1440 dəqiqədən çox ola bilməz",
+	"PRICE_INVALID":         "Qiymət mənfi ola bilməz",
+	"SERVICE_NAME_EXISTS":   "Bu adda xidmət artıq mövcuddur",
+	"SERVICE_LIST_EMPTY":    "Ən azı bir xidmət ID-si tələb olunur",
+	"SERVICE_LIST_TOO_LONG": "Bir sorğuda çox sayda xidmət var",
+	"INVALID_SERVICE":       "Xidmət ID-si boş ola bilməz",
+	"INVALID_SERVICE_ID":    "Xidmət ID-si yanlışdır",
+
+	// Ümumi
+	"INVALID_DATA":         "Məlumat boş ola bilməz",
+	"INVALID_ID":           "ID yanlışdır",
+	"INVALID_REQUEST":      "Sorğu yanlışdır",
+	"INVALID_REQUEST_BODY": "Sorğunun body-si yanlışdır",
+	"NOT_FOUND":            "Tapılmadı",
+	"VALIDATION_ERROR":     "Giriş məlumatları yanlışdır",
+	"INTERNAL_ERROR":       "Daxili server xətası",
+
+	// Email şablonları
+	"INVALID_LIMIT":      "limit 1 ilə 100 arasında olmalıdır",
+	"INVALID_OFFSET":     "offset mənfi ola bilməz",
+	"INVALID_SORT":       "Sıralama created_at və ya name olmalıdır",
+	"INVALID_ORDER":      "Sıra istiqaməti asc və ya desc olmalıdır",
+	"INVALID_STATUS":     "Bu siyahı üçün status filtri dəstəklənmir",
+	"INVALID_CURSOR":     "Cursor etibarsızdır və ya sıralama ilə uyğun gəlmir",
+	"CURSOR_WITH_OFFSET": "cursor və offset birlikdə istifadə edilə bilməz",
+
+	"VERSION_MISMATCH":  "Resurs başqa sorğu tərəfindən dəyişdirilib, cari versiyanı yükləyib yenidən cəhd edin",
+	"IF_MATCH_REQUIRED": "Dəyişiklik üçün If-Match header-i (ETag) tələb olunur",
+	"INVALID_IF_MATCH":  "If-Match header-i yanlış formatdadır",
+
+	"INVALID_IDEMPOTENCY_KEY": "Idempotency-Key 1-255 görünən ASCII simvoldan ibarət olmalıdır",
+	"IDEMPOTENCY_KEY_REUSED":  "Bu Idempotency-Key artıq başqa sorğu ilə istifadə olunub",
+	"IDEMPOTENCY_IN_PROGRESS": "Bu Idempotency-Key ilə sorğu hələ icra olunur, bir az sonra yenidən cəhd edin",
+	"REQUEST_TOO_LARGE":       "Sorğunun məzmunu idempotent sorğu üçün çox böyükdür",
+	"RATE_LIMITED":            "Sorğu limiti aşılıb, Retry-After müddətindən sonra yenidən cəhd edin",
+
+	"AUDIT_OWNER_ONLY":     "Audit jurnalına yalnız biznes sahibləri baxa bilər",
+	"INVALID_ENTITY_TYPE":  "Obyekt növü dəstəklənmir",
+	"INVALID_AUDIT_ACTION": "Əməliyyat növü dəstəklənmir",
+	"INVALID_AUDIT_SORT":   "Audit jurnalı yalnız created_at üzrə sıralanır",
+	"INVALID_TIME":         "Vaxt RFC 3339 formatında olmalıdır",
+	"INVALID_TIME_RANGE":   "from vaxtı to vaxtından əvvəl olmalıdır",
+
+	"WEBHOOK_OWNER_ONLY":         "Webhook-ları yalnız biznes sahibləri idarə edə bilər",
+	"WEBHOOK_NOT_FOUND":          "Webhook endpoint tapılmadı",
+	"WEBHOOK_DELIVERY_NOT_FOUND": "Webhook çatdırılması tapılmadı",
+	"WEBHOOK_LIMIT_REACHED":      "Webhook endpoint-lərinin maksimum sayına çatılıb",
+	"INVALID_WEBHOOK_URL":        "URL mütləq http(s) ünvanı olmalıdır",
+	"INVALID_EVENT_TYPES":        "Ən azı bir dəstəklənən hadisə növü seçilməlidir",
+	"INVALID_DESCRIPTION":        "Təsvir ən çox 255 simvol ola bilər",
+	"INVALID_DELIVERY_SORT":      "Çatdırılmalar yalnız created_at üzrə sıralanır",
+	"INVALID_TIMEZONE":           "Vaxt zonası IANA adı olmalıdır (məs. Asia/Baku)",
+	"INVALID_QUIET_HOURS":        "Sakit saatlar HH:MM formatında, başlanğıc və son birlikdə və fərqli olmalıdır",
+	"INVALID_NOTIFICATION_SORT":  "Bildirişlər yalnız created_at üzrə sıralanır",
+
+	"message.password_changed":             "Parol dəyişdirildi, digər sessiyalar bağlandı",
+	"message.account_deleted":              "Hesab silindi",
+	"message.token_refreshed":              "Token yeniləndi",
+	"message.password_reset_sent":          "Parol sıfırlama linki email-ə göndərildi",
+	"message.password_reset":               "Parol uğurla sıfırlandı",
+	"message.logged_out":                   "Çıxış edildi",
+	"message.business_updated":             "Biznes yeniləndi",
+	"message.co_owner_added":               "Həmsahib əlavə edildi",
+	"message.co_owner_removed":             "Həmsahib silindi",
+	"message.ownership_transfer_cancelled": "Sahiblik təhvili ləğv edildi",
+	"message.ownership_transferred":        "Sahiblik uğurla təhvil verildi",
+	"message.location_created":             "Filial yaradıldı",
+	"message.location_updated":             "Filial yeniləndi",
+	"message.location_deactivated":         "Filial deaktiv edildi",
+	"message.staff_created":                "İşçi profili yaradıldı",
+	"message.staff_updated":                "İşçi məlumatları yeniləndi",
+	"message.staff_deactivated":            "İşçi deaktiv edildi",
+	"message.invite_sent":                  "Dəvət göndərildi",
+	"message.invite_resent":                "Dəvət yenidən göndərildi",
+	"message.invite_revoked":               "Dəvət ləğv edildi",
+	"message.invite_accepted":              "Dəvət qəbul edildi",
+	"message.service_created":              "Xidmət yaradıldı",
+	"message.service_updated":              "Xidmət yeniləndi",
+	"message.service_deactivated":          "Xidmət deaktiv edildi",
+	"message.services_assigned":            "Xidmətlər işçiyə təyin edildi",
+	"message.service_unassigned":           "Xidmət işçidən götürüldü",
+	"message.webhook_created":              "Webhook yaradıldı. Secret yalnız indi göstərilir, onu saxlayın",
+	"message.webhook_updated":              "Webhook yeniləndi",
+	"message.webhook_deleted":              "Webhook silindi",
+	"message.webhook_redelivery_queued":    "Təkrar çatdırılma növbəyə qoyuldu",
+	"message.preferences_updated":          "Bildiriş seçimləri yadda saxlanıldı",
+}
@@ -0,0 +1,201 @@
+// File: internal/i18n/en.go
+package i18n
+
+var en = map[string]string{
+	"problem.validation":            "Validation failed",
+	"problem.unauthorized":          "Unauthorized",
+	"problem.forbidden":             "Forbidden",
+	"problem.not_found":             "Not found",
+	"problem.conflict":              "Conflict",
+	"problem.internal":              "Internal server error",
+	"problem.precondition_failed":   "Precondition failed",
+	"problem.precondition_required": "Precondition required",
+	"problem.too_many_requests":     "Too many requests",
+
+	"EMAIL_EXISTS":         "This email is already registered",
+	"EMAIL_REQUIRED":       "Email is required",
+	"EMAIL_TOO_LONG":       "Email is too long",
+	"EMAIL_INVALID":        "Invalid email format",
+	"INVALID_EMAIL_FORMAT": "Invalid email format",
+
+	"PASSWORD_REQUIRED":  "Password is required",
+	"PASSWORD_TOO_SHORT": "Password must be at least 8 characters long",
+	"PASSWORD_TOO_LONG":  "Password is too long",
+	"PASSWORD_WEAK":      "Password must contain upper- and lowercase letters, a number and a special character",
+
+	"FULLNAME_REQUIRED":  "Full name is required",
+	"FULLNAME_TOO_SHORT": "Full name is too short",
+	"FULLNAME_TOO_LONG":  "Full name is too long",
+
+	"PHONE_REQUIRED": "Phone number is required",
+	"PHONE_INVALID":  "Invalid phone format (example: +994501234567)",
+
+	"INVALID_CREDENTIALS": "Invalid email or password",
+	"USER_INACTIVE":       "Account is inactive",
+
+	"INVALID_REFRESH_TOKEN":  "Invalid refresh token",
+	"REFRESH_TOKEN_EXPIRED":  "Refresh token has expired",
+	"REFRESH_TOKEN_REVOKED":  "Refresh token has been revoked",
+	"REFRESH_TOKEN_REQUIRED": "Refresh token is required",
+	"USER_NOT_FOUND":         "User not found",
+
+	"INVALID_TOKEN":           "Token is invalid or does not exist",
+	"TOKEN_EXPIRED":           "Token has expired",
+	"TOKEN_ALREADY_USED":      "Token has already been used",
+	"TOKEN_USED":              "Token has already been used",
+	"TOKEN_REVOKED":           "Token has been revoked",
+	"RESET_TOKEN_SAVE_FAILED": "Failed to save reset token",
+	"PASSWORD_HASH_FAILED":    "Password processing failed",
+	"PASSWORD_HASHING_FAILED": "Password processing failed",
+	"PASSWORD_UPDATE_FAILED":  "Failed to update password",
+
+	"CURRENT_PASSWORD_REQUIRED":   "Current password is required",
+	"INVALID_CURRENT_PASSWORD":    "Current password is incorrect",
+	"PASSWORD_UNCHANGED":          "New password must differ from the current one",
+	"OWNERSHIP_TRANSFER_REQUIRED": "Transfer the business or add a co-owner before deleting the account",
+	"UNSUPPORTED_FORMAT":          "Format must be json or zip",
+	"INVALID_LOCALE":              "Language must be az, en or ru",
+
+	"UNAUTHORIZED":         "Authentication required",
+	"NO_TOKEN":             "Authorization header is required",
+	"INVALID_TOKEN_FORMAT": "Invalid token format",
+	"NO_ROLE":              "Role is missing from the token",
+	"FORBIDDEN":            "Access denied",
+
+	"BUSINESS_NAME_REQUIRED":      "Business name is required",
+	"BUSINESS_NAME_TOO_SHORT":     "Business name must be at least 2 characters",
+	"BUSINESS_NAME_TOO_LONG":      "Business name is too long",
+	"SERVICE_CATEGORY_REQUIRED":   "Service category is required",
+	"SERVICE_CATEGORY_TOO_SHORT":  "Service category must be at least 3 characters",
+	"SERVICE_CATEGORY_TOO_LONG":   "Service category cannot exceed 50 characters",
+	"INDUSTRY_REQUIRED":           "Industry is required",
+	"INDUSTRY_TOO_SHORT":          "Industry must be at least 3 characters",
+	"INDUSTRY_TOO_LONG":           "Industry cannot exceed 50 characters",
+	"INVALID_BUSINESS_TYPE":       "Invalid business type",
+	"BUSINESS_NOT_FOUND":          "Business not found",
+	"INVALID_BUSINESS":            "Business ID cannot be empty",
+	"INVALID_BUSINESS_ID":         "Invalid business ID",
+	"INVALID_OWNER_ID":            "Owner ID cannot be empty",
+	"INVALID_USER":                "User ID cannot be empty",
+	"INVALID_USER_ID":             "Invalid user ID",
+	"INVALID_TRANSFER_ID":         "Invalid transfer ID",
+	"TRANSFER_TO_SELF":            "Ownership cannot be transferred to yourself",
+	"RECIPIENT_NOT_ACTIVE_STAFF":  "User must be an active staff member of this business",
+	"CANNOT_REMOVE_PRIMARY_OWNER": "Primary owner cannot be removed, transfer ownership first",
+	"NOT_BUSINESS_OWNER":          "Only the business owner can perform this action",
+	"TRANSFER_RECIPIENT_MISMATCH": "This transfer was issued to another user",
+	"OWNER_NOT_FOUND":             "Co-owner not found",
+	"TRANSFER_NOT_FOUND":          "Pending ownership transfer not found",
+	"ALREADY_OWNER":               "User is already an owner of this business",
+	"TRANSFER_ALREADY_PENDING":    "Another ownership transfer is already pending",
+	"TRANSFER_NOT_PENDING":        "Ownership transfer is no longer pending",
+	"TRANSFER_STALE":              "Business owner has changed since the transfer was initiated",
+	"ALREADY_ONBOARDED":           "User already has a business",
+	"ALREADY_IN_BUSINESS":         "User already belongs to another business",
+
+	"LOCATION_NAME_REQUIRED":  "Location name is required",
+	"LOCATION_NAME_TOO_SHORT": "Location name must be at least 2 characters",
+	"LOCATION_NAME_TOO_LONG":  "Location name cannot exceed 100 characters",
+	"NAME_REQUIRED":           "Name is required",
+	"NAME_TOO_SHORT":          "Name must be at least 2 characters",
+	"NAME_TOO_LONG":           "Name cannot exceed 100 characters",
+
+	"ALREADY_STAFF":           "User is already a member of this business",
+	"CONTACT_REQUIRED":        "Either email or phone is required",
+	"INVALID_ROLE":            "Invalid staff role",
+	"INVALID_STAFF":           "Staff ID cannot be empty",
+	"INVITE_ALREADY_ACCEPTED": "Invite has already been accepted",
+	"INVITE_EMAIL_MISMATCH":   "This invite was sent to a different account",
+	"INVITE_NOT_FOUND":        "Invite not found",
+	"INVITE_REVOKED":          "Invite has been revoked",
+	"LOGIN_REQUIRED":          "Sign in to accept an invite sent by SMS",
+	"TITLE_REQUIRED":          "Staff title is required",
+	"TITLE_TOO_SHORT":         "Staff title must be at least 2 characters",
+	"TITLE_TOO_LONG":          "Staff title cannot exceed 50 characters",
+
+	"DURATION_INVALID":      "Duration must be greater than zero",
+	"DURATION_TOO_LONG":     "Duration cannot exceed 1440 minutes",
+	"PRICE_INVALID":         "Price cannot be negative",
+	"SERVICE_NAME_EXISTS":   "A service with this name already exists",
+	"SERVICE_LIST_EMPTY":    "At least one service ID is required",
+	"SERVICE_LIST_TOO_LONG": "Too many services in a single request",
+	"INVALID_SERVICE":       "Service ID cannot be empty",
+	"INVALID_SERVICE_ID":    "Invalid service ID",
+
+	"INVALID_DATA":         "Data cannot be empty",
+	"INVALID_ID":           "Invalid ID",
+	"INVALID_REQUEST":      "Invalid request",
+	"INVALID_REQUEST_BODY": "Invalid request body",
+	"NOT_FOUND":            "Not found",
+	"VALIDATION_ERROR":     "Request validation failed",
+	"INTERNAL_ERROR":       "Internal server error",
+
+	"INVALID_LIMIT":      "limit must be between 1 and 100",
+	"INVALID_OFFSET":     "offset cannot be negative",
+	"INVALID_SORT":       "Sort must be created_at or name",
+	"INVALID_ORDER":      "Order must be asc or desc",
+	"INVALID_STATUS":     "This status filter is not supported for this list",
+	"INVALID_CURSOR":     "The cursor is invalid or does not match the requested sort",
+	"CURSOR_WITH_OFFSET": "cursor and offset cannot be combined",
+
+	"VERSION_MISMATCH":  "The resource was modified by another request; reload the current version and try again",
+	"IF_MATCH_REQUIRED": "Updates require an If-Match header with the resource ETag",
+	"INVALID_IF_MATCH":  "The If-Match header is malformed",
+
+	"INVALID_IDEMPOTENCY_KEY": "Idempotency-Key must be 1-255 printable ASCII characters",
+	"IDEMPOTENCY_KEY_REUSED":  "This Idempotency-Key was already used with a different request",
+	"IDEMPOTENCY_IN_PROGRESS": "A request with this Idempotency-Key is still being processed; retry shortly",
+	"REQUEST_TOO_LARGE":       "The request body is too large for an idempotent request",
+	"RATE_LIMITED":            "Rate limit exceeded; retry after the Retry-After interval",
+
+	"AUDIT_OWNER_ONLY":     "Only business owners can view the audit log",
+	"INVALID_ENTITY_TYPE":  "Entity type is not supported",
+	"INVALID_AUDIT_ACTION": "Action is not supported",
+	"INVALID_AUDIT_SORT":   "The audit log can only be sorted by created_at",
+	"INVALID_TIME":         "Time must be an RFC 3339 timestamp",
+	"INVALID_TIME_RANGE":   "from must be before to",
+
+	"WEBHOOK_OWNER_ONLY":         "Only business owners can manage webhooks",
+	"WEBHOOK_NOT_FOUND":          "Webhook endpoint not found",
+	"WEBHOOK_DELIVERY_NOT_FOUND": "Webhook delivery not found",
+	"WEBHOOK_LIMIT_REACHED":      "Maximum number of webhook endpoints reached",
+	"INVALID_WEBHOOK_URL":        "URL must be an absolute http(s) URL",
+	"INVALID_EVENT_TYPES":        "At least one supported event type is required",
+	"INVALID_DESCRIPTION":        "Description must be at most 255 characters",
+	"INVALID_DELIVERY_SORT":      "Deliveries can only be sorted by created_at",
+	"INVALID_TIMEZONE":           "Timezone must be an IANA name such as Asia/Baku",
+	"INVALID_QUIET_HOURS":        "Quiet hours must use HH:MM, be set together and differ",
+	"INVALID_NOTIFICATION_SORT":  "Notifications can only be sorted by created_at",
+
+	"message.password_changed":             "Password changed, other sessions were signed out",
+	"message.account_deleted":              "Account deleted",
+	"message.token_refreshed":              "Token refreshed",
+	"message.password_reset_sent":          "A password reset link has been sent to your email",
+	"message.password_reset":               "Password reset successfully",
+	"message.logged_out":                   "Logged out",
+	"message.business_updated":             "Business updated successfully",
+	"message.co_owner_added":               "Co-owner added successfully",
+	"message.co_owner_removed":             "Co-owner removed successfully",
+	"message.ownership_transfer_cancelled": "Ownership transfer cancelled",
+	"message.ownership_transferred":        "Ownership transferred successfully",
+	"message.location_created":             "Location created successfully",
+	"message.location_updated":             "Location updated successfully",
+	"message.location_deactivated":         "Location deactivated successfully",
+	"message.staff_created":                "Staff profile created successfully",
+	"message.staff_updated":                "Staff updated successfully",
+	"message.staff_deactivated":            "Staff deactivated successfully",
+	"message.invite_sent":                  "Invite sent successfully",
+	"message.invite_resent":                "Invite resent successfully",
+	"message.invite_revoked":               "Invite revoked successfully",
+	"message.invite_accepted":              "Invite accepted successfully",
+	"message.service_created":              "Service created successfully",
+	"message.service_updated":              "Service updated successfully",
+	"message.service_deactivated":          "Service deactivated successfully",
+	"message.services_assigned":            "Services assigned to staff successfully",
+	"message.service_unassigned":           "Service removed from staff successfully",
+	"message.webhook_created":              "Webhook created. The secret is shown only once, store it now",
+	"message.webhook_updated":              "Webhook updated successfully",
+	"message.webhook_deleted":              "Webhook deleted successfully",
+	"message.webhook_redelivery_queued":    "Redelivery queued",
+	"message.preferences_updated":          "Notification preferences saved",
+}
@@ -0,0 +1,122 @@
+// File: internal/i18n/i18n.go
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale - dəstəklənən dil kodu (BCP 47 əsas hissəsi)
+type Locale string
+
+const (
+	AZ Locale = "az"
+	EN Locale = "en"
+	RU Locale = "ru"
+)
+
+// Default - nə header, nə də saxlanmış seçim olmadıqda istifadə olunur
+const Default = AZ
+
+var Supported = []Locale{AZ, EN, RU}
+
+// Parse - "en", "en-US", "ru_RU" kimi dəyərləri dəstəklənən locale-ə çevirir
+func Parse(value string) (Locale, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if i := strings.IndexAny(value, "-_"); i >= 0 {
+		value = value[:i]
+	}
+	for _, locale := range Supported {
+		if string(locale) == value {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// Negotiate - Accept-Language header-indən q-dəyərinə görə ən uyğun locale-i seçir
+func Negotiate(acceptLanguage string) Locale {
+	type candidate struct {
+		locale Locale
+		q      float64
+		order  int
+	}
+	var candidates []candidate
+	for i, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		locale, ok := Parse(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q, order: i})
+	}
+	if len(candidates) == 0 {
+		return Default
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale
+}
+
+type contextKey struct{}
+
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext - sorğunun locale-i, yoxdursa Default
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(contextKey{}).(Locale); ok {
+		return locale
+	}
+	return Default
+}
+
+// Preferred - istifadəçinin saxlanmış seçimi varsa o, yoxdursa sorğunun locale-i
+func Preferred(ctx context.Context, saved string) Locale {
+	if locale, ok := Parse(saved); ok {
+		return locale
+	}
+	return FromContext(ctx)
+}
+
+// Message - error kodu üçün mətn, yalnız verilmiş dildə axtarılır
+func Message(locale Locale, code string) (string, bool) {
+	msg, ok := catalog[locale][code]
+	return msg, ok
+}
+
+// T - şablon mətni; dildə yoxdursa Default, orada da yoxdursa açarın özü
+func T(locale Locale, key string, args ...any) string {
+	msg, ok := catalog[locale][key]
+	if !ok {
+		if msg, ok = catalog[Default][key]; !ok {
+			msg = key
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+var catalog = map[Locale]map[string]string{
+	AZ: az,
+	EN: en,
+	RU: ru,
+}
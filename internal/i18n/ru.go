@@ -0,0 +1,201 @@
+// File: internal/i18n/ru.go
+package i18n
+
+var ru = map[string]string{
+	"problem.validation":            "Ошибка валидации",
+	"problem.unauthorized":          "Требуется авторизация",
+	"problem.forbidden":             "Доступ запрещён",
+	"problem.not_found":             "Не найдено",
+	"problem.conflict":              "Конфликт",
+	"problem.internal":              "Внутренняя ошибка сервера",
+	"problem.precondition_failed":   "Предусловие не выполнено",
+	"problem.precondition_required": "Требуется предусловие",
+	"problem.too_many_requests":     "Слишком много запросов",
+
+	"EMAIL_EXISTS":         "Этот email уже зарегистрирован",
+	"EMAIL_REQUIRED":       "Требуется email",
+	"EMAIL_TOO_LONG":       "Email слишком длинный",
+	"EMAIL_INVALID":        "Неверный формат email",
+	"INVALID_EMAIL_FORMAT": "Неверный формат email",
+
+	"PASSWORD_REQUIRED":  "Требуется пароль",
+	"PASSWORD_TOO_SHORT": "Пароль должен содержать не менее 8 символов",
+	"PASSWORD_TOO_LONG":  "Пароль слишком длинный",
+	"PASSWORD_WEAK":      "Пароль должен содержать строчные и заглавные буквы, цифру и специальный символ",
+
+	"FULLNAME_REQUIRED":  "Требуется полное имя",
+	"FULLNAME_TOO_SHORT": "Полное имя слишком короткое",
+	"FULLNAME_TOO_LONG":  "Полное имя слишком длинное",
+
+	"PHONE_REQUIRED": "Требуется номер телефона",
+	"PHONE_INVALID":  "Неверный формат телефона (пример: +994501234567)",
+
+	"INVALID_CREDENTIALS": "Неверный email или пароль",
+	"USER_INACTIVE":       "Аккаунт деактивирован",
+
+	"INVALID_REFRESH_TOKEN":  "Неверный refresh token",
+	"REFRESH_TOKEN_EXPIRED":  "Срок действия refresh token истёк",
+	"REFRESH_TOKEN_REVOKED":  "Refresh token отозван",
+	"REFRESH_TOKEN_REQUIRED": "Требуется refresh token",
+	"USER_NOT_FOUND":         "Пользователь не найден",
+
+	"INVALID_TOKEN":           "Токен неверен или не существует",
+	"TOKEN_EXPIRED":           "Срок действия токена истёк",
+	"TOKEN_ALREADY_USED":      "Токен уже использован",
+	"TOKEN_USED":              "Токен уже использован",
+	"TOKEN_REVOKED":           "Токен отозван",
+	"RESET_TOKEN_SAVE_FAILED": "Не удалось сохранить токен сброса",
+	"PASSWORD_HASH_FAILED":    "Ошибка при обработке пароля",
+	"PASSWORD_HASHING_FAILED": "Ошибка при обработке пароля",
+	"PASSWORD_UPDATE_FAILED":  "Не удалось обновить пароль",
+
+	"CURRENT_PASSWORD_REQUIRED":   "Требуется текущий пароль",
+	"INVALID_CURRENT_PASSWORD":    "Текущий пароль неверен",
+	"PASSWORD_UNCHANGED":          "Новый пароль должен отличаться от текущего",
+	"OWNERSHIP_TRANSFER_REQUIRED": "Перед удалением аккаунта передайте бизнес или добавьте совладельца",
+	"UNSUPPORTED_FORMAT":          "Формат должен быть json или zip",
+	"INVALID_LOCALE":              "Язык должен быть az, en или ru",
+
+	"UNAUTHORIZED":         "Требуется авторизация",
+	"NO_TOKEN":             "Требуется заголовок Authorization",
+	"INVALID_TOKEN_FORMAT": "Неверный формат токена",
+	"NO_ROLE":              "В токене отсутствует роль",
+	"FORBIDDEN":            "Доступ запрещён",
+
+	"BUSINESS_NAME_REQUIRED":      "Требуется название бизнеса",
+	"BUSINESS_NAME_TOO_SHORT":     "Название бизнеса должно содержать не менее 2 символов",
+	"BUSINESS_NAME_TOO_LONG":      "Название бизнеса слишком длинное",
+	"SERVICE_CATEGORY_REQUIRED":   "Требуется категория услуг",
+	"SERVICE_CATEGORY_TOO_SHORT":  "Категория услуг должна содержать не менее 3 символов",
+	"SERVICE_CATEGORY_TOO_LONG":   "Категория услуг не может превышать 50 символов",
+	"INDUSTRY_REQUIRED":           "Требуется отрасль",
+	"INDUSTRY_TOO_SHORT":          "Отрасль должна содержать не менее 3 символов",
+	"INDUSTRY_TOO_LONG":           "Отрасль не может превышать 50 символов",
+	"INVALID_BUSINESS_TYPE":       "Неверный тип бизнеса",
+	"BUSINESS_NOT_FOUND":          "Бизнес не найден",
+	"INVALID_BUSINESS":            "ID бизнеса не может быть пустым",
+	"INVALID_BUSINESS_ID":         "Неверный ID бизнеса",
+	"INVALID_OWNER_ID":            "ID владельца не может быть пустым",
+	"INVALID_USER":                "ID пользователя не может быть пустым",
+	"INVALID_USER_ID":             "Неверный ID пользователя",
+	"INVALID_TRANSFER_ID":         "Неверный ID передачи",
+	"TRANSFER_TO_SELF":            "Нельзя передать владение самому себе",
+	"RECIPIENT_NOT_ACTIVE_STAFF":  "Пользователь должен быть активным сотрудником этого бизнеса",
+	"CANNOT_REMOVE_PRIMARY_OWNER": "Основного владельца нельзя удалить, сначала передайте владение",
+	"NOT_BUSINESS_OWNER":          "Это действие может выполнить только владелец бизнеса",
+	"TRANSFER_RECIPIENT_MISMATCH": "Эта передача предназначена другому пользователю",
+	"OWNER_NOT_FOUND":             "Совладелец не найден",
+	"TRANSFER_NOT_FOUND":          "Ожидающая передача не найдена",
+	"ALREADY_OWNER":               "Пользователь уже является владельцем этого бизнеса",
+	"TRANSFER_ALREADY_PENDING":    "Другая передача уже ожидает подтверждения",
+	"TRANSFER_NOT_PENDING":        "Передача больше не ожидает подтверждения",
+	"TRANSFER_STALE":              "Владелец бизнеса изменился после начала передачи",
+	"ALREADY_ONBOARDED":           "У пользователя уже есть бизнес",
+	"ALREADY_IN_BUSINESS":         "Пользователь уже относится к другому бизнесу",
+
+	"LOCATION_NAME_REQUIRED":  "Требуется название филиала",
+	"LOCATION_NAME_TOO_SHORT": "Название филиала должно содержать не менее 2 символов",
+	"LOCATION_NAME_TOO_LONG":  "Название филиала не может превышать 100 символов",
+	"NAME_REQUIRED":           "Требуется название",
+	"NAME_TOO_SHORT":          "Название должно содержать не менее 2 символов",
+	"NAME_TOO_LONG":           "Название не может превышать 100 символов",
+
+	"ALREADY_STAFF":           "Пользователь уже является сотрудником этого бизнеса",
+	"CONTACT_REQUIRED":        "Требуется email или телефон",
+	"INVALID_ROLE":            "Неверная роль сотрудника",
+	"INVALID_STAFF":           "ID сотрудника не может быть пустым",
+	"INVITE_ALREADY_ACCEPTED": "Приглашение уже принято",
+	"INVITE_EMAIL_MISMATCH":   "Это приглашение отправлено на другой аккаунт",
+	"INVITE_NOT_FOUND":        "Приглашение не найдено",
+	"INVITE_REVOKED":          "Приглашение отозвано",
+	"LOGIN_REQUIRED":          "Войдите, чтобы принять приглашение, отправленное по SMS",
+	"TITLE_REQUIRED":          "Требуется должность",
+	"TITLE_TOO_SHORT":         "Должность должна содержать не менее 2 символов",
+	"TITLE_TOO_LONG":          "Должность не может превышать 50 символов",
+
+	"DURATION_INVALID":      "Длительность должна быть больше нуля",
+	"DURATION_TOO_LONG":     "Длительность не может превышать 1440 минут",
+	"PRICE_INVALID":         "Цена не может быть отрицательной",
+	"SERVICE_NAME_EXISTS":   "Услуга с таким названием уже существует",
+	"SERVICE_LIST_EMPTY":    "Требуется хотя бы один ID услуги",
+	"SERVICE_LIST_TOO_LONG": "Слишком много услуг в одном запросе",
+	"INVALID_SERVICE":       "ID услуги не может быть пустым",
+	"INVALID_SERVICE_ID":    "Неверный ID услуги",
+
+	"INVALID_DATA":         "Данные не могут быть пустыми",
+	"INVALID_ID":           "Неверный ID",
+	"INVALID_REQUEST":      "Неверный запрос",
+	"INVALID_REQUEST_BODY": "Неверное тело запроса",
+	"NOT_FOUND":            "Не найдено",
+	"VALIDATION_ERROR":     "Неверные входные данные",
+	"INTERNAL_ERROR":       "Внутренняя ошибка сервера",
+
+	"INVALID_LIMIT":      "limit должен быть от 1 до 100",
+	"INVALID_OFFSET":     "offset не может быть отрицательным",
+	"INVALID_SORT":       "Сортировка должна быть created_at или name",
+	"INVALID_ORDER":      "Порядок должен быть asc или desc",
+	"INVALID_STATUS":     "Этот фильтр статуса не поддерживается для списка",
+	"INVALID_CURSOR":     "Курсор недействителен или не соответствует сортировке",
+	"CURSOR_WITH_OFFSET": "cursor и offset нельзя использовать вместе",
+
+	"VERSION_MISMATCH":  "Ресурс был изменён другим запросом; загрузите текущую версию и повторите попытку",
+	"IF_MATCH_REQUIRED": "Для изменения требуется заголовок If-Match с ETag ресурса",
+	"INVALID_IF_MATCH":  "Заголовок If-Match имеет неверный формат",
+
+	"INVALID_IDEMPOTENCY_KEY": "Idempotency-Key должен содержать от 1 до 255 печатных символов ASCII",
+	"IDEMPOTENCY_KEY_REUSED":  "Этот Idempotency-Key уже использован с другим запросом",
+	"IDEMPOTENCY_IN_PROGRESS": "Запрос с этим Idempotency-Key ещё выполняется; повторите попытку позже",
+	"REQUEST_TOO_LARGE":       "Тело запроса слишком велико для идемпотентного запроса",
+	"RATE_LIMITED":            "Превышен лимит запросов; повторите попытку через Retry-After",
+
+	"AUDIT_OWNER_ONLY":     "Журнал аудита доступен только владельцам бизнеса",
+	"INVALID_ENTITY_TYPE":  "Тип объекта не поддерживается",
+	"INVALID_AUDIT_ACTION": "Действие не поддерживается",
+	"INVALID_AUDIT_SORT":   "Журнал аудита сортируется только по created_at",
+	"INVALID_TIME":         "Время должно быть в формате RFC 3339",
+	"INVALID_TIME_RANGE":   "from должно быть раньше to",
+
+	"WEBHOOK_OWNER_ONLY":         "Управлять вебхуками могут только владельцы бизнеса",
+	"WEBHOOK_NOT_FOUND":          "Вебхук не найден",
+	"WEBHOOK_DELIVERY_NOT_FOUND": "Доставка вебхука не найдена",
+	"WEBHOOK_LIMIT_REACHED":      "Достигнуто максимальное количество вебхуков",
+	"INVALID_WEBHOOK_URL":        "URL должен быть абсолютным http(s) адресом",
+	"INVALID_EVENT_TYPES":        "Нужно указать хотя бы один поддерживаемый тип события",
+	"INVALID_DESCRIPTION":        "Описание не может быть длиннее 255 символов",
+	"INVALID_DELIVERY_SORT":      "Доставки сортируются только по created_at",
+	"INVALID_TIMEZONE":           "Часовой пояс должен быть именем IANA, например Asia/Baku",
+	"INVALID_QUIET_HOURS":        "Тихие часы задаются в формате HH:MM, вместе и должны различаться",
+	"INVALID_NOTIFICATION_SORT":  "Уведомления сортируются только по created_at",
+
+	"message.password_changed":             "Пароль изменён, другие сеансы завершены",
+	"message.account_deleted":              "Аккаунт удалён",
+	"message.token_refreshed":              "Токен обновлён",
+	"message.password_reset_sent":          "Ссылка для сброса пароля отправлена на email",
+	"message.password_reset":               "Пароль успешно сброшен",
+	"message.logged_out":                   "Вы вышли из системы",
+	"message.business_updated":             "Бизнес обновлён",
+	"message.co_owner_added":               "Совладелец добавлен",
+	"message.co_owner_removed":             "Совладелец удалён",
+	"message.ownership_transfer_cancelled": "Передача владения отменена",
+	"message.ownership_transferred":        "Владение успешно передано",
+	"message.location_created":             "Филиал создан",
+	"message.location_updated":             "Филиал обновлён",
+	"message.location_deactivated":         "Филиал деактивирован",
+	"message.staff_created":                "Профиль сотрудника создан",
+	"message.staff_updated":                "Данные сотрудника обновлены",
+	"message.staff_deactivated":            "Сотрудник деактивирован",
+	"message.invite_sent":                  "Приглашение отправлено",
+	"message.invite_resent":                "Приглашение отправлено повторно",
+	"message.invite_revoked":               "Приглашение отозвано",
+	"message.invite_accepted":              "Приглашение принято",
+	"message.service_created":              "Услуга создана",
+	"message.service_updated":              "Услуга обновлена",
+	"message.service_deactivated":          "Услуга деактивирована",
+	"message.services_assigned":            "Услуги назначены сотруднику",
+	"message.service_unassigned":           "Услуга снята с сотрудника",
+	"message.webhook_created":              "Вебхук создан. Секрет показывается только один раз, сохраните его",
+	"message.webhook_updated":              "Вебхук обновлён",
+	"message.webhook_deleted":              "Вебхук удалён",
+	"message.webhook_redelivery_queued":    "Повторная доставка поставлена в очередь",
+	"message.preferences_updated":          "Настройки уведомлений сохранены",
+}
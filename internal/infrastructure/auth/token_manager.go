@@ -35,6 +35,7 @@ func (m *JWTTokenManager) GenerateAccessToken(claims *auth.JWTClaims) (string, e
 		"role":        string(claims.Role),
 		"business_id": bidStr,
 		"is_owner":    claims.IsOwner,
+		"locale":      claims.Locale,
 		"exp":         time.Now().Add(m.accessExpiry).Unix(),
 		"iat":         time.Now().Unix(),
 	})
@@ -65,12 +66,14 @@ func (m *JWTTokenManager) ValidateAccessToken(tokenString string) (*auth.JWTClai
 			bIDPtr = &parsedBID
 		}
 	}
+	locale, _ := (*claimsMap)["locale"].(string)
 	return &auth.JWTClaims{
 		UserID:     userID,
 		Email:      (*claimsMap)["email"].(string),
 		Role:       auth.UserRole((*claimsMap)["role"].(string)),
 		BusinessID: bIDPtr,
 		IsOwner:    (*claimsMap)["is_owner"].(bool),
+		Locale:     locale,
 		ExpiresAt:  int64((*claimsMap)["exp"].(float64)),
 	}, nil
 }
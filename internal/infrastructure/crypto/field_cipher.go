@@ -0,0 +1,174 @@
+// File: internal/infrastructure/crypto/field_cipher.go
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const encryptedPrefix = "enc:"
+
+type fieldKey struct {
+	kek []byte
+}
+
+// AESFieldCipher - AES-GCM envelope şifrələmə: hər dəyər üçün təsadüfi data key,
+// data key isə versiyalı master key (KEK) ilə şifrələnir.
+// Format: enc:v<versiya>:<base64(şifrəli data key)>:<base64(şifrəli dəyər)>
+type AESFieldCipher struct {
+	currentVersion int
+	keys           map[int]fieldKey
+}
+
+func NewAESFieldCipher(currentVersion int, currentSecret string, oldSecrets map[int]string) (*AESFieldCipher, error) {
+	if currentVersion < 1 {
+		return nil, fmt.Errorf("encryption key version must be positive, got %d", currentVersion)
+	}
+	if currentSecret == "" {
+		return nil, errors.New("encryption key is empty")
+	}
+
+	keys := map[int]fieldKey{currentVersion: deriveFieldKey(currentSecret)}
+	for version, secret := range oldSecrets {
+		if version == currentVersion {
+			return nil, fmt.Errorf("old encryption key reuses current version %d", version)
+		}
+		if secret == "" {
+			return nil, fmt.Errorf("encryption key version %d is empty", version)
+		}
+		keys[version] = deriveFieldKey(secret)
+	}
+
+	return &AESFieldCipher{
+		currentVersion: currentVersion,
+		keys:           keys,
+	}, nil
+}
+
+func (c *AESFieldCipher) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	sealedValue, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	wrappedKey, err := seal(c.keys[c.currentVersion].kek, dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%s:%s",
+		c.CurrentPrefix(),
+		base64.RawStdEncoding.EncodeToString(wrappedKey),
+		base64.RawStdEncoding.EncodeToString(sealedValue),
+	), nil
+}
+
+// Decrypt - şifrələnməmiş köhnə dəyərləri olduğu kimi qaytarır ki, migrasiya zamanı oxunuş sınmasın
+func (c *AESFieldCipher) Decrypt(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return value, nil
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 4 || !strings.HasPrefix(parts[1], "v") {
+		return "", errors.New("malformed encrypted value")
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil {
+		return "", fmt.Errorf("malformed key version: %w", err)
+	}
+	key, ok := c.keys[version]
+	if !ok {
+		return "", fmt.Errorf("unknown encryption key version %d", version)
+	}
+
+	wrappedKey, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("malformed data key: %w", err)
+	}
+	sealedValue, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return "", fmt.Errorf("malformed ciphertext: %w", err)
+	}
+
+	dataKey, err := open(key.kek, wrappedKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	plaintext, err := open(dataKey, sealedValue)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// CurrentPrefix - cari açarla şifrələnmiş dəyərlərin prefiksi, məs. "enc:v2:"
+func (c *AESFieldCipher) CurrentPrefix() string {
+	return fmt.Sprintf("%sv%d:", encryptedPrefix, c.currentVersion)
+}
+
+func deriveFieldKey(secret string) fieldKey {
+	master := sha256.Sum256([]byte(secret))
+	return fieldKey{
+		kek: hmacSHA256(master[:], []byte("field-encryption-kek")),
+	}
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+	return gcm, nil
+}
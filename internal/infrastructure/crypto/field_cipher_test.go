@@ -0,0 +1,166 @@
+package crypto
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+// goldenValue - "golden-key-v1" (versiya 1) ilə şifrələnmiş "+994501234567".
+// Açar törətmə və ya format dəyişsə bazadakı bütün dəyərlər oxunmaz olar - bu test onu tutur.
+const goldenValue = "enc:v1:po4Fab1Z+qZQ6IK8xgl8QYkWBi1Og3sHuYyuCl8y/D5CS02Kd/49vjIfDy/nlK137huMdI9IqiV1lyIo:nuIsgIUXyP/FGYIHubf5/C16z+J1boFyOv7zQF8pleAvttLW/NEpzh0"
+
+func newCipher(t *testing.T, version int, secret string, old map[int]string) *AESFieldCipher {
+	t.Helper()
+	cipher, err := NewAESFieldCipher(version, secret, old)
+	if err != nil {
+		t.Fatalf("NewAESFieldCipher: %v", err)
+	}
+	return cipher
+}
+
+func TestFieldCipherRoundTrip(t *testing.T) {
+	cipher := newCipher(t, 3, "current-key", nil)
+
+	for _, plaintext := range []string{"+994501234567", "45.50", "ünvan: Bakı, Nizami küç. 1", strings.Repeat("x", 4096)} {
+		encrypted, err := cipher.Encrypt(plaintext)
+		if err != nil {
+			t.Fatalf("Encrypt(%q): %v", plaintext, err)
+		}
+		if !strings.HasPrefix(encrypted, "enc:v3:") || strings.Count(encrypted, ":") != 3 {
+			t.Fatalf("Encrypt(%q) = %q, want enc:v3:<key>:<value>", plaintext, encrypted)
+		}
+		if strings.Contains(encrypted, plaintext) {
+			t.Fatalf("Encrypt(%q) leaks the plaintext", plaintext)
+		}
+
+		decrypted, err := cipher.Decrypt(encrypted)
+		if err != nil || decrypted != plaintext {
+			t.Fatalf("Decrypt(Encrypt(%q)) = %q, %v", plaintext, decrypted, err)
+		}
+	}
+}
+
+func TestFieldCipherUsesFreshKeys(t *testing.T) {
+	cipher := newCipher(t, 1, "current-key", nil)
+
+	first, _ := cipher.Encrypt("same value")
+	second, _ := cipher.Encrypt("same value")
+	if first == second {
+		t.Fatal("equal plaintexts produced equal ciphertexts")
+	}
+}
+
+func TestFieldCipherEmptyValue(t *testing.T) {
+	cipher := newCipher(t, 1, "current-key", nil)
+
+	encrypted, err := cipher.Encrypt("")
+	if err != nil || encrypted != "" {
+		t.Fatalf("Encrypt(\"\") = %q, %v; want empty", encrypted, err)
+	}
+}
+
+func TestFieldCipherGoldenValue(t *testing.T) {
+	cipher := newCipher(t, 1, "golden-key-v1", nil)
+
+	decrypted, err := cipher.Decrypt(goldenValue)
+	if err != nil || decrypted != "+994501234567" {
+		t.Fatalf("Decrypt(golden) = %q, %v", decrypted, err)
+	}
+}
+
+func TestFieldCipherKeyRotation(t *testing.T) {
+	previous := newCipher(t, 1, "key-one", nil)
+	oldValue, err := previous.Encrypt("+994501234567")
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	rotated := newCipher(t, 2, "key-two", map[int]string{1: "key-one"})
+	if rotated.CurrentPrefix() != "enc:v2:" {
+		t.Fatalf("CurrentPrefix = %q, want enc:v2:", rotated.CurrentPrefix())
+	}
+
+	decrypted, err := rotated.Decrypt(oldValue)
+	if err != nil || decrypted != "+994501234567" {
+		t.Fatalf("Decrypt(v1 value) after rotation = %q, %v", decrypted, err)
+	}
+	newValue, err := rotated.Encrypt(decrypted)
+	if err != nil || !strings.HasPrefix(newValue, "enc:v2:") {
+		t.Fatalf("re-encrypted value = %q, %v; want enc:v2: prefix", newValue, err)
+	}
+
+	// Köhnə açar konfiqurasiyadan çıxarılıbsa dəyər səssizcə boş qayıtmamalıdır
+	withoutOld := newCipher(t, 2, "key-two", nil)
+	if _, err := withoutOld.Decrypt(oldValue); err == nil {
+		t.Fatal("Decrypt(v1 value) without the v1 key succeeded")
+	}
+}
+
+func TestFieldCipherLegacyPlaintext(t *testing.T) {
+	cipher := newCipher(t, 1, "current-key", nil)
+
+	for _, legacy := range []string{"", "+994501234567", "55.00", "v1:abc:def"} {
+		decrypted, err := cipher.Decrypt(legacy)
+		if err != nil || decrypted != legacy {
+			t.Errorf("Decrypt(%q) = %q, %v; want it unchanged", legacy, decrypted, err)
+		}
+	}
+}
+
+func TestFieldCipherRejectsTampering(t *testing.T) {
+	cipher := newCipher(t, 1, "current-key", nil)
+	encrypted, err := cipher.Encrypt("+994501234567")
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	parts := strings.Split(encrypted, ":")
+
+	flip := func(encoded string) string {
+		raw, err := base64.RawStdEncoding.DecodeString(encoded)
+		if err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		raw[len(raw)-1] ^= 0x01
+		return base64.RawStdEncoding.EncodeToString(raw)
+	}
+
+	cases := map[string]string{
+		"flipped ciphertext": strings.Join([]string{parts[0], parts[1], parts[2], flip(parts[3])}, ":"),
+		"flipped data key":   strings.Join([]string{parts[0], parts[1], flip(parts[2]), parts[3]}, ":"),
+		"swapped data key":   strings.Join([]string{parts[0], parts[1], parts[3], parts[2]}, ":"),
+		"unknown version":    strings.Join([]string{parts[0], "v9", parts[2], parts[3]}, ":"),
+		"bad version":        strings.Join([]string{parts[0], "vx", parts[2], parts[3]}, ":"),
+		"missing part":       strings.Join(parts[:3], ":"),
+		"truncated":          strings.Join([]string{parts[0], parts[1], parts[2], parts[3][:4]}, ":"),
+		"not base64":         strings.Join([]string{parts[0], parts[1], parts[2], "!!!"}, ":"),
+	}
+	for name, value := range cases {
+		if decrypted, err := cipher.Decrypt(value); err == nil {
+			t.Errorf("%s: Decrypt = %q, want an error", name, decrypted)
+		}
+	}
+
+	other := newCipher(t, 1, "another-key", nil)
+	if _, err := other.Decrypt(encrypted); err == nil {
+		t.Error("Decrypt with a different key of the same version succeeded")
+	}
+}
+
+func TestNewAESFieldCipherValidation(t *testing.T) {
+	cases := map[string]struct {
+		version int
+		secret  string
+		old     map[int]string
+	}{
+		"zero version":       {version: 0, secret: "key"},
+		"empty secret":       {version: 1, secret: ""},
+		"old reuses current": {version: 2, secret: "key", old: map[int]string{2: "other"}},
+		"empty old secret":   {version: 2, secret: "key", old: map[int]string{1: ""}},
+	}
+	for name, tc := range cases {
+		if _, err := NewAESFieldCipher(tc.version, tc.secret, tc.old); err == nil {
+			t.Errorf("%s: NewAESFieldCipher succeeded, want an error", name)
+		}
+	}
+}
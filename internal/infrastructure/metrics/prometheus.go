@@ -0,0 +1,140 @@
+// File: internal/infrastructure/metrics/prometheus.go
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "booking"
+
+// Prometheus - API və worker metriklərinin registry-si.
+// domain/metrics.Recorder portunu və HTTP/job müşahidəçilərini həyata keçirir.
+type Prometheus struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	businessEvents *prometheus.CounterVec
+
+	jobRuns       *prometheus.CounterVec
+	jobFailures   *prometheus.CounterVec
+	jobDuration   *prometheus.HistogramVec
+	jobQueueDepth *prometheus.GaugeVec
+	jobsInFlight  prometheus.Gauge
+}
+
+func New() *Prometheus {
+	m := &Prometheus{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTP requests by method, route pattern and status code.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by method and route pattern.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		businessEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "business_events_total",
+			Help:      "Business-level events recorded by domain services.",
+		}, []string{"event"}),
+		jobRuns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "job_runs_total",
+			Help:      "Worker job runs.",
+		}, []string{"job"}),
+		jobFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "job_failures_total",
+			Help:      "Worker job runs that returned an error.",
+		}, []string{"job"}),
+		jobDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "job_duration_seconds",
+			Help:      "Worker job run latency.",
+			Buckets:   []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 120},
+		}, []string{"job"}),
+		jobQueueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "job_queue_depth",
+			Help:      "Items waiting to be processed by a worker job.",
+		}, []string{"job"}),
+		jobsInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "jobs_in_flight",
+			Help:      "Worker jobs currently running.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.businessEvents,
+		m.jobRuns,
+		m.jobFailures,
+		m.jobDuration,
+		m.jobQueueDepth,
+		m.jobsInFlight,
+	)
+
+	for _, event := range domain.Events {
+		m.businessEvents.WithLabelValues(string(event))
+	}
+	return m
+}
+
+// RegisterDB - sqlx/sql pool statistikası (open, in_use, idle, wait_count...)
+func (m *Prometheus) RegisterDB(name string, db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+func (m *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Prometheus) Record(event domain.Event) {
+	m.businessEvents.WithLabelValues(string(event)).Inc()
+}
+
+func (m *Prometheus) ObserveRequest(method, route string, status int, duration time.Duration) {
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+func (m *Prometheus) ObserveJob(job string, duration time.Duration, err error) {
+	m.jobRuns.WithLabelValues(job).Inc()
+	m.jobDuration.WithLabelValues(job).Observe(duration.Seconds())
+	if err != nil {
+		m.jobFailures.WithLabelValues(job).Inc()
+	}
+}
+
+func (m *Prometheus) SetQueueDepth(job string, depth int) {
+	m.jobQueueDepth.WithLabelValues(job).Set(float64(depth))
+}
+
+func (m *Prometheus) SetJobsInFlight(n int) {
+	m.jobsInFlight.Set(float64(n))
+}
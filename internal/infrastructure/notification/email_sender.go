@@ -0,0 +1,173 @@
+// File: internal/infrastructure/notification/email_sender.go
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+)
+
+const senderName = "Booking Support"
+
+// SendTimeout - bir məktubun bağlantıdan QUIT-ə qədər göndərilmə müddəti
+const SendTimeout = 30 * time.Second
+
+// SMTPSender - email kanalı: multipart/alternative (text + HTML) məktub göndərir
+type SMTPSender struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPSender(host string, port int, username, password, from string) *SMTPSender {
+	return &SMTPSender{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+func (s *SMTPSender) Channel() domain.Channel {
+	return domain.ChannelEmail
+}
+
+func (s *SMTPSender) Send(ctx context.Context, recipient string, content *domain.Content) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	address, err := mail.ParseAddress(recipient)
+	if err != nil {
+		return fmt.Errorf("invalid email recipient: %w", err)
+	}
+
+	message, err := s.compose(address.Address, content)
+	if err != nil {
+		return err
+	}
+
+	if err := s.deliver(ctx, address.Address, message); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// deliver - smtp.SendMail ilə eynidir, amma bütün sessiya SendTimeout ilə məhdudlaşır ki,
+// cavab verməyən server worker-i saxlamasın
+func (s *SMTPSender) deliver(ctx context.Context, to string, message []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, SendTimeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.host, strconv.Itoa(s.port)))
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if ok, _ := client.Extension("AUTH"); ok && s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(s.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (s *SMTPSender) compose(to string, content *domain.Content) ([]byte, error) {
+	if content == nil {
+		return nil, errors.New("email content is empty")
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		value       string
+	}{
+		{"text/plain; charset=UTF-8", content.Text},
+		{"text/html; charset=UTF-8", content.HTML},
+	} {
+		writer, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create email part: %w", err)
+		}
+		encoder := quotedprintable.NewWriter(writer)
+		if _, err := encoder.Write([]byte(part.value)); err != nil {
+			return nil, fmt.Errorf("failed to encode email part: %w", err)
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, fmt.Errorf("failed to encode email part: %w", err)
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close email body: %w", err)
+	}
+
+	from := (&mail.Address{Name: senderName, Address: s.from}).String()
+	var message bytes.Buffer
+	for _, header := range [][2]string{
+		{"From", from},
+		{"To", to},
+		{"Reply-To", s.from},
+		{"Subject", mime.QEncoding.Encode("UTF-8", strings.TrimSpace(content.Subject))},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + parts.Boundary()},
+	} {
+		fmt.Fprintf(&message, "%s: %s\r\n", header[0], header[1])
+	}
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+	return message.Bytes(), nil
+}
@@ -0,0 +1,147 @@
+// File: internal/infrastructure/notification/renderer.go
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+)
+
+// templates/<locale>/<name>.tmpl bu blokları təyin edir: "subject", "text" və "content" (email),
+// "sms" (SMS kanalı). "content" templates/layout.html daxilində render olunur.
+//
+//go:embed templates
+var templateFS embed.FS
+
+type templateKey struct {
+	name   domain.Template
+	locale i18n.Locale
+}
+
+type templateSet struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// view - şablonlara ötürülən dəyərlər: {{.Data.url}}, {{.Lang}}
+type view struct {
+	Lang string
+	Data map[string]string
+}
+
+// TemplateRenderer - bütün şablonlar başlanğıcda parse olunur və yoxlanır, xəta tətbiqi dayandırır.
+// Tərcüməsi olmayan locale i18n.Default-a düşür.
+type TemplateRenderer struct {
+	sets map[templateKey]*templateSet
+}
+
+func NewTemplateRenderer() (*TemplateRenderer, error) {
+	renderer := &TemplateRenderer{sets: map[templateKey]*templateSet{}}
+	for name, def := range domain.Templates() {
+		for _, locale := range i18n.Supported {
+			set, err := parseTemplate(name, locale, def.Channels)
+			if err != nil {
+				return nil, err
+			}
+			if set == nil {
+				if locale == i18n.Default {
+					return nil, fmt.Errorf("notification template %s is missing for default locale %s", name, locale)
+				}
+				continue
+			}
+			renderer.sets[templateKey{name: name, locale: locale}] = set
+		}
+	}
+	return renderer, nil
+}
+
+// parseTemplate - fayl yoxdursa nil qaytarır
+func parseTemplate(name domain.Template, locale i18n.Locale, channels []domain.Channel) (*templateSet, error) {
+	path := fmt.Sprintf("templates/%s/%s.tmpl", locale, name)
+	source, err := templateFS.ReadFile(path)
+	if err != nil {
+		return nil, nil
+	}
+
+	text, err := texttemplate.New(path).Option("missingkey=error").Parse(string(source))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	html, err := htmltemplate.New(path).Option("missingkey=error").ParseFS(templateFS, "templates/layout.html")
+	if err == nil {
+		html, err = html.Parse(string(source))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	required := map[domain.Channel][]string{
+		domain.ChannelEmail: {"subject", "text", "content"},
+		domain.ChannelSMS:   {"sms"},
+	}
+	for _, channel := range channels {
+		for _, block := range required[channel] {
+			if text.Lookup(block) == nil {
+				return nil, fmt.Errorf("%s must define %q for channel %s", path, block, channel)
+			}
+		}
+	}
+	return &templateSet{text: text, html: html}, nil
+}
+
+func (r *TemplateRenderer) Render(
+	name domain.Template,
+	channel domain.Channel,
+	locale i18n.Locale,
+	data map[string]string,
+) (*domain.Content, error) {
+	set, ok := r.sets[templateKey{name: name, locale: locale}]
+	if !ok {
+		locale = i18n.Default
+		if set, ok = r.sets[templateKey{name: name, locale: locale}]; !ok {
+			return nil, fmt.Errorf("notification template %s not found", name)
+		}
+	}
+	values := view{Lang: string(locale), Data: data}
+
+	switch channel {
+	case domain.ChannelSMS:
+		text, err := executeText(set.text, "sms", values)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.Content{Text: text}, nil
+	case domain.ChannelEmail:
+		subject, err := executeText(set.text, "subject", values)
+		if err != nil {
+			return nil, err
+		}
+		text, err := executeText(set.text, "text", values)
+		if err != nil {
+			return nil, err
+		}
+		var html bytes.Buffer
+		if err := set.html.ExecuteTemplate(&html, "layout", values); err != nil {
+			return nil, fmt.Errorf("failed to render %s html: %w", name, err)
+		}
+		return &domain.Content{Subject: subject, Text: text, HTML: html.String()}, nil
+	}
+	return nil, fmt.Errorf("unsupported notification channel %s", channel)
+}
+
+func executeText(tmpl *texttemplate.Template, block string, values view) (string, error) {
+	if tmpl.Lookup(block) == nil {
+		return "", fmt.Errorf("template %s does not define %q", tmpl.Name(), block)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, block, values); err != nil {
+		return "", fmt.Errorf("failed to render %s %s: %w", tmpl.Name(), block, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,29 @@
+// File: internal/infrastructure/notification/sms_sender.go
+package notification
+
+import (
+	"context"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+)
+
+// LogSMSSender - SMS provayderi qoşulana qədər mesajları log-a yazır
+type LogSMSSender struct {
+	logger logger.Logger
+}
+
+func NewLogSMSSender(appLogger logger.Logger) *LogSMSSender {
+	return &LogSMSSender{logger: appLogger}
+}
+
+func (s *LogSMSSender) Channel() domain.Channel {
+	return domain.ChannelSMS
+}
+
+// Send - telefon nömrəsi və mətn şəxsi məlumatdır, log-a yazılmır; bildirişin id, kanal və
+// şablonu ctx-dən gəlir
+func (s *LogSMSSender) Send(ctx context.Context, recipient string, content *domain.Content) error {
+	s.logger.WithContext(ctx).Info("SMS sent")
+	return nil
+}
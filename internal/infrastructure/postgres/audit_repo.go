@@ -0,0 +1,114 @@
+// File: internal/infrastructure/postgres/audit_repo.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type AuditRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditRepository(db *sqlx.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+type auditEventRow struct {
+	ID         uuid.UUID      `db:"id"`
+	BusinessID uuid.UUID      `db:"business_id"`
+	ActorID    *uuid.UUID     `db:"actor_id"`
+	EntityType string         `db:"entity_type"`
+	EntityID   uuid.UUID      `db:"entity_id"`
+	Action     string         `db:"action"`
+	Changes    []byte         `db:"changes"`
+	IP         sql.NullString `db:"ip"`
+	RequestID  sql.NullString `db:"request_id"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+func (r *AuditRepository) Append(ctx context.Context, event *audit.Event) error {
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit changes: %w", err)
+	}
+	query := `
+		INSERT INTO audit_events (id, business_id, actor_id, entity_type, entity_id,
+		                          action, changes, ip, request_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err = executor(ctx, r.db).ExecContext(ctx, query,
+		event.ID, event.BusinessID, event.ActorID, string(event.EntityType), event.EntityID,
+		string(event.Action), changes, nullIfEmpty(event.IP), nullIfEmpty(event.RequestID), event.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append audit event: %w", err)
+	}
+	return nil
+}
+
+// ListByBusiness - limit+1 sətir qaytarır, səhifələmə domain qatında edilir
+func (r *AuditRepository) ListByBusiness(
+	ctx context.Context,
+	businessID uuid.UUID,
+	filter audit.Filter,
+	q listing.Query,
+) ([]*audit.Event, error) {
+	list := newListQuery("business_id = $1", businessID)
+	if filter.EntityType != "" {
+		list.where("entity_type = ?", string(filter.EntityType))
+	}
+	if filter.EntityID != nil {
+		list.where("entity_id = ?", *filter.EntityID)
+	}
+	if filter.Action != "" {
+		list.where("action = ?", string(filter.Action))
+	}
+	if filter.ActorID != nil {
+		list.where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.From != nil {
+		list.where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		list.where("created_at < ?", *filter.To)
+	}
+
+	query, args := list.build(`
+		SELECT id, business_id, actor_id, entity_type, entity_id,
+		       action, changes, ip, request_id, created_at
+		FROM audit_events`, q, listColumns{id: "id", createdAt: "created_at"})
+
+	var rows []auditEventRow
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	events := make([]*audit.Event, 0, len(rows))
+	for _, row := range rows {
+		event := &audit.Event{
+			ID:         row.ID,
+			BusinessID: row.BusinessID,
+			ActorID:    row.ActorID,
+			EntityType: audit.EntityType(row.EntityType),
+			EntityID:   row.EntityID,
+			Action:     audit.Action(row.Action),
+			IP:         row.IP.String,
+			RequestID:  row.RequestID.String,
+			CreatedAt:  row.CreatedAt,
+		}
+		if err := json.Unmarshal(row.Changes, &event.Changes); err != nil {
+			return nil, fmt.Errorf("failed to decode audit changes: %w", err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -13,12 +13,14 @@ import (
 )
 
 type AuthRepository struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	cipher FieldCipher
 }
 
-func NewAuthRepository(db *sqlx.DB) *AuthRepository {
+func NewAuthRepository(db *sqlx.DB, cipher FieldCipher) *AuthRepository {
 	return &AuthRepository{
-		db: db,
+		db:     db,
+		cipher: cipher,
 	}
 }
 
@@ -27,15 +29,20 @@ func (r *AuthRepository) CreateUser(ctx context.Context, user *auth.User) error
         INSERT INTO users (
             id, email, full_name, phone, password_hash, 
             role, business_id, avatar, is_active, is_owner, 
-            email_verified, created_at, updated_at
+            email_verified, locale, created_at, updated_at
         )
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
     `
-	_, err := r.db.ExecContext(ctx, query,
+	encryptedPhone, err := r.cipher.Encrypt(user.Phone)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt phone: %w", err)
+	}
+
+	_, err = executor(ctx, r.db).ExecContext(ctx, query,
 		user.ID,
 		user.Email,
 		user.FullName,
-		user.Phone,
+		encryptedPhone,
 		user.PasswordHash,
 		user.Role,
 		user.BusinessID,
@@ -43,6 +50,7 @@ func (r *AuthRepository) CreateUser(ctx context.Context, user *auth.User) error
 		user.IsActive,
 		user.IsOwner,
 		user.EmailVerified,
+		nullIfEmpty(user.Locale),
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
@@ -56,30 +64,16 @@ func (r *AuthRepository) CreateUser(ctx context.Context, user *auth.User) error
 
 func (r *AuthRepository) GetUserByEmail(ctx context.Context, email string) (*auth.User, error) {
 	query := `
-        SELECT id, email, full_name, phone, password_hash, role, 
+        SELECT id, email, full_name, COALESCE(phone, '') AS phone, password_hash, role, 
                business_id, avatar, is_active, is_owner, email_verified, 
-               created_at, updated_at 
+               COALESCE(locale, '') AS locale, created_at, updated_at 
         FROM users 
         WHERE email = $1 
         LIMIT 1
     `
 
 	user := &auth.User{}
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
-		&user.ID,
-		&user.Email,
-		&user.FullName,
-		&user.Phone,
-		&user.PasswordHash,
-		&user.Role,
-		&user.BusinessID,
-		&user.Avatar,
-		&user.IsActive,
-		&user.IsOwner,
-		&user.EmailVerified,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	err := executor(ctx, r.db).GetContext(ctx, user, query, email)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -87,34 +81,23 @@ func (r *AuthRepository) GetUserByEmail(ctx context.Context, email string) (*aut
 		}
 		return nil, fmt.Errorf("failed to get user by email %s: %w", email, err)
 	}
+	if err := r.decryptUser(user); err != nil {
+		return nil, err
+	}
 	return user, nil
 }
 
 func (r *AuthRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*auth.User, error) {
 	query := `
-        SELECT id, email, full_name, phone, password_hash, role, 
+        SELECT id, email, full_name, COALESCE(phone, '') AS phone, password_hash, role, 
                business_id, avatar, is_active, is_owner, email_verified, 
-               created_at, updated_at 
+               COALESCE(locale, '') AS locale, created_at, updated_at 
         FROM users 
         WHERE id = $1
     `
 
 	user := &auth.User{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&user.ID,
-		&user.Email,
-		&user.FullName,
-		&user.Phone,
-		&user.PasswordHash,
-		&user.Role,
-		&user.BusinessID,
-		&user.Avatar,
-		&user.IsActive,
-		&user.IsOwner,
-		&user.EmailVerified,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	err := executor(ctx, r.db).GetContext(ctx, user, query, id)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -122,12 +105,24 @@ func (r *AuthRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*auth.U
 		}
 		return nil, fmt.Errorf("failed to get user by id %s: %w", id, err)
 	}
+	if err := r.decryptUser(user); err != nil {
+		return nil, err
+	}
 	return user, nil
 }
 
+func (r *AuthRepository) decryptUser(user *auth.User) error {
+	phone, err := r.cipher.Decrypt(user.Phone)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt phone for user %s: %w", user.ID, err)
+	}
+	user.Phone = phone
+	return nil
+}
+
 func (r *AuthRepository) SaveRefreshToken(ctx context.Context, token *auth.RefreshToken) error {
 	query := `INSERT INTO refresh_tokens (id, user_id, token, expires_at, created_at, revoked) VALUES ($1, $2, $3, $4, $5, $6)`
-	_, err := r.db.ExecContext(ctx, query, token.ID, token.UserID, token.Token, token.ExpiresAt, token.CreatedAt, token.Revoked)
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, token.ID, token.UserID, token.Token, token.ExpiresAt, token.CreatedAt, token.Revoked)
 	if err != nil {
 		return fmt.Errorf("failed to save refresh token for user %s: %w", token.UserID, err)
 	}
@@ -137,7 +132,7 @@ func (r *AuthRepository) SaveRefreshToken(ctx context.Context, token *auth.Refre
 func (r *AuthRepository) GetRefreshToken(ctx context.Context, token string) (*auth.RefreshToken, error) {
 	query := `SELECT id, user_id, token, expires_at, created_at, revoked FROM refresh_tokens WHERE token = $1`
 	rt := &auth.RefreshToken{}
-	err := r.db.QueryRowContext(ctx, query, token).Scan(&rt.ID, &rt.UserID, &rt.Token, &rt.ExpiresAt, &rt.CreatedAt, &rt.Revoked)
+	err := executor(ctx, r.db).GetContext(ctx, rt, query, token)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -149,7 +144,7 @@ func (r *AuthRepository) GetRefreshToken(ctx context.Context, token string) (*au
 
 func (r *AuthRepository) RevokeRefreshToken(ctx context.Context, tokenID uuid.UUID) error {
 	query := `UPDATE refresh_tokens SET revoked = true WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, tokenID)
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, tokenID)
 	if err != nil {
 		return fmt.Errorf("failed to revoke refresh token %s: %w", tokenID, err)
 	}
@@ -173,7 +168,7 @@ func (r *AuthRepository) SavePasswordReset(ctx context.Context, reset *auth.Pass
 		updatedAt = reset.CreatedAt
 	}
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
 		reset.ID,
 		reset.Email,
 		reset.Token,
@@ -191,7 +186,7 @@ func (r *AuthRepository) SavePasswordReset(ctx context.Context, reset *auth.Pass
 func (r *AuthRepository) GetPasswordReset(ctx context.Context, token string) (*auth.PasswordReset, error) {
 	query := `SELECT id, email, token, expires_at, used, created_at FROM password_resets WHERE token = $1`
 	pr := &auth.PasswordReset{}
-	err := r.db.QueryRowContext(ctx, query, token).Scan(&pr.ID, &pr.Email, &pr.Token, &pr.ExpiresAt, &pr.Used, &pr.CreatedAt)
+	err := executor(ctx, r.db).GetContext(ctx, pr, query, token)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -203,7 +198,7 @@ func (r *AuthRepository) GetPasswordReset(ctx context.Context, token string) (*a
 
 func (r *AuthRepository) UpdatePassword(ctx context.Context, userID string, hashedPassword string) error {
 	query := `UPDATE users SET password_hash = $1, updated_at = $2 WHERE id = $3`
-	_, err := r.db.ExecContext(ctx, query, hashedPassword, time.Now(), userID)
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, hashedPassword, time.Now(), userID)
 	if err != nil {
 		return fmt.Errorf("failed to update password for user %s: %w", userID, err)
 	}
@@ -213,7 +208,7 @@ func (r *AuthRepository) UpdatePassword(ctx context.Context, userID string, hash
 func (r *AuthRepository) EmailExists(ctx context.Context, email string) (bool, error) {
 	var exists bool
 	query := `SELECT EXISTS(SELECT 1 FROM users WHERE email = $1)`
-	err := r.db.QueryRowContext(ctx, query, email).Scan(&exists)
+	err := executor(ctx, r.db).GetContext(ctx, &exists, query, email)
 	if err != nil {
 		return false, fmt.Errorf("failed to check email existence for %s: %w", email, err)
 	}
@@ -228,7 +223,7 @@ func (r *AuthRepository) UpdateUserStatus(ctx context.Context, userID uuid.UUID,
         SET is_active = $1, updated_at = $2 
         WHERE id = $3
     `
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
 		isActive,
 		time.Now(),
 		userID,
@@ -239,3 +234,167 @@ func (r *AuthRepository) UpdateUserStatus(ctx context.Context, userID uuid.UUID,
 	}
 	return nil
 }
+
+// UpdateUserLocale - boş locale NULL kimi yazılır (Accept-Language-ə qayıdış)
+func (r *AuthRepository) UpdateUserLocale(ctx context.Context, userID uuid.UUID, locale string) error {
+	query := `
+        UPDATE users
+        SET locale = $1, updated_at = NOW()
+        WHERE id = $2
+    `
+	if _, err := executor(ctx, r.db).ExecContext(ctx, query, nullIfEmpty(locale), userID); err != nil {
+		return fmt.Errorf("failed to update locale for user %s: %w", userID, err)
+	}
+	return nil
+}
+
+func (r *AuthRepository) UpdateUserBusiness(ctx context.Context, userID, businessID uuid.UUID, role auth.UserRole, isOwner bool) error {
+	query := `
+        UPDATE users
+        SET business_id = $1, role = $2, is_owner = $3, updated_at = NOW()
+        WHERE id = $4
+    `
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, businessID, role, isOwner, userID)
+	if err != nil {
+		return fmt.Errorf("failed to attach user %s to business: %w", userID, err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("user not found")
+	}
+	return nil
+}
+
+func (r *AuthRepository) ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]*auth.RefreshToken, error) {
+	query := `
+        SELECT id, user_id, token, expires_at, created_at, revoked
+        FROM refresh_tokens
+        WHERE user_id = $1
+        ORDER BY created_at DESC
+    `
+	var tokens []*auth.RefreshToken
+	if err := executor(ctx, r.db).SelectContext(ctx, &tokens, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list refresh tokens for user %s: %w", userID, err)
+	}
+	return tokens, nil
+}
+
+// ChangePassword - parolu yeniləyir və keepTokenID xaricində bütün sessiyaları ləğv edir
+func (r *AuthRepository) ChangePassword(ctx context.Context, userID uuid.UUID, hashedPassword string, keepTokenID *uuid.UUID) error {
+	return NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE users SET password_hash = $1, updated_at = $2 WHERE id = $3`,
+			hashedPassword, time.Now(), userID,
+		); err != nil {
+			return fmt.Errorf("failed to update password for user %s: %w", userID, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+	        UPDATE refresh_tokens
+	        SET revoked = true
+	        WHERE user_id = $1 AND revoked = false AND ($2::uuid IS NULL OR id <> $2)
+	    `, userID, keepTokenID); err != nil {
+			return fmt.Errorf("failed to revoke sessions for user %s: %w", userID, err)
+		}
+
+		return nil
+	})
+}
+
+func (r *AuthRepository) ListStaffProfilesByUser(ctx context.Context, userID uuid.UUID) ([]*auth.StaffProfile, error) {
+	query := `
+        SELECT id, user_id, business_id, location_id, role,
+               COALESCE(title, '') AS title,
+               COALESCE(department, '') AS department,
+               COALESCE(bio, '') AS bio,
+               hourly_rate AS legacy_hourly_rate, hourly_rate_enc,
+               COALESCE(status, '') AS status,
+               COALESCE(joined_at, created_at) AS joined_at,
+               created_at, updated_at
+        FROM staff_profiles
+        WHERE user_id = $1
+        ORDER BY created_at DESC
+    `
+	var rows []struct {
+		auth.StaffProfile
+		HourlyRateEnc    sql.NullString  `db:"hourly_rate_enc"`
+		LegacyHourlyRate sql.NullFloat64 `db:"legacy_hourly_rate"`
+	}
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list staff profiles for user %s: %w", userID, err)
+	}
+
+	profiles := make([]*auth.StaffProfile, 0, len(rows))
+	for i := range rows {
+		profile := rows[i].StaffProfile
+		rate, err := decryptHourlyRate(r.cipher, rows[i].HourlyRateEnc, rows[i].LegacyHourlyRate)
+		if err != nil {
+			return nil, err
+		}
+		profile.HourlyRate = rate
+		profiles = append(profiles, &profile)
+	}
+	return profiles, nil
+}
+
+// HasSoleOwnedBusiness - istifadəçinin co-owner-i olmayan biznesi varmı
+func (r *AuthRepository) HasSoleOwnedBusiness(ctx context.Context, userID uuid.UUID) (bool, error) {
+	query := `
+        SELECT EXISTS(
+            SELECT 1 FROM businesses b
+            WHERE b.owner_id = $1
+              AND NOT EXISTS (
+                  SELECT 1 FROM business_owners bo
+                  WHERE bo.business_id = b.id AND bo.role = 'co_owner'
+              )
+        )
+    `
+	var exists bool
+	if err := executor(ctx, r.db).GetContext(ctx, &exists, query, userID); err != nil {
+		return false, fmt.Errorf("failed to check sole ownership for user %s: %w", userID, err)
+	}
+	return exists, nil
+}
+
+// AnonymizeUser - şəxsi məlumatları silir, user sətrini tarixçə üçün saxlayır.
+// owner_id NULL olduqda trigger ən köhnə co-owner-i sahib edir.
+func (r *AuthRepository) AnonymizeUser(ctx context.Context, userID uuid.UUID, anonymizedEmail string, passwordHash string) error {
+	return NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+
+		statements := []struct {
+			query string
+			args  []interface{}
+		}{
+			{`DELETE FROM password_resets WHERE email = (SELECT email FROM users WHERE id = $1)`, []interface{}{userID}},
+			{`UPDATE business_invites
+	          SET invited_email = $2, invited_phone = NULL, updated_at = NOW()
+	          WHERE invited_email = (SELECT email FROM users WHERE id = $1)`, []interface{}{userID, anonymizedEmail}},
+			{`UPDATE ownership_transfers
+	          SET status = 'cancelled', updated_at = NOW()
+	          WHERE status = 'pending' AND (from_user_id = $1 OR to_user_id = $1)`, []interface{}{userID}},
+			{`UPDATE businesses SET owner_id = NULL, updated_at = NOW(), version = version + 1 WHERE owner_id = $1`, []interface{}{userID}},
+			{`DELETE FROM business_owners WHERE user_id = $1`, []interface{}{userID}},
+			{`UPDATE staff_profiles SET status = 'inactive', bio = '', updated_at = NOW(), version = version + 1 WHERE user_id = $1`, []interface{}{userID}},
+			{`UPDATE refresh_tokens SET revoked = true WHERE user_id = $1`, []interface{}{userID}},
+			{`DELETE FROM notifications WHERE user_id = $1`, []interface{}{userID}},
+			{`DELETE FROM notification_preferences WHERE user_id = $1`, []interface{}{userID}},
+			{`UPDATE users
+	          SET email = $2, full_name = 'Deleted user', phone = '', avatar = NULL,
+	              password_hash = $3, is_active = false, is_owner = false,
+	              email_verified = false, deleted_at = NOW(), updated_at = NOW()
+	          WHERE id = $1`, []interface{}{userID, anonymizedEmail, passwordHash}},
+		}
+
+		for _, stmt := range statements {
+			if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return fmt.Errorf("failed to anonymize user %s: %w", userID, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -6,7 +6,9 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -29,38 +31,51 @@ func (repository *BusinessRepository) Create(ctx context.Context, business *busi
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
-	_, err := repository.database.ExecContext(
-		ctx, query,
-		business.ID,
-		business.Name,
-		business.OwnerID,
-		business.Industry,
-		business.ServiceCategory,
-		business.Phone,
-		business.BusinessType,
-		business.IsActive,
-		business.CreatedAt,
-		business.UpdatedAt,
-	)
+	ownerQuery := `
+		INSERT INTO business_owners (business_id, user_id, role, created_at)
+		VALUES ($1, $2, 'owner', $3)
+	`
 
-	if err != nil {
-		return fmt.Errorf("postgres: failed to insert business: %w", err)
-	}
+	// Onboarding tranzaksiyası varsa ona qoşulur
+	return NewTxManager(repository.database).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, repository.database)
 
-	return nil
+		_, err := tx.ExecContext(
+			ctx, query,
+			business.ID,
+			business.Name,
+			business.OwnerID,
+			business.Industry,
+			business.ServiceCategory,
+			business.Phone,
+			business.BusinessType,
+			business.IsActive,
+			business.CreatedAt,
+			business.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("postgres: failed to insert business: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, ownerQuery, business.ID, business.OwnerID, business.CreatedAt); err != nil {
+			return fmt.Errorf("postgres: failed to insert business owner: %w", err)
+		}
+
+		return nil
+	})
 }
 
 func (repository *BusinessRepository) GetByID(ctx context.Context, id uuid.UUID) (*business.Business, error) {
 	query := `
 		SELECT 
 			id, name, owner_id, industry, service_category, 
-			phone, business_type, is_active, created_at, updated_at
+			phone, business_type, is_active, version, created_at, updated_at
 		FROM businesses
 		WHERE id = $1
 	`
 
 	var businessEntity business.Business
-	err := repository.database.GetContext(ctx, &businessEntity, query, id)
+	err := executor(ctx, repository.database).GetContext(ctx, &businessEntity, query, id)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -77,7 +92,7 @@ func (repository *BusinessRepository) GetByOwnerID(ctx context.Context, ownerID
 	query := `
 		SELECT 
 			id, name, owner_id, industry, service_category, 
-			phone, business_type, is_active, created_at, updated_at
+			phone, business_type, is_active, version, created_at, updated_at
 		FROM businesses
 		WHERE owner_id = $1 AND is_active = true
 		ORDER BY created_at DESC
@@ -85,7 +100,7 @@ func (repository *BusinessRepository) GetByOwnerID(ctx context.Context, ownerID
 	`
 
 	var businessEntity business.Business
-	err := repository.database.GetContext(ctx, &businessEntity, query, ownerID)
+	err := executor(ctx, repository.database).GetContext(ctx, &businessEntity, query, ownerID)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -98,6 +113,7 @@ func (repository *BusinessRepository) GetByOwnerID(ctx context.Context, ownerID
 	return &businessEntity, nil
 }
 
+// Update - yalnız oxunmuş versiya hələ də cari olduqda yazır, yeni versiyanı entity-yə qaytarır
 func (repository *BusinessRepository) Update(ctx context.Context, business *business.Business) error {
 	query := `
 		UPDATE businesses
@@ -105,48 +121,243 @@ func (repository *BusinessRepository) Update(ctx context.Context, business *busi
 			name = $1,
 			industry = $2,
 			phone = $3,
-			updated_at = $4
-		WHERE id = $5
+			updated_at = $4,
+			version = version + 1
+		WHERE id = $5 AND version = $6
+		RETURNING version
 	`
 
-	result, err := repository.database.ExecContext(
-		ctx, query,
+	err := executor(ctx, repository.database).GetContext(
+		ctx, &business.Version, query,
 		business.Name,
 		business.Industry,
 		business.Phone,
 		business.UpdatedAt,
 		business.ID,
+		business.Version,
 	)
 
+	if err == sql.ErrNoRows {
+		return apperr.ErrVersionMismatch
+	}
 	if err != nil {
 		return fmt.Errorf("postgres: failed to update business: %w", err)
 	}
 
+	return nil
+}
+
+func (repository *BusinessRepository) UpdateOwner(ctx context.Context, businessID, ownerID uuid.UUID) error {
+	query := `
+		UPDATE businesses
+		SET 
+			owner_id = $1,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = $2
+	`
+
+	result, err := executor(ctx, repository.database).ExecContext(ctx, query, ownerID, businessID)
+
+	if err != nil {
+		return fmt.Errorf("postgres: failed to update business owner: %w", err)
+	}
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return fmt.Errorf("postgres: failed to get rows affected: %w", err)
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("postgres: business not found for update")
+		return fmt.Errorf("postgres: business not found for owner update")
 	}
 
 	return nil
 }
 
-func (repository *BusinessRepository) UpdateOwner(ctx context.Context, businessID, ownerID uuid.UUID) error {
+func (repository *BusinessRepository) ListOwners(ctx context.Context, businessID uuid.UUID, query listing.Query) ([]*business.BusinessOwner, error) {
+	filter := newListQuery("bo.business_id = $1", businessID)
+	sql, args := filter.build(`
+		SELECT bo.business_id, bo.user_id, bo.role, bo.created_at,
+			u.full_name, u.email
+		FROM business_owners bo
+		JOIN users u ON bo.user_id = u.id`, query, listColumns{
+		id: "bo.user_id", name: "u.full_name", createdAt: "bo.created_at",
+		search: []string{"u.full_name", "u.email"},
+	})
+
+	var owners []*business.BusinessOwner
+	err := executor(ctx, repository.database).SelectContext(ctx, &owners, sql, args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list business owners: %w", err)
+	}
+
+	return owners, nil
+}
+
+func (repository *BusinessRepository) GetOwner(ctx context.Context, businessID, userID uuid.UUID) (*business.BusinessOwner, error) {
 	query := `
-		UPDATE businesses
-		SET 
-			owner_id = $1,
-			updated_at = NOW()
-		WHERE id = $2
+		SELECT bo.business_id, bo.user_id, bo.role, bo.created_at,
+			u.full_name, u.email
+		FROM business_owners bo
+		JOIN users u ON bo.user_id = u.id
+		WHERE bo.business_id = $1 AND bo.user_id = $2
 	`
 
-	result, err := repository.database.ExecContext(ctx, query, ownerID, businessID)
+	var owner business.BusinessOwner
+	err := executor(ctx, repository.database).GetContext(ctx, &owner, query, businessID, userID)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 
 	if err != nil {
-		return fmt.Errorf("postgres: failed to update business owner: %w", err)
+		return nil, fmt.Errorf("postgres: failed to get business owner: %w", err)
+	}
+
+	return &owner, nil
+}
+
+func (repository *BusinessRepository) AddCoOwner(ctx context.Context, businessID, userID uuid.UUID) error {
+	return NewTxManager(repository.database).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, repository.database)
+
+		query := `
+			INSERT INTO business_owners (business_id, user_id, role, created_at)
+			VALUES ($1, $2, 'co_owner', NOW())
+		`
+		if _, err := tx.ExecContext(ctx, query, businessID, userID); err != nil {
+			return fmt.Errorf("postgres: failed to insert co-owner: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE users SET is_owner = true, updated_at = NOW() WHERE id = $1`, userID); err != nil {
+			return fmt.Errorf("postgres: failed to mark user as owner: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (repository *BusinessRepository) RemoveCoOwner(ctx context.Context, businessID, userID uuid.UUID) error {
+	return NewTxManager(repository.database).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, repository.database)
+
+		query := `
+			DELETE FROM business_owners
+			WHERE business_id = $1 AND user_id = $2 AND role = 'co_owner'
+		`
+		result, err := tx.ExecContext(ctx, query, businessID, userID)
+		if err != nil {
+			return fmt.Errorf("postgres: failed to delete co-owner: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("postgres: failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("postgres: co-owner not found")
+		}
+
+		userQuery := `
+			UPDATE users
+			SET is_owner = EXISTS (SELECT 1 FROM business_owners WHERE user_id = $1),
+				updated_at = NOW()
+			WHERE id = $1
+		`
+		if _, err := tx.ExecContext(ctx, userQuery, userID); err != nil {
+			return fmt.Errorf("postgres: failed to update user owner flag: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (repository *BusinessRepository) CreateOwnershipTransfer(ctx context.Context, transfer *business.OwnershipTransfer) error {
+	query := `
+		INSERT INTO ownership_transfers (
+			id, business_id, from_user_id, to_user_id, token,
+			status, expires_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := executor(ctx, repository.database).ExecContext(
+		ctx, query,
+		transfer.ID,
+		transfer.BusinessID,
+		transfer.FromUserID,
+		transfer.ToUserID,
+		transfer.Token,
+		transfer.Status,
+		transfer.ExpiresAt,
+		transfer.CreatedAt,
+		transfer.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("postgres: failed to insert ownership transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (repository *BusinessRepository) GetOwnershipTransferByToken(ctx context.Context, token string) (*business.OwnershipTransfer, error) {
+	query := `
+		SELECT id, business_id, from_user_id, to_user_id, token,
+			status, expires_at, created_at, updated_at
+		FROM ownership_transfers
+		WHERE token = $1
+	`
+
+	var transfer business.OwnershipTransfer
+	err := executor(ctx, repository.database).GetContext(ctx, &transfer, query, token)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to get ownership transfer: %w", err)
+	}
+
+	return &transfer, nil
+}
+
+func (repository *BusinessRepository) GetPendingOwnershipTransfer(ctx context.Context, businessID uuid.UUID) (*business.OwnershipTransfer, error) {
+	query := `
+		SELECT id, business_id, from_user_id, to_user_id, token,
+			status, expires_at, created_at, updated_at
+		FROM ownership_transfers
+		WHERE business_id = $1 AND status = 'pending'
+	`
+
+	var transfer business.OwnershipTransfer
+	err := executor(ctx, repository.database).GetContext(ctx, &transfer, query, businessID)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to get pending ownership transfer: %w", err)
+	}
+
+	return &transfer, nil
+}
+
+func (repository *BusinessRepository) CancelOwnershipTransfer(ctx context.Context, id, businessID uuid.UUID) error {
+	query := `
+		UPDATE ownership_transfers
+		SET status = 'cancelled', updated_at = NOW()
+		WHERE id = $1 AND business_id = $2 AND status = 'pending'
+	`
+
+	result, err := executor(ctx, repository.database).ExecContext(ctx, query, id, businessID)
+
+	if err != nil {
+		return fmt.Errorf("postgres: failed to cancel ownership transfer: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -155,8 +366,89 @@ func (repository *BusinessRepository) UpdateOwner(ctx context.Context, businessI
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("postgres: business not found for owner update")
+		return fmt.Errorf("postgres: pending ownership transfer not found")
 	}
 
 	return nil
 }
+
+// CompleteOwnershipTransfer - sahibliyi bir tranzaksiyada dəyişir. Köhnə sahib adi işçi olur
+// (yenidən co-owner əlavə edilə bilər), hər iki tərəfin rolu dəyişir və refresh token-ləri
+// ləğv olunur ki, köhnə rol ilə yazılmış JWT yenilənə bilməsin.
+func (repository *BusinessRepository) CompleteOwnershipTransfer(ctx context.Context, transfer *business.OwnershipTransfer) error {
+	return NewTxManager(repository.database).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, repository.database)
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE businesses
+			SET owner_id = $1, updated_at = NOW(), version = version + 1
+			WHERE id = $2 AND owner_id = $3
+		`, transfer.ToUserID, transfer.BusinessID, transfer.FromUserID)
+		if err != nil {
+			return fmt.Errorf("postgres: failed to update business owner: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("postgres: failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return apperr.Conflict("TRANSFER_STALE", "Business owner has changed since the transfer was initiated")
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM business_owners
+			WHERE business_id = $1 AND user_id = $2
+		`, transfer.BusinessID, transfer.FromUserID); err != nil {
+			return fmt.Errorf("postgres: failed to demote previous owner: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO business_owners (business_id, user_id, role, created_at)
+			VALUES ($1, $2, 'owner', NOW())
+			ON CONFLICT (business_id, user_id) DO UPDATE SET role = 'owner'
+		`, transfer.BusinessID, transfer.ToUserID); err != nil {
+			return fmt.Errorf("postgres: failed to promote new owner: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE users
+			SET role = 'staff',
+				is_owner = EXISTS (SELECT 1 FROM business_owners WHERE user_id = $1),
+				updated_at = NOW()
+			WHERE id = $1
+		`, transfer.FromUserID); err != nil {
+			return fmt.Errorf("postgres: failed to update previous owner: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE users
+			SET role = CASE WHEN b.business_type = 'solo_practitioner' THEN 'solo_practitioner' ELSE 'provider_owner' END,
+				is_owner = true,
+				updated_at = NOW()
+			FROM businesses b
+			WHERE users.id = $1 AND b.id = $2
+		`, transfer.ToUserID, transfer.BusinessID); err != nil {
+			return fmt.Errorf("postgres: failed to update new owner: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE refresh_tokens
+			SET revoked = true
+			WHERE user_id IN ($1, $2) AND revoked = false
+		`, transfer.FromUserID, transfer.ToUserID); err != nil {
+			return fmt.Errorf("postgres: failed to revoke sessions after ownership transfer: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE ownership_transfers
+			SET status = 'accepted', updated_at = NOW()
+			WHERE id = $1
+		`, transfer.ID); err != nil {
+			return fmt.Errorf("postgres: failed to mark transfer as accepted: %w", err)
+		}
+
+		return nil
+	})
+}
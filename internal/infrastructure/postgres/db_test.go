@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"os"
+	"testing"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/config"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/crypto"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/jmoiron/sqlx"
+)
+
+// openTestDB - TEST_DATABASE_URL bazasını son miqrasiyaya qədər miqrasiya edib qaytarır.
+// Dəyişən qurulmayıbsa test keçirilir. sslmode göstərilməyibsə require istifadə olunur.
+func openTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	cfg := config.AppConfig{DbDsn: dsn, LogLevel: "error"}
+
+	appLogger, err := logger.New(&cfg)
+	if err != nil {
+		t.Fatalf("logger: %v", err)
+	}
+	if err := RunMigrations(cfg, appLogger); err != nil {
+		t.Fatalf("migrations: %v", err)
+	}
+
+	db, err := New(cfg)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestCipher(t *testing.T) *crypto.AESFieldCipher {
+	t.Helper()
+
+	cipher, err := crypto.NewAESFieldCipher(1, "test-encryption-key", nil)
+	if err != nil {
+		t.Fatalf("field cipher: %v", err)
+	}
+	return cipher
+}
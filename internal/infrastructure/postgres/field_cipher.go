@@ -0,0 +1,51 @@
+// File: internal/infrastructure/postgres/field_cipher.go
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+)
+
+// FieldCipher - həssas sütunların (PII) şifrələnməsi üçün port.
+// Şifrələnməmiş köhnə dəyərləri Decrypt olduğu kimi qaytarmalıdır.
+type FieldCipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(value string) (string, error)
+	CurrentPrefix() string
+}
+
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// decryptHourlyRate - şifrəli dəyər yoxdursa köhnə açıq hourly_rate sütununa düşür
+func decryptHourlyRate(cipher FieldCipher, encrypted sql.NullString, legacy sql.NullFloat64) (float64, error) {
+	if !encrypted.Valid || encrypted.String == "" {
+		if legacy.Valid {
+			return legacy.Float64, nil
+		}
+		return 0, nil
+	}
+
+	plain, err := cipher.Decrypt(encrypted.String)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decrypt hourly rate: %w", err)
+	}
+	rate, err := strconv.ParseFloat(plain, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse hourly rate: %w", err)
+	}
+	return rate, nil
+}
+
+func encryptHourlyRate(cipher FieldCipher, rate float64) (string, error) {
+	encrypted, err := cipher.Encrypt(strconv.FormatFloat(rate, 'f', 2, 64))
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt hourly rate: %w", err)
+	}
+	return encrypted, nil
+}
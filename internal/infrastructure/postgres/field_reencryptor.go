@@ -0,0 +1,317 @@
+// File: internal/infrastructure/postgres/field_reencryptor.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// FieldReencryptor - açar rotasiyasından sonra köhnə versiya ilə (və ya heç) şifrələnmiş
+// sətirləri cari açarla yenidən şifrələyir. Hər batch öz tranzaksiyasındadır.
+type FieldReencryptor struct {
+	db     *sqlx.DB
+	cipher FieldCipher
+}
+
+func NewFieldReencryptor(db *sqlx.DB, cipher FieldCipher) *FieldReencryptor {
+	return &FieldReencryptor{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+// ReencryptBatch - hər cədvəldən ən çox batchSize sətir emal edir, emal olunan sayı qaytarır
+func (r *FieldReencryptor) ReencryptBatch(ctx context.Context, batchSize int) (int, error) {
+	users, err := r.reencryptUserPhones(ctx, batchSize)
+	if err != nil {
+		return users, err
+	}
+	staff, err := r.reencryptHourlyRates(ctx, batchSize)
+	if err != nil {
+		return users + staff, err
+	}
+	secrets, err := r.reencryptWebhookSecrets(ctx, batchSize)
+	if err != nil {
+		return users + staff + secrets, err
+	}
+	notifications, err := r.reencryptNotifications(ctx, batchSize)
+	if err != nil {
+		return users + staff + secrets + notifications, err
+	}
+	responses, err := r.reencryptIdempotentResponses(ctx, batchSize)
+	return users + staff + secrets + notifications + responses, err
+}
+
+// Pending - cari açarla hələ şifrələnməmiş sətirlərin sayı (job queue depth)
+func (r *FieldReencryptor) Pending(ctx context.Context) (int, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM users
+			 WHERE phone IS NOT NULL AND phone <> '' AND phone NOT LIKE $1 || '%')
+		  + (SELECT COUNT(*) FROM staff_profiles
+			 WHERE hourly_rate IS NOT NULL
+			    OR (hourly_rate_enc IS NOT NULL AND hourly_rate_enc NOT LIKE $1 || '%'))
+		  + (SELECT COUNT(*) FROM webhook_endpoints WHERE secret_enc NOT LIKE $1 || '%')
+		  + (SELECT COUNT(*) FROM notifications
+			 WHERE recipient_enc NOT LIKE $1 || '%' OR data_enc NOT LIKE $1 || '%')
+		  + (SELECT COUNT(*) FROM idempotency_keys
+			 WHERE response_body IS NOT NULL OR response_body_enc NOT LIKE $1 || '%')
+	`
+	var pending int
+	if err := executor(ctx, r.db).GetContext(ctx, &pending, query, r.cipher.CurrentPrefix()); err != nil {
+		return 0, fmt.Errorf("failed to count rows pending re-encryption: %w", err)
+	}
+	return pending, nil
+}
+
+func (r *FieldReencryptor) reencryptUserPhones(ctx context.Context, batchSize int) (int, error) {
+	var processed int
+	err := NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+
+		var rows []struct {
+			ID    uuid.UUID `db:"id"`
+			Phone string    `db:"phone"`
+		}
+		query := `
+			SELECT id, phone
+			FROM users
+			WHERE phone IS NOT NULL AND phone <> '' AND phone NOT LIKE $1 || '%'
+			ORDER BY id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		`
+		if err := tx.SelectContext(ctx, &rows, query, r.cipher.CurrentPrefix(), batchSize); err != nil {
+			return fmt.Errorf("failed to select users for re-encryption: %w", err)
+		}
+
+		for _, row := range rows {
+			plain, err := r.cipher.Decrypt(row.Phone)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt phone for user %s: %w", row.ID, err)
+			}
+			encrypted, err := r.cipher.Encrypt(plain)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt phone for user %s: %w", row.ID, err)
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE users SET phone = $1 WHERE id = $2`,
+				encrypted, row.ID,
+			); err != nil {
+				return fmt.Errorf("failed to update phone for user %s: %w", row.ID, err)
+			}
+		}
+
+		processed = len(rows)
+		return nil
+	})
+	return processed, err
+}
+
+func (r *FieldReencryptor) reencryptHourlyRates(ctx context.Context, batchSize int) (int, error) {
+	var processed int
+	err := NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+
+		var rows []struct {
+			ID            uuid.UUID       `db:"id"`
+			HourlyRateEnc sql.NullString  `db:"hourly_rate_enc"`
+			HourlyRate    sql.NullFloat64 `db:"hourly_rate"`
+		}
+		query := `
+			SELECT id, hourly_rate_enc, hourly_rate
+			FROM staff_profiles
+			WHERE hourly_rate IS NOT NULL
+			   OR (hourly_rate_enc IS NOT NULL AND hourly_rate_enc NOT LIKE $1 || '%')
+			ORDER BY id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		`
+		if err := tx.SelectContext(ctx, &rows, query, r.cipher.CurrentPrefix(), batchSize); err != nil {
+			return fmt.Errorf("failed to select staff profiles for re-encryption: %w", err)
+		}
+
+		for _, row := range rows {
+			rate, err := decryptHourlyRate(r.cipher, row.HourlyRateEnc, row.HourlyRate)
+			if err != nil {
+				return fmt.Errorf("staff profile %s: %w", row.ID, err)
+			}
+			encrypted, err := encryptHourlyRate(r.cipher, rate)
+			if err != nil {
+				return fmt.Errorf("staff profile %s: %w", row.ID, err)
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE staff_profiles SET hourly_rate = NULL, hourly_rate_enc = $1 WHERE id = $2`,
+				encrypted, row.ID,
+			); err != nil {
+				return fmt.Errorf("failed to update hourly rate for staff profile %s: %w", row.ID, err)
+			}
+		}
+
+		processed = len(rows)
+		return nil
+	})
+	return processed, err
+}
+
+func (r *FieldReencryptor) reencryptWebhookSecrets(ctx context.Context, batchSize int) (int, error) {
+	var processed int
+	err := NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+
+		var rows []struct {
+			ID        uuid.UUID `db:"id"`
+			SecretEnc string    `db:"secret_enc"`
+		}
+		query := `
+			SELECT id, secret_enc
+			FROM webhook_endpoints
+			WHERE secret_enc NOT LIKE $1 || '%'
+			ORDER BY id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		`
+		if err := tx.SelectContext(ctx, &rows, query, r.cipher.CurrentPrefix(), batchSize); err != nil {
+			return fmt.Errorf("failed to select webhook endpoints for re-encryption: %w", err)
+		}
+
+		for _, row := range rows {
+			plain, err := r.cipher.Decrypt(row.SecretEnc)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt secret for webhook endpoint %s: %w", row.ID, err)
+			}
+			encrypted, err := r.cipher.Encrypt(plain)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt secret for webhook endpoint %s: %w", row.ID, err)
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE webhook_endpoints SET secret_enc = $1 WHERE id = $2`,
+				encrypted, row.ID,
+			); err != nil {
+				return fmt.Errorf("failed to update secret for webhook endpoint %s: %w", row.ID, err)
+			}
+		}
+
+		processed = len(rows)
+		return nil
+	})
+	return processed, err
+}
+
+func (r *FieldReencryptor) reencryptNotifications(ctx context.Context, batchSize int) (int, error) {
+	var processed int
+	err := NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+
+		var rows []struct {
+			ID           uuid.UUID      `db:"id"`
+			RecipientEnc string         `db:"recipient_enc"`
+			DataEnc      sql.NullString `db:"data_enc"`
+		}
+		query := `
+			SELECT id, recipient_enc, data_enc
+			FROM notifications
+			WHERE recipient_enc NOT LIKE $1 || '%' OR data_enc NOT LIKE $1 || '%'
+			ORDER BY id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		`
+		if err := tx.SelectContext(ctx, &rows, query, r.cipher.CurrentPrefix(), batchSize); err != nil {
+			return fmt.Errorf("failed to select notifications for re-encryption: %w", err)
+		}
+
+		for _, row := range rows {
+			recipient, err := r.reencrypt(row.RecipientEnc)
+			if err != nil {
+				return fmt.Errorf("notification %s recipient: %w", row.ID, err)
+			}
+			var data *string
+			if row.DataEnc.Valid {
+				encrypted, err := r.reencrypt(row.DataEnc.String)
+				if err != nil {
+					return fmt.Errorf("notification %s data: %w", row.ID, err)
+				}
+				data = &encrypted
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE notifications SET recipient_enc = $1, data_enc = $2 WHERE id = $3`,
+				recipient, data, row.ID,
+			); err != nil {
+				return fmt.Errorf("failed to update notification %s: %w", row.ID, err)
+			}
+		}
+
+		processed = len(rows)
+		return nil
+	})
+	return processed, err
+}
+
+// reencryptIdempotentResponses - köhnə açıq response_body-ni də response_body_enc-ə köçürür
+func (r *FieldReencryptor) reencryptIdempotentResponses(ctx context.Context, batchSize int) (int, error) {
+	var processed int
+	err := NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+
+		var rows []struct {
+			UserID          uuid.UUID      `db:"user_id"`
+			Key             string         `db:"key"`
+			ResponseBody    []byte         `db:"response_body"`
+			ResponseBodyEnc sql.NullString `db:"response_body_enc"`
+		}
+		query := `
+			SELECT user_id, key, response_body, response_body_enc
+			FROM idempotency_keys
+			WHERE response_body IS NOT NULL OR response_body_enc NOT LIKE $1 || '%'
+			ORDER BY user_id, key
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		`
+		if err := tx.SelectContext(ctx, &rows, query, r.cipher.CurrentPrefix(), batchSize); err != nil {
+			return fmt.Errorf("failed to select idempotency keys for re-encryption: %w", err)
+		}
+
+		for _, row := range rows {
+			var body string
+			if row.ResponseBodyEnc.Valid {
+				plain, err := r.cipher.Decrypt(row.ResponseBodyEnc.String)
+				if err != nil {
+					return fmt.Errorf("failed to decrypt response for idempotency key %s: %w", row.Key, err)
+				}
+				body = plain
+			} else {
+				body = string(row.ResponseBody)
+			}
+			encrypted, err := r.cipher.Encrypt(body)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt response for idempotency key %s: %w", row.Key, err)
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE idempotency_keys SET response_body = NULL, response_body_enc = $1 WHERE user_id = $2 AND key = $3`,
+				encrypted, row.UserID, row.Key,
+			); err != nil {
+				return fmt.Errorf("failed to update response for idempotency key %s: %w", row.Key, err)
+			}
+		}
+
+		processed = len(rows)
+		return nil
+	})
+	return processed, err
+}
+
+func (r *FieldReencryptor) reencrypt(value string) (string, error) {
+	plain, err := r.cipher.Decrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt: %w", err)
+	}
+	encrypted, err := r.cipher.Encrypt(plain)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt: %w", err)
+	}
+	return encrypted, nil
+}
@@ -0,0 +1,88 @@
+// File: internal/infrastructure/postgres/heartbeat_repo.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// HeartbeatMaxAge - worker hər poll intervalında (10s) siqnal göndərir; 3 buraxılmış siqnal ölü sayılır
+const HeartbeatMaxAge = 30 * time.Second
+
+// WorkerHeartbeat - worker prosesinin son canlılıq siqnalı
+type WorkerHeartbeat struct {
+	WorkerID     string    `db:"worker_id"`
+	Hostname     string    `db:"hostname"`
+	JobsInFlight int       `db:"jobs_in_flight"`
+	StartedAt    time.Time `db:"started_at"`
+	LastSeenAt   time.Time `db:"last_seen_at"`
+}
+
+type HeartbeatRepository struct {
+	db *sqlx.DB
+}
+
+func NewHeartbeatRepository(db *sqlx.DB) *HeartbeatRepository {
+	return &HeartbeatRepository{db: db}
+}
+
+func (r *HeartbeatRepository) Beat(ctx context.Context, hb *WorkerHeartbeat) error {
+	query := `
+		INSERT INTO worker_heartbeats (worker_id, hostname, jobs_in_flight, started_at, last_seen_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (worker_id) DO UPDATE
+		SET jobs_in_flight = EXCLUDED.jobs_in_flight,
+		    last_seen_at = NOW()
+	`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, hb.WorkerID, hb.Hostname, hb.JobsInFlight, hb.StartedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record worker heartbeat: %w", err)
+	}
+	return nil
+}
+
+func (r *HeartbeatRepository) Remove(ctx context.Context, workerID string) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM worker_heartbeats WHERE worker_id = $1`, workerID)
+	if err != nil {
+		return fmt.Errorf("failed to remove worker heartbeat: %w", err)
+	}
+	return nil
+}
+
+// Latest - ən son siqnal göndərən worker; heç biri yoxdursa nil
+func (r *HeartbeatRepository) Latest(ctx context.Context) (*WorkerHeartbeat, error) {
+	query := `
+		SELECT worker_id, hostname, jobs_in_flight, started_at, last_seen_at
+		FROM worker_heartbeats
+		ORDER BY last_seen_at DESC
+		LIMIT 1
+	`
+	var hb WorkerHeartbeat
+	if err := executor(ctx, r.db).GetContext(ctx, &hb, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest worker heartbeat: %w", err)
+	}
+	return &hb, nil
+}
+
+// CheckAlive - job queue readiness: son maxAge ərzində ən azı bir worker siqnal göndərməlidir
+func (r *HeartbeatRepository) CheckAlive(ctx context.Context, maxAge time.Duration) error {
+	hb, err := r.Latest(ctx)
+	if err != nil {
+		return err
+	}
+	if hb == nil {
+		return fmt.Errorf("no worker heartbeat recorded")
+	}
+	if age := time.Since(hb.LastSeenAt); age > maxAge {
+		return fmt.Errorf("last worker heartbeat %s ago (worker %s)", age.Round(time.Second), hb.WorkerID)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// File: internal/infrastructure/postgres/idempotency_repo.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/idempotency"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// IdempotencyRepository - cavab gövdəsi (token-lər ola bilər) FieldCipher ilə şifrələnir
+type IdempotencyRepository struct {
+	db     *sqlx.DB
+	cipher FieldCipher
+}
+
+func NewIdempotencyRepository(db *sqlx.DB, cipher FieldCipher) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db, cipher: cipher}
+}
+
+type idempotencyRow struct {
+	UserID          uuid.UUID      `db:"user_id"`
+	Key             string         `db:"key"`
+	Method          string         `db:"method"`
+	Path            string         `db:"path"`
+	Fingerprint     string         `db:"fingerprint"`
+	StatusCode      sql.NullInt32  `db:"status_code"`
+	ResponseHeaders []byte         `db:"response_headers"`
+	ResponseBody    []byte         `db:"response_body"`
+	ResponseBodyEnc sql.NullString `db:"response_body_enc"`
+	CreatedAt       time.Time      `db:"created_at"`
+	ExpiresAt       time.Time      `db:"expires_at"`
+}
+
+// Acquire - açarı INSERT ilə rezerv edir. Vaxtı keçmiş və ya çökmüş sorğudan qalan qeyd üzərinə yazılır.
+// Rezerv alınmadıqda mövcud qeyd oxunur; o arada silinibsə bir dəfə yenidən cəhd olunur.
+func (r *IdempotencyRepository) Acquire(ctx context.Context, record *idempotency.Record) (*idempotency.Record, bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (user_id, key, method, path, fingerprint, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, key) DO UPDATE
+		SET method = EXCLUDED.method,
+		    path = EXCLUDED.path,
+		    fingerprint = EXCLUDED.fingerprint,
+		    status_code = NULL,
+		    response_headers = NULL,
+		    response_body = NULL,
+		    response_body_enc = NULL,
+		    created_at = EXCLUDED.created_at,
+		    expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+		   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.created_at < $8)
+		RETURNING user_id
+	`
+	for attempt := 0; attempt < 2; attempt++ {
+		var userID uuid.UUID
+		err := executor(ctx, r.db).GetContext(ctx, &userID, query,
+			record.UserID, record.Key, record.Method, record.Path, record.Fingerprint,
+			record.CreatedAt, record.ExpiresAt, record.CreatedAt.Add(-idempotency.LockTimeout),
+		)
+		if err == nil {
+			return nil, true, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, false, fmt.Errorf("failed to acquire idempotency key: %w", err)
+		}
+
+		existing, err := r.get(ctx, record.UserID, record.Key)
+		if err != nil {
+			return nil, false, err
+		}
+		if existing != nil {
+			return existing, false, nil
+		}
+	}
+	return nil, false, idempotency.ErrInProgress
+}
+
+func (r *IdempotencyRepository) get(ctx context.Context, userID uuid.UUID, key string) (*idempotency.Record, error) {
+	query := `
+		SELECT user_id, key, method, path, fingerprint, status_code,
+		       response_headers, response_body, response_body_enc, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2
+	`
+	var row idempotencyRow
+	err := executor(ctx, r.db).GetContext(ctx, &row, query, userID, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	record := &idempotency.Record{
+		UserID:      row.UserID,
+		Key:         row.Key,
+		Method:      row.Method,
+		Path:        row.Path,
+		Fingerprint: row.Fingerprint,
+		CreatedAt:   row.CreatedAt,
+		ExpiresAt:   row.ExpiresAt,
+	}
+	if row.StatusCode.Valid {
+		body, err := r.decryptBody(row.ResponseBodyEnc, row.ResponseBody)
+		if err != nil {
+			return nil, err
+		}
+		record.Response = &idempotency.Response{
+			StatusCode: int(row.StatusCode.Int32),
+			Body:       body,
+		}
+		if len(row.ResponseHeaders) > 0 {
+			if err := json.Unmarshal(row.ResponseHeaders, &record.Response.Headers); err != nil {
+				return nil, fmt.Errorf("failed to decode idempotent response headers: %w", err)
+			}
+		}
+	}
+	return record, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, userID uuid.UUID, key string, response *idempotency.Response) error {
+	headers, err := json.Marshal(response.Headers)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotent response headers: %w", err)
+	}
+	body, err := r.cipher.Encrypt(string(response.Body))
+	if err != nil {
+		return fmt.Errorf("failed to encrypt idempotent response body: %w", err)
+	}
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $3, response_headers = $4, response_body = NULL, response_body_enc = $5
+		WHERE user_id = $1 AND key = $2
+	`
+	_, err = executor(ctx, r.db).ExecContext(ctx, query, userID, key, response.StatusCode, headers, body)
+	if err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+// decryptBody - şifrəli gövdə yoxdursa köhnə açıq response_body sütununa düşür
+func (r *IdempotencyRepository) decryptBody(encrypted sql.NullString, legacy []byte) ([]byte, error) {
+	if !encrypted.Valid {
+		return legacy, nil
+	}
+	plain, err := r.cipher.Decrypt(encrypted.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt idempotent response body: %w", err)
+	}
+	return []byte(plain), nil
+}
+
+func (r *IdempotencyRepository) Release(ctx context.Context, userID uuid.UUID, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND status_code IS NULL`
+	if _, err := executor(ctx, r.db).ExecContext(ctx, query, userID, key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted idempotency keys: %w", err)
+	}
+	return int(deleted), nil
+}
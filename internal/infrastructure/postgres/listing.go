@@ -0,0 +1,83 @@
+// File: internal/infrastructure/postgres/listing.go
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+)
+
+// listColumns - siyahı sorğusunda sort, cursor və axtarış üçün istifadə olunan sütunlar
+type listColumns struct {
+	id        string
+	name      string
+	createdAt string
+	search    []string
+}
+
+// listQuery - WHERE şərtlərini toplayır, sonda axtarış, cursor, ORDER BY və LIMIT əlavə edir
+type listQuery struct {
+	conditions []string
+	args       []interface{}
+}
+
+func newListQuery(condition string, args ...interface{}) *listQuery {
+	return &listQuery{conditions: []string{condition}, args: args}
+}
+
+// where - şərtdəki "?" yer tutucuları $N ilə əvəz olunur
+func (q *listQuery) where(condition string, args ...interface{}) {
+	for _, arg := range args {
+		q.args = append(q.args, arg)
+		condition = strings.Replace(condition, "?", fmt.Sprintf("$%d", len(q.args)), 1)
+	}
+	q.conditions = append(q.conditions, condition)
+}
+
+func (q *listQuery) build(selectFrom string, query listing.Query, cols listColumns) (string, []interface{}) {
+	if query.Search != "" && len(cols.search) > 0 {
+		matches := make([]string, len(cols.search))
+		for i, col := range cols.search {
+			matches[i] = col + " ILIKE ? ESCAPE '\\'"
+		}
+		pattern := "%" + escapeLike(query.Search) + "%"
+		args := make([]interface{}, len(cols.search))
+		for i := range args {
+			args[i] = pattern
+		}
+		q.where("("+strings.Join(matches, " OR ")+")", args...)
+	}
+
+	sortCol := cols.createdAt
+	if query.Sort == listing.SortName {
+		sortCol = cols.name
+	}
+	direction, comparison := "DESC", "<"
+	if query.Order == listing.OrderAsc {
+		direction, comparison = "ASC", ">"
+	}
+
+	if query.Cursor != nil {
+		var value interface{} = query.Cursor.Value
+		if query.Sort == listing.SortCreatedAt {
+			q.where(fmt.Sprintf("(%s, %s) %s (?::timestamptz, ?)", sortCol, cols.id, comparison), value, query.Cursor.ID)
+		} else {
+			q.where(fmt.Sprintf("(%s, %s) %s (?, ?)", sortCol, cols.id, comparison), value, query.Cursor.ID)
+		}
+	}
+
+	sql := fmt.Sprintf("%s WHERE %s ORDER BY %s %s, %s %s LIMIT %d",
+		selectFrom, strings.Join(q.conditions, " AND "),
+		sortCol, direction, cols.id, direction,
+		query.Limit+1,
+	)
+	if query.Cursor == nil && query.Offset > 0 {
+		sql += fmt.Sprintf(" OFFSET %d", query.Offset)
+	}
+	return sql, q.args
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
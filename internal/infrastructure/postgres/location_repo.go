@@ -6,6 +6,8 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/location"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -27,7 +29,7 @@ func (r *LocationRepository) Create(ctx context.Context, loc *location.Location)
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := executor(ctx, r.db).ExecContext(
 		ctx, query,
 		loc.ID, loc.BusinessID, loc.Name, loc.Address, loc.City, loc.Phone,
 		loc.IsActive, loc.CreatedAt, loc.UpdatedAt,
@@ -43,13 +45,13 @@ func (r *LocationRepository) Create(ctx context.Context, loc *location.Location)
 func (r *LocationRepository) GetByID(ctx context.Context, id, businessID uuid.UUID) (*location.Location, error) {
 	query := `
 		SELECT id, business_id, name, address, city, phone, 
-			   is_active, created_at, updated_at
+			   is_active, version, created_at, updated_at
 		FROM locations
 		WHERE id = $1 AND business_id = $2 AND is_active = true
 	`
 
 	var loc location.Location
-	err := r.db.GetContext(ctx, &loc, query, id, businessID)
+	err := executor(ctx, r.db).GetContext(ctx, &loc, query, id, businessID)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -61,17 +63,23 @@ func (r *LocationRepository) GetByID(ctx context.Context, id, businessID uuid.UU
 	return &loc, nil
 }
 
-func (r *LocationRepository) ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*location.Location, error) {
-	query := `
+// ListByBusiness - limit+1 sətir qaytarır, səhifələmə domain qatında edilir
+func (r *LocationRepository) ListByBusiness(ctx context.Context, businessID uuid.UUID, q listing.Query) ([]*location.Location, error) {
+	list := newListQuery("business_id = $1", businessID)
+	switch q.Status {
+	case "", location.StatusActive:
+		list.where("is_active = true")
+	case location.StatusInactive:
+		list.where("is_active = false")
+	}
+
+	query, args := list.build(`
 		SELECT id, business_id, name, address, city, phone, 
-			   is_active, created_at, updated_at
-		FROM locations
-		WHERE business_id = $1 AND is_active = true
-		ORDER BY created_at DESC
-	`
+			   is_active, version, created_at, updated_at
+		FROM locations`, q, listColumns{id: "id", name: "name", createdAt: "created_at", search: []string{"name"}})
 
 	var locations []*location.Location
-	err := r.db.SelectContext(ctx, &locations, query, businessID)
+	err := executor(ctx, r.db).SelectContext(ctx, &locations, query, args...)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to list locations: %w", err)
@@ -83,36 +91,36 @@ func (r *LocationRepository) ListByBusiness(ctx context.Context, businessID uuid
 func (r *LocationRepository) Update(ctx context.Context, loc *location.Location) error {
 	query := `
 		UPDATE locations
-		SET name = $1, address = $2, city = $3, phone = $4, updated_at = $5
-		WHERE id = $6 AND business_id = $7
+		SET name = $1, address = $2, city = $3, phone = $4, updated_at = $5,
+			version = version + 1
+		WHERE id = $6 AND business_id = $7 AND version = $8
+		RETURNING version
 	`
 
-	result, err := r.db.ExecContext(
-		ctx, query,
+	err := executor(ctx, r.db).GetContext(
+		ctx, &loc.Version, query,
 		loc.Name, loc.Address, loc.City, loc.Phone, loc.UpdatedAt,
-		loc.ID, loc.BusinessID,
+		loc.ID, loc.BusinessID, loc.Version,
 	)
 
+	if err == sql.ErrNoRows {
+		return apperr.ErrVersionMismatch
+	}
 	if err != nil {
 		return fmt.Errorf("failed to update location: %w", err)
 	}
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return fmt.Errorf("location not found")
-	}
-
 	return nil
 }
 
 func (r *LocationRepository) Deactivate(ctx context.Context, id, businessID uuid.UUID) error {
 	query := `
 		UPDATE locations
-		SET is_active = false, updated_at = NOW()
+		SET is_active = false, updated_at = NOW(), version = version + 1
 		WHERE id = $1 AND business_id = $2
 	`
 
-	result, err := r.db.ExecContext(ctx, query, id, businessID)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id, businessID)
 
 	if err != nil {
 		return fmt.Errorf("failed to deactivate location: %w", err)
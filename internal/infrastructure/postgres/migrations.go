@@ -2,17 +2,20 @@
 package postgres
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
-	"runtime"
+	"io/fs"
+	"sort"
 	"strings"
 
 	"github.com/OrkhanNajaf1i/booking-service/internal/config"
 	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/OrkhanNajaf1i/booking-service/migrations"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 )
 
 // buildMigrationDSN:
@@ -56,44 +59,159 @@ func buildMigrationDSN(cfg config.AppConfig) string {
 	return dsn
 }
 
-func RunMigrations(cfg config.AppConfig, appLogger logger.Logger) error {
-	_, currentFile, _, _ := runtime.Caller(0)
-	baseDir := filepath.Dir(currentFile)
-	projectRoot := filepath.Join(baseDir, "..", "..", "..")
-	migrationsDir := filepath.Join(projectRoot, "migrations")
+// MigrationStatus - tətbiq olunmuş və gözləyən miqrasiyalar
+type MigrationStatus struct {
+	Version uint
+	Dirty   bool
+	Applied bool
+	Latest  uint
+	Pending []uint
+}
 
-	appLogger.Info("Migration path check", logger.Field{Key: "dir", Value: migrationsDir})
+// Migrator - embed olunmuş miqrasiyaları icra edir. Eyni anda başlayan API/worker/migrate
+// prosesləri golang-migrate-in öz advisory lock-u ilə sıraya düzülür. Bu lock sessiya
+// səviyyəsindədir: transaction-mode pooler (PgBouncer, Supabase 6543) arxasında qorumur,
+// ona görə miqrasiya üçün birbaşa (və ya session-mode) DSN istifadə edilməlidir.
+type Migrator struct {
+	m        *migrate.Migrate
+	versions []uint
+	logger   logger.Logger
+}
 
-	if _, err := os.Stat(migrationsDir); os.IsNotExist(err) {
-		return fmt.Errorf("migrations directory not found: %s (create it or move files)", migrationsDir)
+func NewMigrator(cfg config.AppConfig, appLogger logger.Logger) (*Migrator, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
 	}
 
-	migrationsPath := "file://" + filepath.ToSlash(migrationsDir)
+	versions, err := migrationVersions()
+	if err != nil {
+		return nil, err
+	}
 
-	// ✅ FIX: DSN-i düzgün seçirik
 	dsn := buildMigrationDSN(cfg)
-	appLogger.Info("Migration DSN", logger.Field{Key: "dsn", Value: dsn})
-
-	m, err := migrate.New(migrationsPath, dsn)
+	m, err := migrate.NewWithSourceInstance("iofs", src, dsn)
 	if err != nil {
-		return fmt.Errorf("failed to create migrate instance: %w", err)
+		return nil, fmt.Errorf("failed to create migrate instance: %w", err)
 	}
-	defer m.Close()
-
-	version, dirty, err := m.Version()
-	if err != nil && err != migrate.ErrNilVersion {
-		appLogger.Warn("Could not get migration version", logger.Field{Key: "error", Value: err.Error()})
-	} else if err == nil {
-		appLogger.Info("Current migration version",
-			logger.Field{Key: "version", Value: version},
-			logger.Field{Key: "dirty", Value: dirty},
-		)
+
+	return &Migrator{
+		m:        m,
+		versions: versions,
+		logger:   appLogger,
+	}, nil
+}
+
+func (mg *Migrator) Close() {
+	mg.m.Close()
+}
+
+// Up - bütün gözləyən miqrasiyaları tətbiq edir
+func (mg *Migrator) Up(ctx context.Context) error {
+	return mg.run("up", func() error {
+		return ignoreNoChange(mg.m.Up())
+	})
+}
+
+// Down - son n miqrasiyanı geri qaytarır
+func (mg *Migrator) Down(ctx context.Context, n int) error {
+	if n <= 0 {
+		return fmt.Errorf("down requires a positive number of steps")
+	}
+	return mg.run("down", func() error {
+		return ignoreNoChange(mg.m.Steps(-n))
+	})
+}
+
+// Goto - verilmiş versiyaya qədər irəli və ya geri gedir
+func (mg *Migrator) Goto(ctx context.Context, version uint) error {
+	return mg.run("goto", func() error {
+		return ignoreNoChange(mg.m.Migrate(version))
+	})
+}
+
+// Force - dirty vəziyyətdən çıxmaq üçün versiyanı miqrasiya icra etmədən yazır
+func (mg *Migrator) Force(ctx context.Context, version int) error {
+	return mg.run("force", func() error {
+		return mg.m.Force(version)
+	})
+}
+
+func (mg *Migrator) Status() (*MigrationStatus, error) {
+	status := &MigrationStatus{}
+	if len(mg.versions) > 0 {
+		status.Latest = mg.versions[len(mg.versions)-1]
+	}
+
+	version, dirty, err := mg.m.Version()
+	switch {
+	case errors.Is(err, migrate.ErrNilVersion):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read migration version: %w", err)
+	default:
+		status.Version = version
+		status.Dirty = dirty
+		status.Applied = true
+	}
+
+	for _, v := range mg.versions {
+		if !status.Applied || v > status.Version {
+			status.Pending = append(status.Pending, v)
+		}
 	}
+	return status, nil
+}
 
-	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-		return fmt.Errorf("migration failed: %w", err)
+func (mg *Migrator) run(op string, fn func() error) error {
+	mg.logger.Info("Running migrations", logger.Field{Key: "op", Value: op})
+	if err := fn(); err != nil {
+		return fmt.Errorf("migration %s failed: %w", op, err)
 	}
 
-	appLogger.Info("Migrations completed successfully")
+	if status, err := mg.Status(); err == nil {
+		mg.logger.Info("Migration finished",
+			logger.Field{Key: "op", Value: op},
+			logger.Field{Key: "version", Value: status.Version},
+			logger.Field{Key: "dirty", Value: status.Dirty},
+		)
+	}
 	return nil
 }
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
+
+func migrationVersions() ([]uint, error) {
+	entries, err := fs.ReadDir(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	seen := make(map[uint]bool)
+	var versions []uint
+	for _, entry := range entries {
+		m, err := source.DefaultParse(entry.Name())
+		if err != nil || seen[m.Version] {
+			continue
+		}
+		seen[m.Version] = true
+		versions = append(versions, m.Version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+// RunMigrations - API və worker başlayanda istifadə olunur (APP_AUTO_MIGRATE)
+func RunMigrations(cfg config.AppConfig, appLogger logger.Logger) error {
+	migrator, err := NewMigrator(cfg, appLogger)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	return migrator.Up(context.Background())
+}
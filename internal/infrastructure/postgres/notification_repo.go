@@ -0,0 +1,278 @@
+// File: internal/infrastructure/postgres/notification_repo.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/notification"
+	"github.com/OrkhanNajaf1i/booking-service/internal/i18n"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// NotificationRepository - alıcı ünvanı və şablon dəyişənləri FieldCipher ilə şifrələnir
+type NotificationRepository struct {
+	db     *sqlx.DB
+	cipher FieldCipher
+}
+
+func NewNotificationRepository(db *sqlx.DB, cipher FieldCipher) *NotificationRepository {
+	return &NotificationRepository{db: db, cipher: cipher}
+}
+
+type notificationRow struct {
+	ID           uuid.UUID      `db:"id"`
+	UserID       *uuid.UUID     `db:"user_id"`
+	Template     string         `db:"template"`
+	Channel      string         `db:"channel"`
+	Locale       string         `db:"locale"`
+	RecipientEnc string         `db:"recipient_enc"`
+	DataEnc      sql.NullString `db:"data_enc"`
+	Status       string         `db:"status"`
+	Attempts     int            `db:"attempts"`
+	ScheduledAt  time.Time      `db:"scheduled_at"`
+	LastError    sql.NullString `db:"last_error"`
+	SentAt       *time.Time     `db:"sent_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+const notificationColumns = `
+	id, user_id, template, channel, locale, recipient_enc, data_enc, status, attempts,
+	scheduled_at, last_error, sent_at, created_at, updated_at`
+
+func (row notificationRow) toNotification() *notification.Notification {
+	return &notification.Notification{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Template:    notification.Template(row.Template),
+		Channel:     notification.Channel(row.Channel),
+		Locale:      i18n.Locale(row.Locale),
+		Status:      notification.Status(row.Status),
+		Attempts:    row.Attempts,
+		ScheduledAt: row.ScheduledAt,
+		LastError:   row.LastError.String,
+		SentAt:      row.SentAt,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// decrypt - yalnız göndərmə üçün götürülən sətirlərdə ünvan və dəyişənlər açılır
+func (r *NotificationRepository) decrypt(row notificationRow) (*notification.Notification, error) {
+	result := row.toNotification()
+	recipient, err := r.cipher.Decrypt(row.RecipientEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt notification recipient: %w", err)
+	}
+	result.Recipient = recipient
+	if row.DataEnc.Valid {
+		plain, err := r.cipher.Decrypt(row.DataEnc.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt notification data: %w", err)
+		}
+		if err := json.Unmarshal([]byte(plain), &result.Data); err != nil {
+			return nil, fmt.Errorf("failed to decode notification data: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// encryptData - boş Data NULL kimi yazılır
+func (r *NotificationRepository) encryptData(data map[string]string) (*string, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	plain, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode notification data: %w", err)
+	}
+	encrypted, err := r.cipher.Encrypt(string(plain))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt notification data: %w", err)
+	}
+	return &encrypted, nil
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	recipientEnc, err := r.cipher.Encrypt(n.Recipient)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt notification recipient: %w", err)
+	}
+	dataEnc, err := r.encryptData(n.Data)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO notifications (id, user_id, template, channel, locale, recipient_enc, data_enc,
+		                           status, attempts, scheduled_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	_, err = executor(ctx, r.db).ExecContext(ctx, query,
+		n.ID, n.UserID, string(n.Template), string(n.Channel), string(n.Locale), recipientEnc, dataEnc,
+		string(n.Status), n.Attempts, n.ScheduledAt, n.CreatedAt, n.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert notification: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) ClaimDue(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+	now time.Time,
+) ([]*notification.Notification, error) {
+	query := `
+		UPDATE notifications
+		SET locked_until = $2
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status = 'pending'
+			  AND scheduled_at <= $1
+			  AND (locked_until IS NULL OR locked_until <= $1)
+			ORDER BY scheduled_at, id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING` + notificationColumns
+	var rows []notificationRow
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, now, now.Add(lease), limit); err != nil {
+		return nil, fmt.Errorf("failed to claim notifications: %w", err)
+	}
+
+	notifications := make([]*notification.Notification, 0, len(rows))
+	for _, row := range rows {
+		n, err := r.decrypt(row)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	// RETURNING sırası zəmanətli deyil
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].ScheduledAt.Before(notifications[j].ScheduledAt)
+	})
+	return notifications, nil
+}
+
+func (r *NotificationRepository) SaveAttempt(ctx context.Context, n *notification.Notification) error {
+	dataEnc, err := r.encryptData(n.Data)
+	if err != nil {
+		return err
+	}
+	query := `
+		UPDATE notifications
+		SET status = $2, attempts = $3, scheduled_at = $4, locked_until = NULL,
+		    last_error = $5, sent_at = $6, data_enc = $7, updated_at = $8
+		WHERE id = $1
+	`
+	_, err = executor(ctx, r.db).ExecContext(ctx, query,
+		n.ID, string(n.Status), n.Attempts, n.ScheduledAt,
+		nullIfEmpty(n.LastError), n.SentAt, dataEnc, n.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save notification attempt: %w", err)
+	}
+	return nil
+}
+
+// PendingNotifications - göndərilməyi gözləyən bildirişlər (job_queue_depth metriki)
+func (r *NotificationRepository) PendingNotifications(ctx context.Context) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM notifications WHERE status = 'pending'`
+	if err := executor(ctx, r.db).GetContext(ctx, &count, query); err != nil {
+		return 0, fmt.Errorf("failed to count pending notifications: %w", err)
+	}
+	return count, nil
+}
+
+func (r *NotificationRepository) ListByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	q listing.Query,
+) ([]*notification.Notification, error) {
+	list := newListQuery("user_id = $1", userID)
+	if q.Status != "" && q.Status != listing.StatusAll {
+		list.where("status = ?", q.Status)
+	}
+
+	query, args := list.build(`SELECT`+notificationColumns+` FROM notifications`, q,
+		listColumns{id: "id", createdAt: "created_at"})
+
+	var rows []notificationRow
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	notifications := make([]*notification.Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, row.toNotification())
+	}
+	return notifications, nil
+}
+
+type notificationPreferencesRow struct {
+	UserID          uuid.UUID      `db:"user_id"`
+	EmailEnabled    bool           `db:"email_enabled"`
+	SMSEnabled      bool           `db:"sms_enabled"`
+	QuietHoursStart sql.NullString `db:"quiet_hours_start"`
+	QuietHoursEnd   sql.NullString `db:"quiet_hours_end"`
+	Timezone        string         `db:"timezone"`
+	UpdatedAt       time.Time      `db:"updated_at"`
+}
+
+func (r *NotificationRepository) GetPreferences(ctx context.Context, userID uuid.UUID) (*notification.Preferences, error) {
+	var row notificationPreferencesRow
+	query := `
+		SELECT user_id, email_enabled, sms_enabled, quiet_hours_start, quiet_hours_end, timezone, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+	err := executor(ctx, r.db).GetContext(ctx, &row, query, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return &notification.Preferences{
+		UserID:          row.UserID,
+		EmailEnabled:    row.EmailEnabled,
+		SMSEnabled:      row.SMSEnabled,
+		QuietHoursStart: row.QuietHoursStart.String,
+		QuietHoursEnd:   row.QuietHoursEnd.String,
+		Timezone:        row.Timezone,
+		UpdatedAt:       row.UpdatedAt,
+	}, nil
+}
+
+func (r *NotificationRepository) SavePreferences(ctx context.Context, p *notification.Preferences) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, email_enabled, sms_enabled,
+		                                      quiet_hours_start, quiet_hours_end, timezone, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email_enabled = EXCLUDED.email_enabled,
+		    sms_enabled = EXCLUDED.sms_enabled,
+		    quiet_hours_start = EXCLUDED.quiet_hours_start,
+		    quiet_hours_end = EXCLUDED.quiet_hours_end,
+		    timezone = EXCLUDED.timezone,
+		    updated_at = EXCLUDED.updated_at
+	`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		p.UserID, p.EmailEnabled, p.SMSEnabled,
+		nullIfEmpty(p.QuietHoursStart), nullIfEmpty(p.QuietHoursEnd), p.Timezone, p.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// File: internal/infrastructure/postgres/outbox_repo.go
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/infrastructure/tracing"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type OutboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewOutboxRepository(db *sqlx.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+type outboxRow struct {
+	ID           uuid.UUID `db:"id"`
+	EventType    string    `db:"event_type"`
+	BusinessID   uuid.UUID `db:"business_id"`
+	AggregateID  uuid.UUID `db:"aggregate_id"`
+	Payload      []byte    `db:"payload"`
+	TraceContext []byte    `db:"trace_context"`
+	OccurredAt   time.Time `db:"occurred_at"`
+	Attempts     int       `db:"attempts"`
+}
+
+// Publish - ctx-də tranzaksiya varsa hadisələr onunla birlikdə commit olunur.
+// Cari span-ın konteksti saxlanır ki, relay-in span-ı sorğuya link ilə bağlansın.
+func (r *OutboxRepository) Publish(ctx context.Context, published ...*events.Event) error {
+	query := `
+		INSERT INTO outbox_events (id, event_type, business_id, aggregate_id, payload,
+		                           trace_context, occurred_at, available_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+	`
+	for _, event := range published {
+		if event.TraceContext == nil {
+			event.TraceContext = tracing.Inject(ctx)
+		}
+		traceContext, err := json.Marshal(event.TraceContext)
+		if err != nil {
+			return fmt.Errorf("failed to encode trace context: %w", err)
+		}
+		_, err = executor(ctx, r.db).ExecContext(ctx, query,
+			event.ID, string(event.Type), event.BusinessID, event.AggregateID,
+			[]byte(event.Payload), traceContext, event.OccurredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write outbox event: %w", err)
+		}
+	}
+	return nil
+}
+
+// Claim - SKIP LOCKED ilə paralel relay-lər eyni sətirləri götürmür; lease bitənə qədər
+// hadisə başqa relay-ə görünmür (relay çöksə lease bitdikdən sonra yenidən götürülür)
+func (r *OutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration, now time.Time) ([]*events.Event, error) {
+	query := `
+		UPDATE outbox_events
+		SET locked_until = $2
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE published_at IS NULL
+			  AND attempts < $3
+			  AND available_at <= $1
+			  AND (locked_until IS NULL OR locked_until <= $1)
+			ORDER BY occurred_at, id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_type, business_id, aggregate_id, payload, trace_context, occurred_at, attempts
+	`
+	var rows []outboxRow
+	err := executor(ctx, r.db).SelectContext(ctx, &rows, query, now, now.Add(lease), events.MaxAttempts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	claimed := make([]*events.Event, 0, len(rows))
+	for _, row := range rows {
+		event := &events.Event{
+			ID:          row.ID,
+			Type:        events.Type(row.EventType),
+			BusinessID:  row.BusinessID,
+			AggregateID: row.AggregateID,
+			Payload:     row.Payload,
+			OccurredAt:  row.OccurredAt,
+			Attempts:    row.Attempts,
+		}
+		if len(row.TraceContext) > 0 {
+			if err := json.Unmarshal(row.TraceContext, &event.TraceContext); err != nil {
+				return nil, fmt.Errorf("failed to decode trace context: %w", err)
+			}
+		}
+		claimed = append(claimed, event)
+	}
+	// RETURNING sırası zəmanətli deyil
+	sort.Slice(claimed, func(i, j int) bool { return claimed[i].OccurredAt.Before(claimed[j].OccurredAt) })
+	return claimed, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := `UPDATE outbox_events SET published_at = $2, locked_until = NULL, last_error = NULL WHERE id = $1`
+	if _, err := executor(ctx, r.db).ExecContext(ctx, query, id, at); err != nil {
+		return fmt.Errorf("failed to mark outbox event published: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	query := `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, last_error = $2, available_at = $3, locked_until = NULL
+		WHERE id = $1
+	`
+	if _, err := executor(ctx, r.db).ExecContext(ctx, query, id, reason, retryAt); err != nil {
+		return fmt.Errorf("failed to mark outbox event failed: %w", err)
+	}
+	return nil
+}
+
+// Pending - göndərilməmiş və cəhd limiti bitməmiş hadisələr (job_queue_depth metriki)
+func (r *OutboxRepository) Pending(ctx context.Context) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM outbox_events WHERE published_at IS NULL AND attempts < $1`
+	if err := executor(ctx, r.db).GetContext(ctx, &count, query, events.MaxAttempts); err != nil {
+		return 0, fmt.Errorf("failed to count pending outbox events: %w", err)
+	}
+	return count, nil
+}
+
+func (r *OutboxRepository) DeletePublished(ctx context.Context, before time.Time) (int, error) {
+	query := `DELETE FROM outbox_events WHERE published_at IS NOT NULL AND published_at < $1`
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete published outbox events: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted outbox events: %w", err)
+	}
+	return int(deleted), nil
+}
@@ -0,0 +1,78 @@
+// File: internal/infrastructure/postgres/ratelimit_repo.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/ratelimit"
+	"github.com/jmoiron/sqlx"
+)
+
+// RateLimitRepository - bütün API instansları üçün ortaq bucket-lər. Sətir FOR UPDATE ilə kilidlənir,
+// token hesabı domain-dəki Limit.Take ilə aparılır.
+type RateLimitRepository struct {
+	db *sqlx.DB
+}
+
+func NewRateLimitRepository(db *sqlx.DB) *RateLimitRepository {
+	return &RateLimitRepository{db: db}
+}
+
+func (r *RateLimitRepository) Take(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Decision, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return ratelimit.Decision{}, fmt.Errorf("failed to begin rate limit transaction: %w", err)
+	}
+	defer tx.Rollback() // nolint:errcheck
+	exec := tracedExecutor{inner: tx}
+
+	full := limit.NewBucket(now)
+	_, err = exec.ExecContext(ctx, `
+		INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO NOTHING
+	`, key, full.Tokens, full.UpdatedAt)
+	if err != nil {
+		return ratelimit.Decision{}, fmt.Errorf("failed to create rate limit bucket: %w", err)
+	}
+
+	var row struct {
+		Tokens    float64   `db:"tokens"`
+		UpdatedAt time.Time `db:"updated_at"`
+	}
+	err = exec.GetContext(ctx, &row,
+		`SELECT tokens, updated_at FROM rate_limit_buckets WHERE key = $1 FOR UPDATE`, key,
+	)
+	if err != nil {
+		return ratelimit.Decision{}, fmt.Errorf("failed to lock rate limit bucket: %w", err)
+	}
+
+	bucket, decision := limit.Take(ratelimit.Bucket{Tokens: row.Tokens, UpdatedAt: row.UpdatedAt}, now)
+	_, err = exec.ExecContext(ctx,
+		`UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3 WHERE key = $1`,
+		key, bucket.Tokens, bucket.UpdatedAt,
+	)
+	if err != nil {
+		return ratelimit.Decision{}, fmt.Errorf("failed to update rate limit bucket: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ratelimit.Decision{}, fmt.Errorf("failed to commit rate limit bucket: %w", err)
+	}
+	return decision, nil
+}
+
+// DeleteStale - before-dan bəri toxunulmamış bucket-ləri silir (onlar artıq tam doludur)
+func (r *RateLimitRepository) DeleteStale(ctx context.Context, before time.Time) (int, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM rate_limit_buckets WHERE updated_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale rate limit buckets: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted rate limit buckets: %w", err)
+	}
+	return int(deleted), nil
+}
@@ -0,0 +1,46 @@
+// File: internal/infrastructure/postgres/schema_check.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// VerifySchema - verilənlər bazası binary-yə embed olunmuş son miqrasiyaya qədər miqrasiya
+// olunmalı və dirty vəziyyətdə olmamalıdır. Repository sorğularının sxemə uyğunluğunu
+// schema_drift_test.go miqrasiya olunmuş baza üzərində yoxlayır.
+func VerifySchema(ctx context.Context, db *sqlx.DB) error {
+	versions, err := migrationVersions()
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return errors.New("no embedded migrations")
+	}
+	latest := versions[len(versions)-1]
+
+	var state struct {
+		Version int64 `db:"version"`
+		Dirty   bool  `db:"dirty"`
+	}
+	err = db.GetContext(ctx, &state, `SELECT version, dirty FROM schema_migrations LIMIT 1`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("schema drift: no migrations applied, expected version %d", latest)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read migration version: %w", err)
+	}
+
+	if state.Dirty {
+		return fmt.Errorf("schema is dirty at version %d", state.Version)
+	}
+	// Yeni versiya ilə miqrasiya olunmuş baza köhnə binary ilə işləyə bilər (rolling deploy)
+	if state.Version < int64(latest) {
+		return fmt.Errorf("schema drift: database is at version %d, expected %d", state.Version, latest)
+	}
+	return nil
+}
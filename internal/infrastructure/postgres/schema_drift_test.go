@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+)
+
+// driftValue - doldurulan string sahələri və parametrləri; RateLimitRepository.Take öz
+// tranzaksiyasını commit etdiyi üçün bu açarla yaranan bucket test sonunda silinir
+const driftValue = "schema-drift-check"
+
+var errDriftRollback = errors.New("schema drift check rollback")
+
+// schemaErrorCode - SQLSTATE 42xxx: sintaksis, mövcud olmayan cədvəl, sütun, funksiya və ya tip uyğunsuzluğu.
+// 42501 (icazə, RLS) sxem fərqi deyil.
+var schemaErrorCode = regexp.MustCompile(`SQLSTATE (42[0-9A-Z]{3})`)
+
+// TestRepositoryQueriesMatchSchema - hər repository-nin hər metodunu miqrasiya olunmuş bazada
+// doldurulmuş arqumentlərlə çağırır və geri qaytarılan tranzaksiyada icra edir. Sorğu ilə sxem
+// arasında fərq (silinmiş sütun, adı dəyişmiş cədvəl) Postgres-in 42xxx xətası kimi görünür.
+// Yeni repository əlavə edildikdə repositories siyahısına da əlavə edilməlidir.
+func TestRepositoryQueriesMatchSchema(t *testing.T) {
+	db := openTestDB(t)
+	cipher := newTestCipher(t)
+	txManager := NewTxManager(db)
+
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM rate_limit_buckets WHERE key = $1`, driftValue) // nolint:errcheck
+	})
+
+	repositories := []interface{}{
+		NewAuditRepository(db),
+		NewAuthRepository(db, cipher),
+		NewBusinessRepository(db),
+		NewFieldReencryptor(db, cipher),
+		NewHeartbeatRepository(db),
+		NewIdempotencyRepository(db, cipher),
+		NewLocationRepository(db),
+		NewNotificationRepository(db, cipher),
+		NewOutboxRepository(db),
+		NewRateLimitRepository(db),
+		NewServiceRepository(db),
+		NewStaffRepository(db, cipher),
+		NewWebhookRepository(db, cipher),
+	}
+
+	for _, repository := range repositories {
+		value := reflect.ValueOf(repository)
+		for i := 0; i < value.NumMethod(); i++ {
+			method := value.Type().Method(i)
+			call := value.Method(i)
+			t.Run(fmt.Sprintf("%s.%s", value.Elem().Type().Name(), method.Name), func(t *testing.T) {
+				ctx := tenant.Unscoped(context.Background())
+				err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+					if err := invoke(ctx, call); err != nil {
+						return err
+					}
+					return errDriftRollback
+				})
+				if err == nil || errors.Is(err, errDriftRollback) {
+					return
+				}
+				if match := schemaErrorCode.FindStringSubmatch(err.Error()); match != nil && match[1] != "42501" {
+					t.Errorf("query does not match the migrated schema: %v", err)
+				}
+			})
+		}
+	}
+}
+
+// invoke - metodu doldurulmuş arqumentlərlə çağırır və son qaytarılan xətanı verir
+func invoke(ctx context.Context, method reflect.Value) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	methodType := method.Type()
+	args := make([]reflect.Value, methodType.NumIn())
+	for i := range args {
+		args[i] = sampleValue(ctx, methodType.In(i), 0)
+	}
+
+	var results []reflect.Value
+	if methodType.IsVariadic() {
+		results = method.CallSlice(args)
+	} else {
+		results = method.Call(args)
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	last := results[len(results)-1]
+	if last.Type() != reflect.TypeOf((*error)(nil)).Elem() || last.IsNil() {
+		return nil
+	}
+	return last.Interface().(error)
+}
+
+var (
+	contextType  = reflect.TypeOf((*context.Context)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// sampleValue - boş olmayan nümunə dəyər: metodlar sıfır dəyərlərdə sorğuya çatmadan qayıtmasın
+func sampleValue(ctx context.Context, typ reflect.Type, depth int) reflect.Value {
+	value := reflect.New(typ).Elem()
+	if depth > 4 {
+		return value
+	}
+
+	switch {
+	case typ == contextType:
+		return reflect.ValueOf(ctx)
+	case typ == timeType:
+		value.Set(reflect.ValueOf(time.Now().UTC()))
+		return value
+	case typ == durationType:
+		value.SetInt(int64(time.Minute))
+		return value
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		value.SetString(driftValue)
+	case reflect.Bool:
+		value.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		value.SetFloat(1)
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			value.Index(i).Set(sampleValue(ctx, typ.Elem(), depth+1))
+		}
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			value.SetBytes([]byte(`{}`))
+			break
+		}
+		value.Set(reflect.Append(value, sampleValue(ctx, typ.Elem(), depth+1)))
+	case reflect.Map:
+		value.Set(reflect.MakeMap(typ))
+		value.SetMapIndex(sampleValue(ctx, typ.Key(), depth+1), sampleValue(ctx, typ.Elem(), depth+1))
+	case reflect.Ptr:
+		value.Set(reflect.New(typ.Elem()))
+		value.Elem().Set(sampleValue(ctx, typ.Elem(), depth+1))
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Field(i).CanSet() {
+				value.Field(i).Set(sampleValue(ctx, typ.Field(i).Type, depth+1))
+			}
+		}
+	}
+	return value
+}
@@ -3,10 +3,14 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/service"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -25,12 +29,15 @@ func (r *ServiceRepository) Create(ctx context.Context, s *domain.Service) error
             is_active, created_at, updated_at
         ) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
     `
-	_, err := r.db.ExecContext(
+	_, err := executor(ctx, r.db).ExecContext(
 		ctx, query,
 		s.ID, s.BusinessID, s.Name, s.Description, s.DurationMinutes, s.Price,
 		s.IsActive, s.CreatedAt, s.UpdatedAt,
 	)
 	if err != nil {
+		if isUniqueViolation(err, "idx_services_business_name") {
+			return domain.ErrDuplicateName
+		}
 		return fmt.Errorf("failed to insert service: %w", err)
 	}
 	return nil
@@ -39,12 +46,12 @@ func (r *ServiceRepository) Create(ctx context.Context, s *domain.Service) error
 func (r *ServiceRepository) GetByID(ctx context.Context, id, businessID uuid.UUID) (*domain.Service, error) {
 	query := `
         SELECT id, business_id, name, description, duration_minutes, price,
-               is_active, created_at, updated_at
+               is_active, version, created_at, updated_at
         FROM services
         WHERE id = $1 AND business_id = $2 AND is_active = true
     `
 	var svc domain.Service
-	err := r.db.GetContext(ctx, &svc, query, id, businessID)
+	err := executor(ctx, r.db).GetContext(ctx, &svc, query, id, businessID)
 	if err != nil {
 		if isNoRowsError(err) {
 			return nil, nil
@@ -54,16 +61,23 @@ func (r *ServiceRepository) GetByID(ctx context.Context, id, businessID uuid.UUI
 	return &svc, nil
 }
 
-func (r *ServiceRepository) ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*domain.Service, error) {
-	query := `
+// ListByBusiness - limit+1 sətir qaytarır, səhifələmə domain qatında edilir
+func (r *ServiceRepository) ListByBusiness(ctx context.Context, businessID uuid.UUID, q listing.Query) ([]*domain.Service, error) {
+	filter := newListQuery("business_id = $1", businessID)
+	switch q.Status {
+	case "", domain.StatusActive:
+		filter.where("is_active = true")
+	case domain.StatusInactive:
+		filter.where("is_active = false")
+	}
+
+	query, args := filter.build(`
         SELECT id, business_id, name, description, duration_minutes, price,
-               is_active, created_at, updated_at
-        FROM services
-        WHERE business_id = $1 AND is_active = true
-        ORDER BY created_at DESC
-    `
+               is_active, version, created_at, updated_at
+        FROM services`, q, listColumns{id: "id", name: "name", createdAt: "created_at", search: []string{"name"}})
+
 	var list []*domain.Service
-	if err := r.db.SelectContext(ctx, &list, query, businessID); err != nil {
+	if err := executor(ctx, r.db).SelectContext(ctx, &list, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to list services: %w", err)
 	}
 	return list, nil
@@ -73,31 +87,34 @@ func (r *ServiceRepository) Update(ctx context.Context, s *domain.Service) error
 	query := `
         UPDATE services
         SET name = $1, description = $2, duration_minutes = $3, price = $4,
-            updated_at = $5
-        WHERE id = $6 AND business_id = $7
+            updated_at = $5, version = version + 1
+        WHERE id = $6 AND business_id = $7 AND version = $8
+        RETURNING version
     `
-	result, err := r.db.ExecContext(
-		ctx, query,
+	err := executor(ctx, r.db).GetContext(
+		ctx, &s.Version, query,
 		s.Name, s.Description, s.DurationMinutes, s.Price, s.UpdatedAt,
-		s.ID, s.BusinessID,
+		s.ID, s.BusinessID, s.Version,
 	)
+	if isNoRowsError(err) {
+		return apperr.ErrVersionMismatch
+	}
 	if err != nil {
+		if isUniqueViolation(err, "idx_services_business_name") {
+			return domain.ErrDuplicateName
+		}
 		return fmt.Errorf("failed to update service: %w", err)
 	}
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return fmt.Errorf("service not found")
-	}
 	return nil
 }
 
 func (r *ServiceRepository) Deactivate(ctx context.Context, id, businessID uuid.UUID) error {
 	query := `
         UPDATE services
-        SET is_active = false, updated_at = NOW()
+        SET is_active = false, updated_at = NOW(), version = version + 1
         WHERE id = $1 AND business_id = $2
     `
-	result, err := r.db.ExecContext(ctx, query, id, businessID)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id, businessID)
 	if err != nil {
 		return fmt.Errorf("failed to deactivate service: %w", err)
 	}
@@ -113,45 +130,47 @@ func (r *ServiceRepository) AssignServicesToStaff(
 	businessID, staffID uuid.UUID,
 	serviceIDs []uuid.UUID,
 ) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer tx.Rollback() // nolint:errcheck
+	return NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
 
-	query := `
-        INSERT INTO staff_services (
-            staff_id, business_id, service_id, created_at
-        ) VALUES ($1,$2,$3,NOW())
-        ON CONFLICT (staff_id, business_id, service_id) DO NOTHING
-    `
-	for _, sid := range serviceIDs {
-		if _, err := tx.ExecContext(ctx, query, staffID, businessID, sid); err != nil {
-			return fmt.Errorf("failed to assign service %s to staff %s: %w", sid, staffID, err)
+		query := `
+	        INSERT INTO staff_services (
+	            staff_id, business_id, service_id, created_at
+	        ) VALUES ($1,$2,$3,NOW())
+	        ON CONFLICT (staff_id, business_id, service_id) DO NOTHING
+	    `
+		for _, sid := range serviceIDs {
+			if _, err := tx.ExecContext(ctx, query, staffID, businessID, sid); err != nil {
+				return fmt.Errorf("failed to assign service %s to staff %s: %w", sid, staffID, err)
+			}
 		}
-	}
 
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit assign services tx: %w", err)
-	}
-	return nil
+		return nil
+	})
 }
 
 func (r *ServiceRepository) GetStaffServices(
 	ctx context.Context,
 	businessID, staffID uuid.UUID,
+	q listing.Query,
 ) ([]*domain.Service, error) {
-	query := `
+	filter := newListQuery("ss.staff_id = $1 AND ss.business_id = $2", staffID, businessID)
+	switch q.Status {
+	case "", domain.StatusActive:
+		filter.where("s.is_active = true")
+	case domain.StatusInactive:
+		filter.where("s.is_active = false")
+	}
+
+	query, args := filter.build(`
         SELECT s.id, s.business_id, s.name, s.description, s.duration_minutes, s.price,
-               s.is_active, s.created_at, s.updated_at
+               s.is_active, s.version, s.created_at, s.updated_at
         FROM services s
         JOIN staff_services ss
-          ON ss.service_id = s.id
-        WHERE ss.staff_id = $1 AND ss.business_id = $2 AND s.is_active = true
-        ORDER BY s.name ASC
-    `
+          ON ss.service_id = s.id`, q, listColumns{id: "s.id", name: "s.name", createdAt: "s.created_at", search: []string{"s.name"}})
+
 	var list []*domain.Service
-	if err := r.db.SelectContext(ctx, &list, query, staffID, businessID); err != nil {
+	if err := executor(ctx, r.db).SelectContext(ctx, &list, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to get staff services: %w", err)
 	}
 	return list, nil
@@ -165,7 +184,7 @@ func (r *ServiceRepository) RemoveServiceFromStaff(
         DELETE FROM staff_services
         WHERE staff_id = $1 AND business_id = $2 AND service_id = $3
     `
-	result, err := r.db.ExecContext(ctx, query, staffID, businessID, serviceID)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, staffID, businessID, serviceID)
 	if err != nil {
 		return fmt.Errorf("failed to remove service from staff: %w", err)
 	}
@@ -176,6 +195,12 @@ func (r *ServiceRepository) RemoveServiceFromStaff(
 	return nil
 }
 
+// isUniqueViolation - Postgres 23505 xətası və verilmiş constraint/index adı
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
 func isNoRowsError(err error) bool {
 	type causer interface{ Error() string }
 	if err == nil {
@@ -5,34 +5,61 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/apperr"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
 	"github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
 type StaffRepository struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	cipher FieldCipher
 }
 
-func NewStaffRepository(db *sqlx.DB) *StaffRepository {
-	return &StaffRepository{db: db}
+func NewStaffRepository(db *sqlx.DB, cipher FieldCipher) *StaffRepository {
+	return &StaffRepository{db: db, cipher: cipher}
+}
+
+// staffProfileRow - hourly_rate şifrəli saxlanılır (hourly_rate_enc), köhnə sətirlərdə isə açıq qalıb
+type staffProfileRow struct {
+	staff.StaffProfile
+	HourlyRateEnc    sql.NullString  `db:"hourly_rate_enc"`
+	LegacyHourlyRate sql.NullFloat64 `db:"legacy_hourly_rate"`
+}
+
+func (r *StaffRepository) toStaffProfile(row *staffProfileRow) (*staff.StaffProfile, error) {
+	profile := row.StaffProfile
+	rate, err := decryptHourlyRate(r.cipher, row.HourlyRateEnc, row.LegacyHourlyRate)
+	if err != nil {
+		return nil, err
+	}
+	profile.HourlyRate = rate
+	return &profile, nil
 }
 
 func (r *StaffRepository) CreateStaffProfile(ctx context.Context, profile *staff.StaffProfile) error {
 	query := `
 		INSERT INTO staff_profiles (
 			id, user_id, business_id, location_id, role, title, 
-			department, bio, hourly_rate, status, joined_at, 
+			department, bio, hourly_rate_enc, status, joined_at, 
 			created_at, updated_at
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 	`
 
-	_, err := r.db.ExecContext(
+	hourlyRateEnc, err := encryptHourlyRate(r.cipher, profile.HourlyRate)
+	if err != nil {
+		return err
+	}
+
+	_, err = executor(ctx, r.db).ExecContext(
 		ctx, query,
 		profile.ID, profile.UserID, profile.BusinessID, profile.LocationID,
 		profile.Role, profile.Title, profile.Department, profile.Bio,
-		profile.HourlyRate, profile.Status, profile.JoinedAt,
+		hourlyRateEnc, profile.Status, profile.JoinedAt,
 		profile.CreatedAt, profile.UpdatedAt,
 	)
 
@@ -46,14 +73,14 @@ func (r *StaffRepository) CreateStaffProfile(ctx context.Context, profile *staff
 func (r *StaffRepository) GetStaffByID(ctx context.Context, id, businessID uuid.UUID) (*staff.StaffProfile, error) {
 	query := `
 		SELECT id, user_id, business_id, location_id, role, title, 
-			   department, bio, hourly_rate, status, joined_at, 
-			   created_at, updated_at
+			   department, bio, hourly_rate AS legacy_hourly_rate, hourly_rate_enc,
+			   status, joined_at, version, created_at, updated_at
 		FROM staff_profiles
 		WHERE id = $1 AND business_id = $2 AND status != 'inactive'
 	`
 
-	var profile staff.StaffProfile
-	err := r.db.GetContext(ctx, &profile, query, id, businessID)
+	var row staffProfileRow
+	err := executor(ctx, r.db).GetContext(ctx, &row, query, id, businessID)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -62,20 +89,20 @@ func (r *StaffRepository) GetStaffByID(ctx context.Context, id, businessID uuid.
 		return nil, fmt.Errorf("failed to get staff: %w", err)
 	}
 
-	return &profile, nil
+	return r.toStaffProfile(&row)
 }
 
 func (r *StaffRepository) GetStaffByUserID(ctx context.Context, userID, businessID uuid.UUID) (*staff.StaffProfile, error) {
 	query := `
 		SELECT id, user_id, business_id, location_id, role, title, 
-			   department, bio, hourly_rate, status, joined_at, 
-			   created_at, updated_at
+			   department, bio, hourly_rate AS legacy_hourly_rate, hourly_rate_enc,
+			   status, joined_at, version, created_at, updated_at
 		FROM staff_profiles
 		WHERE user_id = $1 AND business_id = $2 AND status != 'inactive'
 	`
 
-	var profile staff.StaffProfile
-	err := r.db.GetContext(ctx, &profile, query, userID, businessID)
+	var row staffProfileRow
+	err := executor(ctx, r.db).GetContext(ctx, &row, query, userID, businessID)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -84,27 +111,42 @@ func (r *StaffRepository) GetStaffByUserID(ctx context.Context, userID, business
 		return nil, fmt.Errorf("failed to get staff by user: %w", err)
 	}
 
-	return &profile, nil
+	return r.toStaffProfile(&row)
 }
 
-func (r *StaffRepository) ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*staff.StaffWithUser, error) {
-	query := `
+// ListByBusiness - limit+1 sətir qaytarır; status verilməyibsə deaktiv işçilər gizlədilir
+func (r *StaffRepository) ListByBusiness(ctx context.Context, businessID uuid.UUID, q listing.Query) ([]*staff.StaffWithUser, error) {
+	filter := newListQuery("sp.business_id = $1", businessID)
+	switch q.Status {
+	case "":
+		filter.where("sp.status != 'inactive'")
+	case listing.StatusAll:
+	default:
+		filter.where("sp.status = ?", q.Status)
+	}
+
+	query, args := filter.build(`
 		SELECT sp.id, sp.user_id, sp.role, sp.title, sp.department, 
-			sp.location_id, sp.status, sp.joined_at,
-			u.full_name, u.email, u.phone, u.avatar
+			sp.location_id, sp.status, sp.joined_at, sp.created_at,
+			u.full_name, u.email, COALESCE(u.phone, '') AS phone, u.avatar
 		FROM staff_profiles sp
-		JOIN users u ON sp.user_id = u.id
-		WHERE sp.business_id = $1 AND sp.status != 'inactive'
-		ORDER BY sp.joined_at DESC
-	`
+		JOIN users u ON sp.user_id = u.id`, q, listColumns{id: "sp.id", name: "u.full_name", createdAt: "sp.created_at", search: []string{"u.full_name"}})
 
 	var staffList []*staff.StaffWithUser
-	err := r.db.SelectContext(ctx, &staffList, query, businessID)
+	err := executor(ctx, r.db).SelectContext(ctx, &staffList, query, args...)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to list staff: %w", err)
 	}
 
+	for _, member := range staffList {
+		phone, err := r.cipher.Decrypt(member.Phone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt staff phone: %w", err)
+		}
+		member.Phone = phone
+	}
+
 	return staffList, nil
 }
 
@@ -112,37 +154,42 @@ func (r *StaffRepository) UpdateStaffProfile(ctx context.Context, profile *staff
 	query := `
 		UPDATE staff_profiles
 		SET role = $1, title = $2, department = $3, bio = $4, 
-			hourly_rate = $5, location_id = $6, updated_at = $7
-		WHERE id = $8 AND business_id = $9
+			hourly_rate = NULL, hourly_rate_enc = $5, location_id = $6, updated_at = $7,
+			version = version + 1
+		WHERE id = $8 AND business_id = $9 AND version = $10
+		RETURNING version
 	`
 
-	result, err := r.db.ExecContext(
-		ctx, query,
+	hourlyRateEnc, err := encryptHourlyRate(r.cipher, profile.HourlyRate)
+	if err != nil {
+		return err
+	}
+
+	err = executor(ctx, r.db).GetContext(
+		ctx, &profile.Version, query,
 		profile.Role, profile.Title, profile.Department, profile.Bio,
-		profile.HourlyRate, profile.LocationID, profile.UpdatedAt,
-		profile.ID, profile.BusinessID,
+		hourlyRateEnc, profile.LocationID, profile.UpdatedAt,
+		profile.ID, profile.BusinessID, profile.Version,
 	)
 
+	if err == sql.ErrNoRows {
+		return apperr.ErrVersionMismatch
+	}
 	if err != nil {
 		return fmt.Errorf("failed to update staff: %w", err)
 	}
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return fmt.Errorf("staff not found")
-	}
-
 	return nil
 }
 
 func (r *StaffRepository) DeactivateStaff(ctx context.Context, id, businessID uuid.UUID) error {
 	query := `
 		UPDATE staff_profiles
-		SET status = 'inactive', updated_at = NOW()
+		SET status = 'inactive', updated_at = NOW(), version = version + 1
 		WHERE id = $1 AND business_id = $2
 	`
 
-	result, err := r.db.ExecContext(ctx, query, id, businessID)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id, businessID)
 
 	if err != nil {
 		return fmt.Errorf("failed to deactivate staff: %w", err)
@@ -164,7 +211,7 @@ func (r *StaffRepository) CreateInvite(ctx context.Context, invite *staff.Busine
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := executor(ctx, r.db).ExecContext(
 		ctx, query,
 		invite.ID, invite.BusinessID, invite.InvitedEmail, invite.InvitedPhone,
 		invite.Role, invite.LocationID, invite.Token, invite.ExpiresAt,
@@ -180,14 +227,15 @@ func (r *StaffRepository) CreateInvite(ctx context.Context, invite *staff.Busine
 
 func (r *StaffRepository) GetInviteByToken(ctx context.Context, token string) (*staff.BusinessInvite, error) {
 	query := `
-		SELECT id, business_id, invited_email, invited_phone, role, 
-			   location_id, token, expires_at, used, created_at, updated_at
+		SELECT id, business_id, COALESCE(invited_email, '') AS invited_email,
+			   COALESCE(invited_phone, '') AS invited_phone, role, location_id,
+			   token, expires_at, used, revoked_at, created_at, updated_at
 		FROM business_invites
 		WHERE token = $1
 	`
 
 	var invite staff.BusinessInvite
-	err := r.db.GetContext(ctx, &invite, query, token)
+	err := executor(ctx, r.db).GetContext(ctx, &invite, query, token)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -203,10 +251,10 @@ func (r *StaffRepository) MarkInviteAsUsed(ctx context.Context, inviteID uuid.UU
 	query := `
 		UPDATE business_invites
 		SET used = true, updated_at = NOW()
-		WHERE id = $1
+		WHERE id = $1 AND used = false AND revoked_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, inviteID)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, inviteID)
 
 	if err != nil {
 		return fmt.Errorf("failed to mark invite as used: %w", err)
@@ -214,23 +262,40 @@ func (r *StaffRepository) MarkInviteAsUsed(ctx context.Context, inviteID uuid.UU
 
 	rows, _ := result.RowsAffected()
 	if rows == 0 {
-		return fmt.Errorf("invite not found")
+		return fmt.Errorf("invite not found or already used")
 	}
 
 	return nil
 }
 
-func (r *StaffRepository) ListInvitesByBusiness(ctx context.Context, businessID uuid.UUID) ([]*staff.BusinessInvite, error) {
-	query := `
-		SELECT id, business_id, invited_email, invited_phone, role, 
-			   location_id, token, expires_at, used, created_at, updated_at
-		FROM business_invites
-		WHERE business_id = $1
-		ORDER BY created_at DESC
-	`
+// ListInvitesByBusiness - status verilməyibsə açıq (qəbul və ləğv edilməmiş) dəvətlər, vaxtı keçənlər daxil
+func (r *StaffRepository) ListInvitesByBusiness(ctx context.Context, businessID uuid.UUID, q listing.Query) ([]*staff.BusinessInvite, error) {
+	filter := newListQuery("business_id = $1", businessID)
+	switch staff.InviteStatus(q.Status) {
+	case "":
+		filter.where("used = false AND revoked_at IS NULL")
+	case staff.InviteStatusPending:
+		filter.where("used = false AND revoked_at IS NULL AND expires_at > NOW()")
+	case staff.InviteStatusExpired:
+		filter.where("used = false AND revoked_at IS NULL AND expires_at <= NOW()")
+	case staff.InviteStatusAccepted:
+		filter.where("used = true")
+	case staff.InviteStatusRevoked:
+		filter.where("used = false AND revoked_at IS NOT NULL")
+	}
+
+	// Dəvətin adı yoxdur, axtarış email və telefon üzrə aparılır; name sort email-ə görədir
+	query, args := filter.build(`
+		SELECT id, business_id, COALESCE(invited_email, '') AS invited_email,
+			   COALESCE(invited_phone, '') AS invited_phone, role, location_id,
+			   token, expires_at, used, revoked_at, created_at, updated_at
+		FROM business_invites`, q, listColumns{
+		id: "id", name: "COALESCE(invited_email, '')", createdAt: "created_at",
+		search: []string{"invited_email"},
+	})
 
 	var invites []*staff.BusinessInvite
-	err := r.db.SelectContext(ctx, &invites, query, businessID)
+	err := executor(ctx, r.db).SelectContext(ctx, &invites, query, args...)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to list invites: %w", err)
@@ -238,3 +303,89 @@ func (r *StaffRepository) ListInvitesByBusiness(ctx context.Context, businessID
 
 	return invites, nil
 }
+
+func (r *StaffRepository) GetInviteByID(ctx context.Context, id, businessID uuid.UUID) (*staff.BusinessInvite, error) {
+	query := `
+		SELECT id, business_id, COALESCE(invited_email, '') AS invited_email,
+			   COALESCE(invited_phone, '') AS invited_phone, role, location_id,
+			   token, expires_at, used, revoked_at, created_at, updated_at
+		FROM business_invites
+		WHERE id = $1 AND business_id = $2
+	`
+
+	var invite staff.BusinessInvite
+	err := executor(ctx, r.db).GetContext(ctx, &invite, query, id, businessID)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invite: %w", err)
+	}
+
+	return &invite, nil
+}
+
+func (r *StaffRepository) RefreshInviteToken(ctx context.Context, id, businessID uuid.UUID, token string, expiresAt time.Time) error {
+	query := `
+		UPDATE business_invites
+		SET token = $1, expires_at = $2, updated_at = NOW()
+		WHERE id = $3 AND business_id = $4 AND used = false AND revoked_at IS NULL
+	`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, token, expiresAt, id, businessID)
+
+	if err != nil {
+		return fmt.Errorf("failed to refresh invite token: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("invite not found")
+	}
+
+	return nil
+}
+
+func (r *StaffRepository) RevokeInvite(ctx context.Context, id, businessID uuid.UUID) error {
+	query := `
+		UPDATE business_invites
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND business_id = $2 AND used = false AND revoked_at IS NULL
+	`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id, businessID)
+
+	if err != nil {
+		return fmt.Errorf("failed to revoke invite: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("invite not found")
+	}
+
+	return nil
+}
+
+// GetActiveStaffMember - business domeni üçün aktiv işçini user məlumatları ilə qaytarır
+func (r *StaffRepository) GetActiveStaffMember(ctx context.Context, businessID, userID uuid.UUID) (*business.StaffMember, error) {
+	query := `
+		SELECT sp.user_id, u.email, u.full_name, COALESCE(u.locale, '') AS locale
+		FROM staff_profiles sp
+		JOIN users u ON sp.user_id = u.id
+		WHERE sp.business_id = $1 AND sp.user_id = $2 AND sp.status = 'active'
+	`
+
+	var member business.StaffMember
+	err := executor(ctx, r.db).GetContext(ctx, &member, query, businessID, userID)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active staff member: %w", err)
+	}
+
+	return &member, nil
+}
@@ -0,0 +1,144 @@
+// File: internal/infrastructure/postgres/tenant.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+)
+
+// tenantTables - row-level security ilə qorunan cədvəllər (migrations/013_row_level_security, 014_audit_events,
+// 016_webhooks, 019_fail_closed_row_level_security). Policy yalnız app.business_id-yə uyğun sətirləri göstərir;
+// heç nə qurulmayıbsa sətir görünmür. Worker və token ilə icazə verilən axınlar tenant.Unscoped ilə
+// app.bypass_rls qurur.
+var tenantTables = []string{
+	"businesses",
+	"business_owners",
+	"ownership_transfers",
+	"locations",
+	"staff_profiles",
+	"business_invites",
+	"services",
+	"staff_services",
+	"audit_events",
+	"webhook_endpoints",
+	"webhook_deliveries",
+}
+
+// tenantScope - tranzaksiyaya qurulan parametrlər: app.business_id və ya app.bypass_rls
+type tenantScope struct {
+	businessID string
+	bypass     string
+}
+
+// scopeOf - ctx-də nə biznes, nə də Unscoped varsa ok=false: parametr qurulmur və policy sətirləri gizlədir
+func scopeOf(ctx context.Context) (tenantScope, bool) {
+	if businessID, ok := tenant.BusinessID(ctx); ok {
+		return tenantScope{businessID: businessID.String()}, true
+	}
+	if tenant.IsUnscoped(ctx) {
+		return tenantScope{bypass: "on"}, true
+	}
+	return tenantScope{}, false
+}
+
+// setTenant - parametrlər yalnız cari tranzaksiya üçün qurulur (SET LOCAL), pool-dakı bağlantıya keçmir.
+// Hər ikisi birlikdə yazılır ki, əvvəlki scope (məs. bypass) tranzaksiyada qalmasın.
+func setTenant(ctx context.Context, exec dbExecutor, scope tenantScope) error {
+	query := `SELECT set_config('app.business_id', $1, true), set_config('app.bypass_rls', $2, true)`
+	if _, err := exec.ExecContext(ctx, query, scope.businessID, scope.bypass); err != nil {
+		return fmt.Errorf("failed to set tenant: %w", err)
+	}
+	return nil
+}
+
+// tenantExecutor - tranzaksiyadan kənar sorğunu tenant parametrləri qurulmuş qısa tranzaksiyada icra edir.
+// Get/Select sətirləri commit-dən əvvəl oxuyur.
+type tenantExecutor struct {
+	db    *sqlx.DB
+	scope tenantScope
+}
+
+func (e tenantExecutor) within(ctx context.Context, fn func(exec dbExecutor) error) error {
+	tx, err := e.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tenant transaction: %w", err)
+	}
+	defer tx.Rollback() // nolint:errcheck
+
+	exec := tracedExecutor{inner: tx}
+	if err := setTenant(ctx, exec, e.scope); err != nil {
+		return err
+	}
+	if err := fn(exec); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (e tenantExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	err := e.within(ctx, func(exec dbExecutor) error {
+		var err error
+		result, err = exec.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+func (e tenantExecutor) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return e.within(ctx, func(exec dbExecutor) error {
+		return exec.GetContext(ctx, dest, query, args...)
+	})
+}
+
+func (e tenantExecutor) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return e.within(ctx, func(exec dbExecutor) error {
+		return exec.SelectContext(ctx, dest, query, args...)
+	})
+}
+
+// VerifyRowSecurity - bütün tenant cədvəllərində RLS aktiv və məcburi (FORCE) olmalıdır
+func VerifyRowSecurity(ctx context.Context, db *sqlx.DB) error {
+	query := `
+		SELECT relname
+		FROM pg_class
+		WHERE relnamespace = 'public'::regnamespace
+		  AND relname::text = ANY($1)
+		  AND relrowsecurity AND relforcerowsecurity
+	`
+	var protected []string
+	if err := db.SelectContext(ctx, &protected, query, tenantTables); err != nil {
+		return fmt.Errorf("failed to read row level security state: %w", err)
+	}
+
+	enabled := make(map[string]bool, len(protected))
+	for _, table := range protected {
+		enabled[table] = true
+	}
+	var missing []string
+	for _, table := range tenantTables {
+		if !enabled[table] {
+			missing = append(missing, table)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("row level security is not enforced on: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// RowSecurityBypassed - superuser və BYPASSRLS rolları policy-lərdən təsirlənmir
+func RowSecurityBypassed(ctx context.Context, db *sqlx.DB) (bool, error) {
+	var bypassed bool
+	err := db.GetContext(ctx, &bypassed, `SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`)
+	if err != nil {
+		return false, fmt.Errorf("failed to read database role: %w", err)
+	}
+	return bypassed, nil
+}
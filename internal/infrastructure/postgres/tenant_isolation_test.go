@@ -0,0 +1,402 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/auth"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/business"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/location"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/service"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/staff"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/tenant"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+)
+
+// rlsTestRole - superuser və BYPASSRLS policy-lərdən kənardadır, test bu adi rolla işləyir
+const rlsTestRole = "booking_rls_test"
+
+// tenantRepositories - izolyasiyası yoxlanan repository-lər
+type tenantRepositories struct {
+	users      *AuthRepository
+	businesses *BusinessRepository
+	locations  *LocationRepository
+	services   *ServiceRepository
+	staff      *StaffRepository
+	audit      *AuditRepository
+	webhooks   *WebhookRepository
+}
+
+// tenantFixture - bir biznesin hər tenant cədvəlində bir sətri
+type tenantFixture struct {
+	business *business.Business
+	location *location.Location
+	service  *service.Service
+	staff    *staff.StaffProfile
+	invite   *staff.BusinessInvite
+	endpoint *webhook.Endpoint
+	delivery *webhook.Delivery
+}
+
+// TestTenantIsolation - iki biznes öz tenant kontekstində yaradılır, sonra B-nin sətirləri A-nın
+// kontekstindən və tenant kontekstsiz oxunur, dəyişdirilir və silinir. Policy fail-closed olduğu üçün
+// heç biri görünməməli və dəyişməməlidir; Unscoped isə açıq bypass kimi hamısını görür.
+// TEST_DATABASE_URL qurulmayıbsa keçirilir.
+func TestTenantIsolation(t *testing.T) {
+	db := rowSecurityDB(t)
+	cipher := newTestCipher(t)
+	repos := tenantRepositories{
+		users:      NewAuthRepository(db, cipher),
+		businesses: NewBusinessRepository(db),
+		locations:  NewLocationRepository(db),
+		services:   NewServiceRepository(db),
+		staff:      NewStaffRepository(db, cipher),
+		audit:      NewAuditRepository(db),
+		webhooks:   NewWebhookRepository(db, cipher),
+	}
+
+	a := seedTenant(t, repos)
+	b := seedTenant(t, repos)
+	ctxB := tenant.WithBusinessID(context.Background(), b.business.ID)
+	query := listing.Query{Limit: 20}
+
+	accesses := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{name: "other tenant", ctx: tenant.WithBusinessID(context.Background(), a.business.ID)},
+		{name: "no tenant", ctx: context.Background()},
+	}
+	for _, access := range accesses {
+		ctx := access.ctx
+		t.Run(access.name, func(t *testing.T) {
+			t.Run("business", func(t *testing.T) {
+				if got, err := repos.businesses.GetByID(ctx, b.business.ID); err != nil || got != nil {
+					t.Errorf("GetByID = %v, %v; want hidden", got, err)
+				}
+				if got, err := repos.businesses.GetByOwnerID(ctx, b.business.OwnerID); err != nil || got != nil {
+					t.Errorf("GetByOwnerID = %v, %v; want hidden", got, err)
+				}
+				if owners, err := repos.businesses.ListOwners(ctx, b.business.ID, query); err != nil || len(owners) != 0 {
+					t.Errorf("ListOwners = %d rows, %v; want none", len(owners), err)
+				}
+				changed := *b.business
+				changed.Name = "Changed"
+				if err := repos.businesses.Update(ctx, &changed); err == nil {
+					t.Error("Update succeeded across tenants")
+				}
+			})
+
+			t.Run("location", func(t *testing.T) {
+				if got, err := repos.locations.GetByID(ctx, b.location.ID, b.business.ID); err != nil || got != nil {
+					t.Errorf("GetByID = %v, %v; want hidden", got, err)
+				}
+				if list, err := repos.locations.ListByBusiness(ctx, b.business.ID, query); err != nil || len(list) != 0 {
+					t.Errorf("ListByBusiness = %d rows, %v; want none", len(list), err)
+				}
+				changed := *b.location
+				changed.Name = "Changed"
+				if err := repos.locations.Update(ctx, &changed); err == nil {
+					t.Error("Update succeeded across tenants")
+				}
+				if err := repos.locations.Deactivate(ctx, b.location.ID, b.business.ID); err == nil {
+					t.Error("Deactivate succeeded across tenants")
+				}
+				if err := repos.locations.Create(ctx, location.NewLocation(b.business.ID, "Injected")); err == nil {
+					t.Error("Create succeeded for another tenant")
+				}
+			})
+
+			t.Run("service", func(t *testing.T) {
+				if got, err := repos.services.GetByID(ctx, b.service.ID, b.business.ID); err != nil || got != nil {
+					t.Errorf("GetByID = %v, %v; want hidden", got, err)
+				}
+				if list, err := repos.services.ListByBusiness(ctx, b.business.ID, query); err != nil || len(list) != 0 {
+					t.Errorf("ListByBusiness = %d rows, %v; want none", len(list), err)
+				}
+				changed := *b.service
+				changed.Name = "Changed"
+				if err := repos.services.Update(ctx, &changed); err == nil {
+					t.Error("Update succeeded across tenants")
+				}
+				if err := repos.services.Deactivate(ctx, b.service.ID, b.business.ID); err == nil {
+					t.Error("Deactivate succeeded across tenants")
+				}
+			})
+
+			t.Run("staff", func(t *testing.T) {
+				if got, err := repos.staff.GetStaffByID(ctx, b.staff.ID, b.business.ID); err != nil || got != nil {
+					t.Errorf("GetStaffByID = %v, %v; want hidden", got, err)
+				}
+				if got, err := repos.staff.GetStaffByUserID(ctx, b.staff.UserID, b.business.ID); err != nil || got != nil {
+					t.Errorf("GetStaffByUserID = %v, %v; want hidden", got, err)
+				}
+				if list, err := repos.staff.ListByBusiness(ctx, b.business.ID, query); err != nil || len(list) != 0 {
+					t.Errorf("ListByBusiness = %d rows, %v; want none", len(list), err)
+				}
+				changed := *b.staff
+				changed.Title = "Changed"
+				if err := repos.staff.UpdateStaffProfile(ctx, &changed); err == nil {
+					t.Error("UpdateStaffProfile succeeded across tenants")
+				}
+				if err := repos.staff.DeactivateStaff(ctx, b.staff.ID, b.business.ID); err == nil {
+					t.Error("DeactivateStaff succeeded across tenants")
+				}
+			})
+
+			t.Run("invite", func(t *testing.T) {
+				if got, err := repos.staff.GetInviteByID(ctx, b.invite.ID, b.business.ID); err != nil || got != nil {
+					t.Errorf("GetInviteByID = %v, %v; want hidden", got, err)
+				}
+				// Token axtarışı yalnız Unscoped ilə bütün bizneslərə baxır
+				if got, err := repos.staff.GetInviteByToken(ctx, b.invite.Token); err != nil || got != nil {
+					t.Errorf("GetInviteByToken = %v, %v; want hidden", got, err)
+				}
+				if list, err := repos.staff.ListInvitesByBusiness(ctx, b.business.ID, query); err != nil || len(list) != 0 {
+					t.Errorf("ListInvitesByBusiness = %d rows, %v; want none", len(list), err)
+				}
+				if err := repos.staff.RevokeInvite(ctx, b.invite.ID, b.business.ID); err == nil {
+					t.Error("RevokeInvite succeeded across tenants")
+				}
+			})
+
+			t.Run("audit", func(t *testing.T) {
+				if list, err := repos.audit.ListByBusiness(ctx, b.business.ID, audit.Filter{}, query); err != nil || len(list) != 0 {
+					t.Errorf("ListByBusiness = %d rows, %v; want none", len(list), err)
+				}
+				if err := repos.audit.Append(ctx, auditEvent(b.business.ID, b.location.ID)); err == nil {
+					t.Error("Append succeeded for another tenant")
+				}
+			})
+
+			t.Run("webhook", func(t *testing.T) {
+				if got, err := repos.webhooks.GetEndpoint(ctx, b.endpoint.ID, b.business.ID); err == nil {
+					t.Errorf("GetEndpoint = %v; want not found", got)
+				}
+				if list, err := repos.webhooks.ListEndpoints(ctx, b.business.ID, query); err != nil || len(list) != 0 {
+					t.Errorf("ListEndpoints = %d rows, %v; want none", len(list), err)
+				}
+				changed := *b.endpoint
+				changed.URL = "https://attacker.example.com/hook"
+				if err := repos.webhooks.UpdateEndpoint(ctx, &changed); err == nil {
+					t.Error("UpdateEndpoint succeeded across tenants")
+				}
+				if err := repos.webhooks.DeleteEndpoint(ctx, b.endpoint.ID, b.business.ID); err == nil {
+					t.Error("DeleteEndpoint succeeded across tenants")
+				}
+				if got, err := repos.webhooks.GetDelivery(ctx, b.delivery.ID, b.business.ID); err == nil {
+					t.Errorf("GetDelivery = %v; want not found", got)
+				}
+				if list, err := repos.webhooks.ListDeliveries(ctx, b.endpoint.ID, b.business.ID, query); err != nil || len(list) != 0 {
+					t.Errorf("ListDeliveries = %d rows, %v; want none", len(list), err)
+				}
+			})
+		})
+	}
+
+	// B-nin öz kontekstində sətirlər dəyişməmiş qalmalıdır
+	t.Run("owner sees unchanged rows", func(t *testing.T) {
+		if got, err := repos.businesses.GetByID(ctxB, b.business.ID); err != nil || got == nil || got.Name != b.business.Name {
+			t.Errorf("business = %+v, %v; want unchanged", got, err)
+		}
+		if got, err := repos.locations.GetByID(ctxB, b.location.ID, b.business.ID); err != nil || got == nil || !got.IsActive || got.Name != b.location.Name {
+			t.Errorf("location = %+v, %v; want unchanged", got, err)
+		}
+		if got, err := repos.services.GetByID(ctxB, b.service.ID, b.business.ID); err != nil || got == nil || !got.IsActive || got.Name != b.service.Name {
+			t.Errorf("service = %+v, %v; want unchanged", got, err)
+		}
+		if got, err := repos.staff.GetStaffByID(ctxB, b.staff.ID, b.business.ID); err != nil || got == nil || got.Status != staff.StaffStatusActive || got.Title != b.staff.Title {
+			t.Errorf("staff = %+v, %v; want unchanged", got, err)
+		}
+		if got, err := repos.staff.GetInviteByID(ctxB, b.invite.ID, b.business.ID); err != nil || got == nil || got.RevokedAt != nil {
+			t.Errorf("invite = %+v, %v; want unchanged", got, err)
+		}
+		if got, err := repos.webhooks.GetEndpoint(ctxB, b.endpoint.ID, b.business.ID); err != nil || got.URL != b.endpoint.URL {
+			t.Errorf("endpoint = %+v, %v; want unchanged", got, err)
+		}
+		if list, err := repos.audit.ListByBusiness(ctxB, b.business.ID, audit.Filter{}, query); err != nil || len(list) != 1 {
+			t.Errorf("audit = %d rows, %v; want the seeded event only", len(list), err)
+		}
+	})
+
+	t.Run("unscoped bypass", func(t *testing.T) {
+		ctx := tenant.Unscoped(context.Background())
+		if got, err := repos.staff.GetInviteByToken(ctx, b.invite.Token); err != nil || got == nil || got.ID != b.invite.ID {
+			t.Errorf("GetInviteByToken = %+v, %v; want the invite", got, err)
+		}
+		if got, err := repos.locations.GetByID(ctx, b.location.ID, b.business.ID); err != nil || got == nil {
+			t.Errorf("location = %v, %v; want visible", got, err)
+		}
+	})
+}
+
+// seedTenant - yeni biznesi və onun sətirlərini həmin biznesin tenant kontekstində yaradır.
+// Sətirlər unikal id-lərlə yaradılır və silinmir: audit_events dəyişməzdir.
+func seedTenant(t *testing.T, repos tenantRepositories) *tenantFixture {
+	t.Helper()
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	owner := &auth.User{
+		ID:        uuid.New(),
+		Email:     "owner-" + uuid.NewString() + "@example.com",
+		FullName:  "Tenant Owner",
+		Role:      auth.UserTypeOwner,
+		IsActive:  true,
+		IsOwner:   true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := repos.users.CreateUser(context.Background(), owner); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	fixture := &tenantFixture{
+		business: business.NewBusiness("Tenant "+owner.ID.String()[:8], "beauty", "", "", business.BusinessTypeMulti),
+	}
+	fixture.business.OwnerID = owner.ID
+	ctx := tenant.WithBusinessID(context.Background(), fixture.business.ID)
+	businessID := fixture.business.ID
+
+	if err := repos.businesses.Create(ctx, fixture.business); err != nil {
+		t.Fatalf("create business: %v", err)
+	}
+
+	fixture.location = location.NewLocation(businessID, "Main")
+	if err := repos.locations.Create(ctx, fixture.location); err != nil {
+		t.Fatalf("create location: %v", err)
+	}
+
+	fixture.service = &service.Service{
+		ID:              uuid.New(),
+		BusinessID:      businessID,
+		Name:            "Haircut",
+		DurationMinutes: 30,
+		Price:           20,
+		IsActive:        true,
+		Version:         1,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := repos.services.Create(ctx, fixture.service); err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+
+	fixture.staff = staff.NewStaffProfile(owner.ID, businessID, staff.StaffRoleAdmin, "Owner")
+	fixture.staff.LocationID = &fixture.location.ID
+	if err := repos.staff.CreateStaffProfile(ctx, fixture.staff); err != nil {
+		t.Fatalf("create staff profile: %v", err)
+	}
+
+	fixture.invite = &staff.BusinessInvite{
+		ID:           uuid.New(),
+		BusinessID:   businessID,
+		InvitedEmail: "invitee-" + uuid.NewString() + "@example.com",
+		Role:         staff.StaffRoleStaff,
+		Token:        uuid.NewString(),
+		ExpiresAt:    now.Add(time.Hour),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := repos.staff.CreateInvite(ctx, fixture.invite); err != nil {
+		t.Fatalf("create invite: %v", err)
+	}
+
+	if err := repos.audit.Append(ctx, auditEvent(businessID, fixture.location.ID)); err != nil {
+		t.Fatalf("append audit event: %v", err)
+	}
+
+	fixture.endpoint = &webhook.Endpoint{
+		ID:         uuid.New(),
+		BusinessID: businessID,
+		URL:        "https://hooks.example.com/" + businessID.String(),
+		EventTypes: []events.Type{events.LocationCreated},
+		Secret:     "whsec_test",
+		IsActive:   true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := repos.webhooks.CreateEndpoint(ctx, fixture.endpoint); err != nil {
+		t.Fatalf("create webhook endpoint: %v", err)
+	}
+
+	fixture.delivery = &webhook.Delivery{
+		ID:            uuid.New(),
+		EndpointID:    fixture.endpoint.ID,
+		BusinessID:    businessID,
+		EventID:       uuid.New(),
+		EventType:     events.LocationCreated,
+		Payload:       []byte(`{}`),
+		Status:        webhook.DeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if _, err := repos.webhooks.CreateDelivery(ctx, fixture.delivery); err != nil {
+		t.Fatalf("create webhook delivery: %v", err)
+	}
+	return fixture
+}
+
+func auditEvent(businessID, entityID uuid.UUID) *audit.Event {
+	return &audit.Event{
+		ID:         uuid.New(),
+		BusinessID: businessID,
+		EntityType: audit.EntityLocation,
+		EntityID:   entityID,
+		Action:     audit.ActionCreated,
+		Changes:    audit.Changes{},
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// rowSecurityDB - policy-lərə tabe olan bağlantı. TEST_DATABASE_URL rolu superuser və ya BYPASSRLS-dirsə
+// adi rol yaradılır və hər bağlantı SET ROLE ilə ona keçir. Rol yaradıla bilmirsə test keçirilir.
+func rowSecurityDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	db := openTestDB(t)
+	bypassed, err := RowSecurityBypassed(context.Background(), db)
+	if err != nil {
+		t.Fatalf("read database role: %v", err)
+	}
+	if !bypassed {
+		return db
+	}
+
+	statements := []string{
+		`DO $$ BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = '` + rlsTestRole + `') THEN
+				CREATE ROLE ` + rlsTestRole + ` NOLOGIN NOSUPERUSER NOBYPASSRLS;
+			END IF;
+		END $$`,
+		`GRANT USAGE ON SCHEMA public TO ` + rlsTestRole,
+		`GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO ` + rlsTestRole,
+		`GRANT USAGE ON ALL SEQUENCES IN SCHEMA public TO ` + rlsTestRole,
+		`GRANT ` + rlsTestRole + ` TO CURRENT_USER`,
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Skipf("cannot prepare a role subject to row level security: %v", err)
+		}
+	}
+
+	connConfig, err := pgx.ParseConfig(os.Getenv("TEST_DATABASE_URL"))
+	if err != nil {
+		t.Fatalf("parse TEST_DATABASE_URL: %v", err)
+	}
+	roleDB := sqlx.NewDb(stdlib.OpenDB(*connConfig, stdlib.OptionAfterConnect(func(ctx context.Context, conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, "SET ROLE "+rlsTestRole)
+		return err
+	})), "pgx")
+	t.Cleanup(func() { roleDB.Close() })
+	return roleDB
+}
@@ -0,0 +1,85 @@
+// File: internal/infrastructure/postgres/tx_manager.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type txKey struct{}
+
+// dbExecutor - *sqlx.DB və *sqlx.Tx üçün ortaq metodlar. Query/QueryRow yoxdur: nəticəsi
+// tenant tranzaksiyası bağlandıqdan sonra oxunardı, Get/Select sətirləri tranzaksiya daxilində oxuyur.
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+// executor - ctx-də aktiv tranzaksiya varsa onu, yoxdursa pool-u qaytarır. Sorğular trace olunur.
+// ctx-də tenant və ya Unscoped varsa tranzaksiyadan kənar sorğular həmin parametrlərlə icra olunur (row-level security).
+func executor(ctx context.Context, db *sqlx.DB) dbExecutor {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tracedExecutor{inner: tx}
+	}
+	if scope, ok := scopeOf(ctx); ok {
+		return tenantExecutor{db: db, scope: scope}
+	}
+	return tracedExecutor{inner: db}
+}
+
+type TxManager struct {
+	db *sqlx.DB
+}
+
+func NewTxManager(db *sqlx.DB) *TxManager {
+	return &TxManager{db: db}
+}
+
+// WithinTransaction - fn xəta qaytarsa rollback, əks halda commit edir.
+// İç-içə çağırışlar xarici tranzaksiyaya qoşulur. Tenant tranzaksiya başlayanda ctx-dən götürülür;
+// iç çağırışın ctx-ində tenant varsa (məs. onboarding-də yeni biznes) tranzaksiyanın qalan hissəsi üçün yenidən qurulur.
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		if scope, ok := scopeOf(ctx); ok {
+			if err := setTenant(ctx, tracedExecutor{inner: tx}, scope); err != nil {
+				return err
+			}
+		}
+		return fn(ctx)
+	}
+
+	ctx, span := tracer.Start(ctx, "db.transaction", trace.WithAttributes(attribute.String("db.system", "postgresql")))
+	defer span.End()
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // nolint:errcheck
+
+	if scope, ok := scopeOf(ctx); ok {
+		if err := setTenant(ctx, tracedExecutor{inner: tx}, scope); err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		span.SetStatus(codes.Error, "rolled back")
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+// File: internal/infrastructure/postgres/webhook_repo.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/events"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/listing"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// WebhookRepository - endpoint secret-i FieldCipher ilə şifrələnir, oxunanda açılır
+type WebhookRepository struct {
+	db     *sqlx.DB
+	cipher FieldCipher
+}
+
+func NewWebhookRepository(db *sqlx.DB, cipher FieldCipher) *WebhookRepository {
+	return &WebhookRepository{db: db, cipher: cipher}
+}
+
+type webhookEndpointRow struct {
+	ID          uuid.UUID `db:"id"`
+	BusinessID  uuid.UUID `db:"business_id"`
+	URL         string    `db:"url"`
+	Description string    `db:"description"`
+	EventTypes  []byte    `db:"event_types"`
+	SecretEnc   string    `db:"secret_enc"`
+	IsActive    bool      `db:"is_active"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+const webhookEndpointColumns = `
+	id, business_id, url, description, event_types, secret_enc, is_active, created_at, updated_at`
+
+func (r *WebhookRepository) toEndpoint(row webhookEndpointRow) (*webhook.Endpoint, error) {
+	secret, err := r.cipher.Decrypt(row.SecretEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+	var eventTypes []events.Type
+	if err := json.Unmarshal(row.EventTypes, &eventTypes); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook event types: %w", err)
+	}
+	return &webhook.Endpoint{
+		ID:          row.ID,
+		BusinessID:  row.BusinessID,
+		URL:         row.URL,
+		Description: row.Description,
+		EventTypes:  eventTypes,
+		Secret:      secret,
+		IsActive:    row.IsActive,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}, nil
+}
+
+func (r *WebhookRepository) toEndpoints(rows []webhookEndpointRow) ([]*webhook.Endpoint, error) {
+	endpoints := make([]*webhook.Endpoint, 0, len(rows))
+	for _, row := range rows {
+		endpoint, err := r.toEndpoint(row)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+func (r *WebhookRepository) CreateEndpoint(ctx context.Context, endpoint *webhook.Endpoint) error {
+	secretEnc, err := r.cipher.Encrypt(endpoint.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+	eventTypes, err := json.Marshal(endpoint.EventTypes)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event types: %w", err)
+	}
+	query := `
+		INSERT INTO webhook_endpoints (id, business_id, url, description, event_types,
+		                               secret_enc, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err = executor(ctx, r.db).ExecContext(ctx, query,
+		endpoint.ID, endpoint.BusinessID, endpoint.URL, endpoint.Description, eventTypes,
+		secretEnc, endpoint.IsActive, endpoint.CreatedAt, endpoint.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepository) CountEndpoints(ctx context.Context, businessID uuid.UUID) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM webhook_endpoints WHERE business_id = $1`
+	if err := executor(ctx, r.db).GetContext(ctx, &count, query, businessID); err != nil {
+		return 0, fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+	return count, nil
+}
+
+func (r *WebhookRepository) GetEndpoint(ctx context.Context, id, businessID uuid.UUID) (*webhook.Endpoint, error) {
+	var row webhookEndpointRow
+	query := `SELECT` + webhookEndpointColumns + ` FROM webhook_endpoints WHERE id = $1 AND business_id = $2`
+	err := executor(ctx, r.db).GetContext(ctx, &row, query, id, businessID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, webhook.ErrEndpointNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+	return r.toEndpoint(row)
+}
+
+func (r *WebhookRepository) ListEndpoints(ctx context.Context, businessID uuid.UUID, q listing.Query) ([]*webhook.Endpoint, error) {
+	list := newListQuery("business_id = $1", businessID)
+	switch q.Status {
+	case webhook.StatusActive:
+		list.where("is_active = true")
+	case webhook.StatusInactive:
+		list.where("is_active = false")
+	}
+
+	query, args := list.build(`SELECT`+webhookEndpointColumns+` FROM webhook_endpoints`, q,
+		listColumns{id: "id", name: "url", createdAt: "created_at", search: []string{"url", "description"}})
+
+	var rows []webhookEndpointRow
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	return r.toEndpoints(rows)
+}
+
+func (r *WebhookRepository) ListSubscribed(ctx context.Context, businessID uuid.UUID, eventType events.Type) ([]*webhook.Endpoint, error) {
+	query := `SELECT` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE business_id = $1 AND is_active = true AND event_types @> to_jsonb($2::text)
+		ORDER BY created_at, id
+	`
+	var rows []webhookEndpointRow
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, businessID, string(eventType)); err != nil {
+		return nil, fmt.Errorf("failed to list subscribed webhook endpoints: %w", err)
+	}
+	return r.toEndpoints(rows)
+}
+
+func (r *WebhookRepository) UpdateEndpoint(ctx context.Context, endpoint *webhook.Endpoint) error {
+	eventTypes, err := json.Marshal(endpoint.EventTypes)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event types: %w", err)
+	}
+	query := `
+		UPDATE webhook_endpoints
+		SET url = $1, description = $2, event_types = $3, is_active = $4, updated_at = $5
+		WHERE id = $6 AND business_id = $7
+	`
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
+		endpoint.URL, endpoint.Description, eventTypes, endpoint.IsActive, endpoint.UpdatedAt,
+		endpoint.ID, endpoint.BusinessID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	return endpointAffected(result)
+}
+
+func (r *WebhookRepository) DeleteEndpoint(ctx context.Context, id, businessID uuid.UUID) error {
+	query := `DELETE FROM webhook_endpoints WHERE id = $1 AND business_id = $2`
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id, businessID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	return endpointAffected(result)
+}
+
+func endpointAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return webhook.ErrEndpointNotFound
+	}
+	return nil
+}
+
+type webhookDeliveryRow struct {
+	ID             uuid.UUID      `db:"id"`
+	EndpointID     uuid.UUID      `db:"endpoint_id"`
+	BusinessID     uuid.UUID      `db:"business_id"`
+	EventID        uuid.UUID      `db:"event_id"`
+	EventType      string         `db:"event_type"`
+	Payload        []byte         `db:"payload"`
+	Status         string         `db:"status"`
+	Attempts       int            `db:"attempts"`
+	NextAttemptAt  *time.Time     `db:"next_attempt_at"`
+	ResponseStatus *int           `db:"response_status"`
+	ResponseBody   sql.NullString `db:"response_body"`
+	LastError      sql.NullString `db:"last_error"`
+	DurationMs     *int64         `db:"duration_ms"`
+	RedeliveryOf   *uuid.UUID     `db:"redelivery_of"`
+	DeliveredAt    *time.Time     `db:"delivered_at"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+const webhookDeliveryColumns = `
+	id, endpoint_id, business_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+	response_status, response_body, last_error, duration_ms, redelivery_of, delivered_at, created_at, updated_at`
+
+func (row webhookDeliveryRow) toDelivery() *webhook.Delivery {
+	return &webhook.Delivery{
+		ID:             row.ID,
+		EndpointID:     row.EndpointID,
+		BusinessID:     row.BusinessID,
+		EventID:        row.EventID,
+		EventType:      events.Type(row.EventType),
+		Payload:        row.Payload,
+		Status:         webhook.DeliveryStatus(row.Status),
+		Attempts:       row.Attempts,
+		NextAttemptAt:  row.NextAttemptAt,
+		ResponseStatus: row.ResponseStatus,
+		ResponseBody:   row.ResponseBody.String,
+		LastError:      row.LastError.String,
+		DurationMs:     row.DurationMs,
+		RedeliveryOf:   row.RedeliveryOf,
+		DeliveredAt:    row.DeliveredAt,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
+
+// CreateDelivery - (endpoint_id, event_id) unikal indeksi relay-in təkrar çatdırdığı hadisəni süzür
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *webhook.Delivery) (bool, error) {
+	query := `
+		INSERT INTO webhook_deliveries (id, endpoint_id, business_id, event_id, event_type, payload,
+		                                status, attempts, next_attempt_at, redelivery_of, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (endpoint_id, event_id) WHERE redelivery_of IS NULL DO NOTHING
+	`
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
+		delivery.ID, delivery.EndpointID, delivery.BusinessID, delivery.EventID, string(delivery.EventType),
+		delivery.Payload, string(delivery.Status), delivery.Attempts, delivery.NextAttemptAt,
+		delivery.RedeliveryOf, delivery.CreatedAt, delivery.UpdatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert webhook delivery: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return inserted > 0, nil
+}
+
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id, businessID uuid.UUID) (*webhook.Delivery, error) {
+	var row webhookDeliveryRow
+	query := `SELECT` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE id = $1 AND business_id = $2`
+	err := executor(ctx, r.db).GetContext(ctx, &row, query, id, businessID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, webhook.ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	return row.toDelivery(), nil
+}
+
+func (r *WebhookRepository) ListDeliveries(
+	ctx context.Context,
+	endpointID, businessID uuid.UUID,
+	q listing.Query,
+) ([]*webhook.Delivery, error) {
+	list := newListQuery("endpoint_id = $1", endpointID)
+	list.where("business_id = ?", businessID)
+	if q.Status != "" && q.Status != listing.StatusAll {
+		list.where("status = ?", q.Status)
+	}
+
+	query, args := list.build(`SELECT`+webhookDeliveryColumns+` FROM webhook_deliveries`, q,
+		listColumns{id: "id", createdAt: "created_at"})
+
+	var rows []webhookDeliveryRow
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	deliveries := make([]*webhook.Delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, row.toDelivery())
+	}
+	return deliveries, nil
+}
+
+type webhookTargetRow struct {
+	webhookDeliveryRow
+	URL       string `db:"url"`
+	SecretEnc string `db:"secret_enc"`
+}
+
+// ClaimDue - yalnız aktiv endpoint-lərin çatdırılmaları götürülür; deaktiv endpoint-in
+// çatdırılmaları pending qalır və endpoint yenidən aktiv olanda göndərilir
+func (r *WebhookRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration, now time.Time) ([]*webhook.Target, error) {
+	query := `
+		WITH claimed AS (
+			UPDATE webhook_deliveries
+			SET locked_until = $2
+			WHERE id IN (
+				SELECT d.id FROM webhook_deliveries d
+				JOIN webhook_endpoints e ON e.id = d.endpoint_id
+				WHERE d.status = 'pending'
+				  AND e.is_active = true
+				  AND d.next_attempt_at <= $1
+				  AND (d.locked_until IS NULL OR d.locked_until <= $1)
+				ORDER BY d.next_attempt_at, d.id
+				LIMIT $3
+				FOR UPDATE OF d SKIP LOCKED
+			)
+			RETURNING` + webhookDeliveryColumns + `
+		)
+		SELECT claimed.*, e.url, e.secret_enc
+		FROM claimed
+		JOIN webhook_endpoints e ON e.id = claimed.endpoint_id
+	`
+	var rows []webhookTargetRow
+	if err := executor(ctx, r.db).SelectContext(ctx, &rows, query, now, now.Add(lease), limit); err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	targets := make([]*webhook.Target, 0, len(rows))
+	for _, row := range rows {
+		secret, err := r.cipher.Decrypt(row.SecretEnc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt webhook secret: %w", err)
+		}
+		targets = append(targets, &webhook.Target{
+			Delivery: row.toDelivery(),
+			URL:      row.URL,
+			Secret:   secret,
+		})
+	}
+	// RETURNING sırası zəmanətli deyil
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].Delivery.CreatedAt.Before(targets[j].Delivery.CreatedAt)
+	})
+	return targets, nil
+}
+
+func (r *WebhookRepository) SaveAttempt(ctx context.Context, delivery *webhook.Delivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, locked_until = NULL,
+		    response_status = $5, response_body = $6, last_error = $7, duration_ms = $8,
+		    delivered_at = $9, updated_at = $10
+		WHERE id = $1
+	`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		delivery.ID, string(delivery.Status), delivery.Attempts, delivery.NextAttemptAt,
+		delivery.ResponseStatus, nullIfEmpty(delivery.ResponseBody), nullIfEmpty(delivery.LastError), delivery.DurationMs,
+		delivery.DeliveredAt, delivery.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery attempt: %w", err)
+	}
+	return nil
+}
+
+// PendingDeliveries - aktiv endpoint-lərə göndərilməyi gözləyən çatdırılmalar (job_queue_depth metriki)
+func (r *WebhookRepository) PendingDeliveries(ctx context.Context) (int, error) {
+	var count int
+	query := `
+		SELECT COUNT(*) FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		WHERE d.status = 'pending' AND e.is_active = true
+	`
+	if err := executor(ctx, r.db).GetContext(ctx, &count, query); err != nil {
+		return 0, fmt.Errorf("failed to count pending webhook deliveries: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,57 @@
+// File: internal/infrastructure/ratelimit/memory_store.go
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/ratelimit"
+)
+
+// sweepInterval - tam dolmuş (artıq təsiri olmayan) bucket-lər bu intervalla silinir
+const sweepInterval = time.Minute
+
+type memoryBucket struct {
+	bucket  domain.Bucket
+	resetAt time.Time
+}
+
+// MemoryStore - proses daxilində bucket-lər; hər API instansının öz kvotası olur
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit domain.Limit, now time.Time) (domain.Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	entry, ok := s.buckets[key]
+	if !ok {
+		entry = &memoryBucket{bucket: limit.NewBucket(now)}
+		s.buckets[key] = entry
+	}
+	bucket, decision := limit.Take(entry.bucket, now)
+	entry.bucket = bucket
+	entry.resetAt = now.Add(decision.ResetAfter)
+	return decision, nil
+}
+
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, entry := range s.buckets {
+		if !now.Before(entry.resetAt) {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
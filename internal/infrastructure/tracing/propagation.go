@@ -0,0 +1,36 @@
+// File: internal/infrastructure/tracing/propagation.go
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Carrier - job və ya outbox sətrində saxlanan trace context (traceparent, tracestate)
+type Carrier map[string]string
+
+// Inject - cari span-ın kontekstini job ilə birlikdə saxlamaq üçün çıxarır
+func Inject(ctx context.Context) Carrier {
+	carrier := Carrier{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(carrier))
+	return carrier
+}
+
+// StartLinkedSpan - worker job-u üçün yeni root span açır. Carrier-də sorğunun span-ı varsa
+// ona link əlavə olunur: job ayrıca trace-dir, amma başladığı HTTP sorğusuna geri bağlanır.
+func StartLinkedSpan(ctx context.Context, tracerName, spanName string, carrier Carrier) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithNewRoot(),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+	}
+	if len(carrier) > 0 {
+		remote := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(carrier))
+		if sc := trace.SpanContextFromContext(remote); sc.IsValid() {
+			opts = append(opts, trace.WithLinks(trace.Link{SpanContext: sc}))
+		}
+	}
+	return otel.Tracer(tracerName).Start(ctx, spanName, opts...)
+}
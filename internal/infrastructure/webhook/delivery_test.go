@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OrkhanNajaf1i/booking-service/internal/config"
+	"github.com/OrkhanNajaf1i/booking-service/internal/domain/audit"
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+	"github.com/OrkhanNajaf1i/booking-service/internal/logger"
+	"github.com/google/uuid"
+)
+
+const testSecret = "whsec_test"
+
+// deliveryRepository - ClaimDue bir dəfə hədəfləri verir, SaveAttempt nəticəni saxlayır.
+// Qalan metodlar çağırılmır (embed olunmuş nil interfeys panic edər).
+type deliveryRepository struct {
+	domain.Repository
+
+	mu      sync.Mutex
+	targets []*domain.Target
+	saved   []domain.Delivery
+}
+
+func (r *deliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration, now time.Time) ([]*domain.Target, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	targets := r.targets
+	r.targets = nil
+	return targets, nil
+}
+
+func (r *deliveryRepository) SaveAttempt(ctx context.Context, delivery *domain.Delivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saved = append(r.saved, *delivery)
+	return nil
+}
+
+type inlineTx struct{}
+
+func (inlineTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// deliverOnce - hədəfi real HTTPSender ilə bir dəfə göndərir və saxlanmış nəticəni qaytarır
+func deliverOnce(t *testing.T, url string, attempts int, timeout time.Duration) domain.Delivery {
+	t.Helper()
+	appLogger, err := logger.New(&config.AppConfig{LogLevel: "error"})
+	if err != nil {
+		t.Fatalf("logger: %v", err)
+	}
+	repo := &deliveryRepository{targets: []*domain.Target{{
+		Delivery: &domain.Delivery{
+			ID:         uuid.New(),
+			EndpointID: uuid.New(),
+			BusinessID: uuid.New(),
+			EventID:    uuid.New(),
+			EventType:  "booking.created",
+			Payload:    []byte(`{"id":"evt","type":"booking.created"}`),
+			Status:     domain.DeliveryPending,
+			Attempts:   attempts,
+		},
+		URL:    url,
+		Secret: testSecret,
+	}}}
+	service := domain.NewService(repo, inlineTx{}, NewHTTPSender(timeout, true), appLogger, audit.Nop())
+
+	claimed, err := service.DeliverDue(context.Background(), 10, time.Minute)
+	if err != nil || claimed != 1 {
+		t.Fatalf("DeliverDue = %d, %v; want 1 claimed", claimed, err)
+	}
+	if len(repo.saved) != 1 {
+		t.Fatalf("saved %d attempts, want 1", len(repo.saved))
+	}
+	return repo.saved[0]
+}
+
+func TestDeliverySignsPayload(t *testing.T) {
+	var (
+		verified bool
+		headers  http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		headers = r.Header.Clone()
+		verified = domain.Verify(testSecret, r.Header.Get(domain.SignatureHeader), body, time.Now())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	delivery := deliverOnce(t, server.URL, 0, time.Second)
+
+	if !verified {
+		t.Fatalf("signature %q does not verify with the endpoint secret", headers.Get(domain.SignatureHeader))
+	}
+	if headers.Get(domain.IDHeader) != delivery.EventID.String() || headers.Get(domain.EventHeader) != "booking.created" {
+		t.Errorf("headers = %v, want event id and type", headers)
+	}
+	if delivery.Status != domain.DeliverySucceeded || delivery.Attempts != 1 || delivery.DeliveredAt == nil || delivery.NextAttemptAt != nil {
+		t.Errorf("delivery = %+v, want succeeded after one attempt", delivery)
+	}
+	if delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusNoContent {
+		t.Errorf("response status = %v, want 204", delivery.ResponseStatus)
+	}
+}
+
+func TestDeliveryRetriesServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	before := time.Now().UTC()
+	delivery := deliverOnce(t, server.URL, 2, time.Second)
+
+	if delivery.Status != domain.DeliveryPending || delivery.Attempts != 3 || delivery.LastError == "" {
+		t.Fatalf("delivery = %+v, want pending with an error after the third attempt", delivery)
+	}
+	assertRetryAt(t, delivery, before, domain.Backoff(3))
+}
+
+func TestDeliveryRetriesTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	before := time.Now().UTC()
+	delivery := deliverOnce(t, server.URL, 0, 50*time.Millisecond)
+
+	if delivery.Status != domain.DeliveryPending || delivery.Attempts != 1 || delivery.LastError == "" || delivery.ResponseStatus != nil {
+		t.Fatalf("delivery = %+v, want pending with a transport error and no status", delivery)
+	}
+	assertRetryAt(t, delivery, before, domain.Backoff(1))
+}
+
+func TestDeliveryFailsAfterMaxAttempts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	delivery := deliverOnce(t, server.URL, domain.MaxAttempts-1, time.Second)
+
+	if delivery.Status != domain.DeliveryFailed || delivery.Attempts != domain.MaxAttempts || delivery.NextAttemptAt != nil {
+		t.Fatalf("delivery = %+v, want failed with no further attempt", delivery)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		8:  64 * time.Minute,
+		10: 256 * time.Minute,
+		11: 6 * time.Hour,
+		50: 6 * time.Hour,
+	}
+	for attempts, want := range cases {
+		if got := domain.Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+func assertRetryAt(t *testing.T, delivery domain.Delivery, before time.Time, backoff time.Duration) {
+	t.Helper()
+	if delivery.NextAttemptAt == nil {
+		t.Fatal("next attempt is not scheduled")
+	}
+	earliest, latest := before.Add(backoff), time.Now().UTC().Add(backoff)
+	if delivery.NextAttemptAt.Before(earliest) || delivery.NextAttemptAt.After(latest) {
+		t.Errorf("next attempt = %v, want within [%v, %v]", delivery.NextAttemptAt, earliest, latest)
+	}
+}
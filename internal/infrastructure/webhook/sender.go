@@ -0,0 +1,108 @@
+// File: internal/infrastructure/webhook/sender.go
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+)
+
+// maxResponseBody - jurnalda saxlanan cavab gövdəsinin həcmi
+const maxResponseBody = 2048
+
+var errForbiddenAddress = errors.New("destination address is not allowed")
+
+// HTTPSender - redirect-ləri izləmir (3xx uğursuz sayılır). allowPrivate false olduqda
+// loopback, private, link-local və s. ünvanlara qoşulma dial zamanı bloklanır (SSRF, DNS rebinding).
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(timeout time.Duration, allowPrivate bool) *HTTPSender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+				return fmt.Errorf("%w: %s", errForbiddenAddress, host)
+			}
+			return nil
+		}
+	}
+
+	transport := &http.Transport{
+		// Proxy yoxdur: proxy üzərindən daxili ünvan yoxlaması keçilə bilər
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConns:          20,
+		IdleConnTimeout:       90 * time.Second,
+	}
+
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, req domain.Request) domain.Attempt {
+	start := time.Now()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return domain.Attempt{Err: fmt.Errorf("invalid webhook request: %w", err), Duration: time.Since(start)}
+	}
+	for key, value := range req.Headers {
+		httpReq.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return domain.Attempt{Err: err, Duration: time.Since(start)}
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	// Qalan gövdə oxunur ki, bağlantı yenidən istifadə olunsun
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	return domain.Attempt{
+		StatusCode: resp.StatusCode,
+		Body:       validUTF8(body),
+		Duration:   time.Since(start),
+	}
+}
+
+func publicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || isSharedAddress(ip))
+}
+
+// isSharedAddress - carrier-grade NAT (100.64.0.0/10) da daxili şəbəkə sayılır
+func isSharedAddress(ip net.IP) bool {
+	ip4 := ip.To4()
+	return ip4 != nil && ip4[0] == 100 && ip4[1]&0xc0 == 64
+}
+
+// validUTF8 - kəsilmiş gövdə TEXT sütununa yazıla bilməsi üçün etibarsız baytlardan təmizlənir
+func validUTF8(body []byte) string {
+	return string(bytes.ToValidUTF8(bytes.ReplaceAll(body, []byte{0}, nil), []byte("\uFFFD")))
+}
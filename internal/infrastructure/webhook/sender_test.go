@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/OrkhanNajaf1i/booking-service/internal/domain/webhook"
+)
+
+// Testlər httptest serverinə (127.0.0.1) göndərir: allowPrivate=true loopback bloklamasını söndürür,
+// production-da bu APP_WEBHOOK_ALLOW_PRIVATE_NETWORKS ilə idarə olunur.
+
+func TestHTTPSenderSendsRequest(t *testing.T) {
+	var (
+		gotMethod  string
+		gotHeaders http.Header
+		gotBody    string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotMethod, gotHeaders, gotBody = r.Method, r.Header.Clone(), string(body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	sender := NewHTTPSender(time.Second, true)
+	attempt := sender.Send(context.Background(), domain.Request{
+		URL:     server.URL,
+		Headers: map[string]string{domain.SignatureHeader: "t=1,v1=abc", "Content-Type": "application/json"},
+		Body:    []byte(`{"id":1}`),
+	})
+
+	if !attempt.Succeeded() || attempt.StatusCode != http.StatusAccepted || attempt.Body != "ok" {
+		t.Fatalf("attempt = %+v, want 202 ok", attempt)
+	}
+	if gotMethod != http.MethodPost || gotBody != `{"id":1}` {
+		t.Errorf("request = %s %q, want POST with the payload", gotMethod, gotBody)
+	}
+	if gotHeaders.Get(domain.SignatureHeader) != "t=1,v1=abc" || gotHeaders.Get("Content-Type") != "application/json" {
+		t.Errorf("headers = %v, want signature and content type", gotHeaders)
+	}
+}
+
+func TestHTTPSenderReportsServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(strings.Repeat("x", maxResponseBody*2)))
+	}))
+	defer server.Close()
+
+	attempt := NewHTTPSender(time.Second, true).Send(context.Background(), domain.Request{URL: server.URL})
+
+	if attempt.Succeeded() || attempt.Err != nil || attempt.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("attempt = %+v, want a 503 without transport error", attempt)
+	}
+	if len(attempt.Body) != maxResponseBody {
+		t.Errorf("body length = %d, want it truncated to %d", len(attempt.Body), maxResponseBody)
+	}
+}
+
+func TestHTTPSenderDoesNotFollowRedirects(t *testing.T) {
+	followed := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/elsewhere", http.StatusFound)
+	})
+	mux.HandleFunc("/elsewhere", func(w http.ResponseWriter, r *http.Request) {
+		followed = true
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	attempt := NewHTTPSender(time.Second, true).Send(context.Background(), domain.Request{URL: server.URL + "/hook"})
+
+	if attempt.Succeeded() || attempt.StatusCode != http.StatusFound || followed {
+		t.Fatalf("attempt = %+v, followed = %v; want an unfollowed 302", attempt, followed)
+	}
+}
+
+func TestHTTPSenderTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	attempt := NewHTTPSender(50*time.Millisecond, true).Send(context.Background(), domain.Request{URL: server.URL})
+
+	if attempt.Succeeded() || attempt.Err == nil || attempt.StatusCode != 0 {
+		t.Fatalf("attempt = %+v, want a transport error", attempt)
+	}
+}
+
+func TestHTTPSenderBlocksPrivateAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	attempt := NewHTTPSender(time.Second, false).Send(context.Background(), domain.Request{URL: server.URL})
+
+	if !errors.Is(attempt.Err, errForbiddenAddress) || called {
+		t.Fatalf("attempt = %+v, called = %v; want loopback blocked at dial", attempt, called)
+	}
+}
+
+func TestPublicIP(t *testing.T) {
+	cases := map[string]bool{
+		"8.8.8.8":         true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"fd00::1":         false,
+	}
+	for address, want := range cases {
+		if got := publicIP(net.ParseIP(address)); got != want {
+			t.Errorf("publicIP(%s) = %v, want %v", address, got, want)
+		}
+	}
+}
@@ -19,6 +19,31 @@ type Logger interface {
 	Debug(msg string, fields ...Field)
 	Error(msg string, fields ...Field)
 	Warn(msg string, fields ...Field)
+	// WithContext - context-ə yığılmış sahələri (request_id, user_id...) əlavə edir
+	WithContext(ctx context.Context) Logger
+	WithFields(fields ...Field) Logger
+}
+
+type contextFieldsKey struct{}
+
+// ContextWithFields - sonrakı WithContext çağırışlarında loga düşəcək sahələri context-ə yazır
+func ContextWithFields(ctx context.Context, fields ...Field) context.Context {
+	existing, _ := ctx.Value(contextFieldsKey{}).([]Field)
+	merged := make([]Field, 0, len(existing)+len(fields))
+	merged = append(merged, existing...)
+	merged = append(merged, fields...)
+	return context.WithValue(ctx, contextFieldsKey{}, merged)
+}
+
+// FieldFromContext - context-də saxlanmış sahənin dəyəri (məs. request_id)
+func FieldFromContext(ctx context.Context, key string) (any, bool) {
+	fields, _ := ctx.Value(contextFieldsKey{}).([]Field)
+	for i := len(fields) - 1; i >= 0; i-- {
+		if fields[i].Key == key {
+			return fields[i].Value, true
+		}
+	}
+	return nil, false
 }
 
 type slogLogger struct {
@@ -68,8 +93,11 @@ func (s *slogLogger) WithFields(fields ...Field) Logger {
 }
 
 func (s *slogLogger) WithContext(ctx context.Context) Logger {
-	if requestID := ctx.Value("request_id"); requestID != nil {
-		return s.WithFields(Field{Key: "request_id", Value: requestID})
+	if ctx == nil {
+		return s
+	}
+	if fields, _ := ctx.Value(contextFieldsKey{}).([]Field); len(fields) > 0 {
+		return s.WithFields(fields...)
 	}
 	return s
 }
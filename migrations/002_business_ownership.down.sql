@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_businesses_promote_co_owner ON businesses;
+DROP FUNCTION IF EXISTS promote_business_co_owner();
+DROP TABLE IF EXISTS ownership_transfers;
+DROP TABLE IF EXISTS business_owners;
@@ -0,0 +1,65 @@
+-- File: migrations/002_business_ownership.up.sql
+
+CREATE TABLE business_owners (
+    business_id UUID NOT NULL REFERENCES businesses(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'co_owner')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (business_id, user_id)
+);
+
+CREATE TABLE ownership_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    business_id UUID NOT NULL REFERENCES businesses(id) ON DELETE CASCADE,
+    from_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(500) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'cancelled')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO business_owners (business_id, user_id, role, created_at)
+SELECT id, owner_id, 'owner', created_at
+FROM businesses
+WHERE owner_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- Biznesin sahibi silinəndə (users ON DELETE SET NULL) ən köhnə co-owner sahib olur
+CREATE OR REPLACE FUNCTION promote_business_co_owner() RETURNS TRIGGER AS $$
+DECLARE
+    successor UUID;
+BEGIN
+    IF NEW.owner_id IS NULL AND OLD.owner_id IS NOT NULL THEN
+        DELETE FROM business_owners
+        WHERE business_id = NEW.id AND user_id = OLD.owner_id;
+
+        SELECT user_id INTO successor
+        FROM business_owners
+        WHERE business_id = NEW.id AND role = 'co_owner'
+        ORDER BY created_at ASC
+        LIMIT 1;
+
+        IF successor IS NOT NULL THEN
+            UPDATE business_owners
+            SET role = 'owner'
+            WHERE business_id = NEW.id AND user_id = successor;
+
+            NEW.owner_id := successor;
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_businesses_promote_co_owner
+    BEFORE UPDATE OF owner_id ON businesses
+    FOR EACH ROW
+    EXECUTE FUNCTION promote_business_co_owner();
+
+CREATE UNIQUE INDEX idx_business_owners_primary ON business_owners(business_id) WHERE role = 'owner';
+CREATE INDEX idx_business_owners_user_id ON business_owners(user_id);
+
+CREATE UNIQUE INDEX idx_ownership_transfers_pending ON ownership_transfers(business_id) WHERE status = 'pending';
+CREATE INDEX idx_ownership_transfers_token ON ownership_transfers(token);
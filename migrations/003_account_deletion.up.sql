@@ -0,0 +1,5 @@
+-- File: migrations/003_account_deletion.up.sql
+
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
@@ -0,0 +1,6 @@
+-- Şifrəli dəyərlər geri açılmır: phone TEXT olaraq qalır, hourly_rate_enc silinməzdən əvvəl
+-- dəyərlər tətbiq tərəfindən deşifrə edilməlidir.
+ALTER TABLE staff_profiles DROP COLUMN IF EXISTS hourly_rate_enc;
+
+DROP INDEX IF EXISTS idx_users_phone_bidx;
+ALTER TABLE users DROP COLUMN IF EXISTS phone_bidx;
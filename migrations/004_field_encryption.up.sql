@@ -0,0 +1,8 @@
+-- File: migrations/004_field_encryption.up.sql
+-- Mövcud açıq dəyərlər worker-dəki re-encryption job tərəfindən şifrələnir
+
+ALTER TABLE users ALTER COLUMN phone TYPE TEXT;
+ALTER TABLE users ADD COLUMN phone_bidx VARCHAR(64);
+CREATE INDEX idx_users_phone_bidx ON users(phone_bidx);
+
+ALTER TABLE staff_profiles ADD COLUMN hourly_rate_enc TEXT;
@@ -0,0 +1,2 @@
+-- Hash-lənmiş tokenlər geri açıq mətnə çevrilə bilməz
+ALTER TABLE business_invites DROP COLUMN IF EXISTS revoked_at;
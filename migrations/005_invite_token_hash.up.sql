@@ -0,0 +1,8 @@
+-- File: migrations/005_invite_token_hash.up.sql
+-- Dəvət tokenləri PasswordReset kimi yalnız SHA-256 hash şəklində saxlanılır.
+-- Bu migrasiyadan əvvəlki bütün tokenlər açıq mətndir.
+
+UPDATE business_invites
+SET token = encode(sha256(convert_to(token, 'UTF8')), 'hex');
+
+ALTER TABLE business_invites ADD COLUMN revoked_at TIMESTAMPTZ;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS staff_services;
+DROP TABLE IF EXISTS services;
+ALTER TABLE staff_profiles DROP CONSTRAINT IF EXISTS uq_staff_profiles_id_business;
+ALTER TABLE locations DROP COLUMN IF EXISTS phone;
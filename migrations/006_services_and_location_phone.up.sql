@@ -0,0 +1,37 @@
+-- File: migrations/006_services_and_location_phone.up.sql
+
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS phone VARCHAR(50);
+
+-- staff_services-də biznes uyğunluğunu FK ilə təmin etmək üçün
+ALTER TABLE staff_profiles
+    ADD CONSTRAINT uq_staff_profiles_id_business UNIQUE (id, business_id);
+
+CREATE TABLE IF NOT EXISTS services (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    business_id UUID NOT NULL REFERENCES businesses(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    duration_minutes INTEGER NOT NULL CHECK (duration_minutes > 0),
+    price NUMERIC(10, 2) NOT NULL DEFAULT 0 CHECK (price >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_services_id_business UNIQUE (id, business_id)
+);
+
+-- Deaktiv edilmiş xidmətin adı yenidən istifadə oluna bilər
+CREATE UNIQUE INDEX IF NOT EXISTS idx_services_business_name
+    ON services(business_id, LOWER(name)) WHERE is_active;
+CREATE INDEX IF NOT EXISTS idx_services_business_id ON services(business_id);
+
+CREATE TABLE IF NOT EXISTS staff_services (
+    staff_id UUID NOT NULL,
+    business_id UUID NOT NULL,
+    service_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (staff_id, business_id, service_id),
+    FOREIGN KEY (staff_id, business_id) REFERENCES staff_profiles(id, business_id) ON DELETE CASCADE,
+    FOREIGN KEY (service_id, business_id) REFERENCES services(id, business_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_staff_services_service_id ON staff_services(service_id);
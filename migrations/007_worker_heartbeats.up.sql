@@ -0,0 +1,12 @@
+-- File: migrations/007_worker_heartbeats.up.sql
+-- Worker prosesləri hər poll intervalında sətrini yeniləyir; API /readyz job queue-nu buradan yoxlayır
+
+CREATE TABLE IF NOT EXISTS worker_heartbeats (
+    worker_id      VARCHAR(255) PRIMARY KEY,
+    hostname       VARCHAR(255) NOT NULL,
+    jobs_in_flight INT NOT NULL DEFAULT 0,
+    started_at     TIMESTAMPTZ NOT NULL,
+    last_seen_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_worker_heartbeats_last_seen ON worker_heartbeats(last_seen_at);
@@ -0,0 +1,5 @@
+-- File: migrations/008_user_locale.up.sql
+-- İstifadəçinin dil seçimi; NULL olduqda Accept-Language header-i istifadə olunur
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale VARCHAR(5)
+    CHECK (locale IN ('az', 'en', 'ru'));
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_business_invites_business_created;
+DROP INDEX IF EXISTS idx_staff_profiles_business_created;
+DROP INDEX IF EXISTS idx_services_business_created;
+DROP INDEX IF EXISTS idx_locations_business_created;
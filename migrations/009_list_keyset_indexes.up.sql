@@ -0,0 +1,7 @@
+-- File: migrations/009_list_keyset_indexes.up.sql
+-- Siyahı endpoint-lərində keyset pagination (created_at, id) üzrə sıralanır
+
+CREATE INDEX IF NOT EXISTS idx_locations_business_created ON locations(business_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_services_business_created ON services(business_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_staff_profiles_business_created ON staff_profiles(business_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_business_invites_business_created ON business_invites(business_id, created_at, id);
@@ -0,0 +1,4 @@
+ALTER TABLE staff_profiles DROP COLUMN IF EXISTS version;
+ALTER TABLE services DROP COLUMN IF EXISTS version;
+ALTER TABLE locations DROP COLUMN IF EXISTS version;
+ALTER TABLE businesses DROP COLUMN IF EXISTS version;
@@ -0,0 +1,7 @@
+-- File: migrations/010_resource_versions.up.sql
+-- Optimistic concurrency: hər yeniləmə versiyanı artırır, ETag/If-Match bu sütuna əsaslanır
+
+ALTER TABLE businesses ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE services ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE staff_profiles ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
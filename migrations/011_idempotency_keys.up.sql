@@ -0,0 +1,18 @@
+-- File: migrations/011_idempotency_keys.up.sql
+-- Idempotency-Key ilə gələn POST sorğularının cavabları 24 saat saxlanır; status_code NULL - sorğu hələ icra olunur
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id          UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    key              VARCHAR(255) NOT NULL,
+    method           VARCHAR(10) NOT NULL,
+    path             TEXT NOT NULL,
+    fingerprint      CHAR(64) NOT NULL,
+    status_code      INT,
+    response_headers JSONB,
+    response_body    BYTEA,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at       TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
@@ -0,0 +1,10 @@
+-- File: migrations/012_rate_limit_buckets.up.sql
+-- APP_RATE_LIMIT_BACKEND=postgres olduqda token bucket-lər API instansları arasında burada paylaşılır
+
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key        VARCHAR(255) PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_updated_at ON rate_limit_buckets(updated_at);
@@ -0,0 +1,33 @@
+DROP POLICY IF EXISTS tenant_isolation ON staff_services;
+ALTER TABLE staff_services NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE staff_services DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON services;
+ALTER TABLE services NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE services DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON business_invites;
+ALTER TABLE business_invites NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE business_invites DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON staff_profiles;
+ALTER TABLE staff_profiles NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE staff_profiles DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON locations;
+ALTER TABLE locations NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE locations DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON ownership_transfers;
+ALTER TABLE ownership_transfers NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE ownership_transfers DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON business_owners;
+ALTER TABLE business_owners NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE business_owners DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON businesses;
+ALTER TABLE businesses NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE businesses DISABLE ROW LEVEL SECURITY;
+
+DROP FUNCTION IF EXISTS app_current_business_id();
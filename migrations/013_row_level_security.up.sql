@@ -0,0 +1,66 @@
+-- File: migrations/013_row_level_security.up.sql
+-- Tenant izolyasiyası: tətbiq hər tranzaksiyada app.business_id qurur, policy başqa biznesin sətirlərini gizlədir
+-- və yazmağa icazə vermir. app.business_id qurulmayıbsa (worker, login, token ilə axınlar) policy məhdudlaşdırmır;
+-- 019_fail_closed_row_level_security bunu app.bypass_rls ilə açıq icazəyə çevirir.
+-- FORCE cədvəl sahibinə də tətbiq edir; superuser və BYPASSRLS rolları isə policy-dən kənardadır.
+
+CREATE OR REPLACE FUNCTION app_current_business_id() RETURNS UUID
+LANGUAGE sql STABLE AS $$
+    SELECT NULLIF(current_setting('app.business_id', true), '')::uuid
+$$;
+
+ALTER TABLE businesses ENABLE ROW LEVEL SECURITY;
+ALTER TABLE businesses FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON businesses;
+CREATE POLICY tenant_isolation ON businesses
+    USING (app_current_business_id() IS NULL OR id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR id = app_current_business_id());
+
+ALTER TABLE business_owners ENABLE ROW LEVEL SECURITY;
+ALTER TABLE business_owners FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON business_owners;
+CREATE POLICY tenant_isolation ON business_owners
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+ALTER TABLE ownership_transfers ENABLE ROW LEVEL SECURITY;
+ALTER TABLE ownership_transfers FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON ownership_transfers;
+CREATE POLICY tenant_isolation ON ownership_transfers
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+ALTER TABLE locations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE locations FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON locations;
+CREATE POLICY tenant_isolation ON locations
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+ALTER TABLE staff_profiles ENABLE ROW LEVEL SECURITY;
+ALTER TABLE staff_profiles FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON staff_profiles;
+CREATE POLICY tenant_isolation ON staff_profiles
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+ALTER TABLE business_invites ENABLE ROW LEVEL SECURITY;
+ALTER TABLE business_invites FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON business_invites;
+CREATE POLICY tenant_isolation ON business_invites
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+ALTER TABLE services ENABLE ROW LEVEL SECURITY;
+ALTER TABLE services FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON services;
+CREATE POLICY tenant_isolation ON services
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+ALTER TABLE staff_services ENABLE ROW LEVEL SECURITY;
+ALTER TABLE staff_services FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON staff_services;
+CREATE POLICY tenant_isolation ON staff_services
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
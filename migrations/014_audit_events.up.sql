@@ -0,0 +1,43 @@
+-- File: migrations/014_audit_events.up.sql
+-- İnzibati əməliyyatların jurnalı. Qeydlər yalnız əlavə olunur: UPDATE və DELETE trigger ilə qadağandır.
+-- Biznes və istifadəçiyə FK yoxdur - jurnal onlar silindikdən sonra da qalmalıdır.
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    id          UUID PRIMARY KEY,
+    business_id UUID NOT NULL,
+    actor_id    UUID,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id   UUID NOT NULL,
+    action      VARCHAR(50) NOT NULL,
+    changes     JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ip          VARCHAR(64),
+    request_id  VARCHAR(128),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_business_created ON audit_events(business_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity ON audit_events(business_id, entity_type, entity_id);
+
+CREATE OR REPLACE FUNCTION audit_events_immutable() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END
+$$;
+
+DROP TRIGGER IF EXISTS audit_events_immutable ON audit_events;
+CREATE TRIGGER audit_events_immutable
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_immutable();
+
+DROP TRIGGER IF EXISTS audit_events_no_truncate ON audit_events;
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_events_immutable();
+
+ALTER TABLE audit_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_events FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON audit_events;
+CREATE POLICY tenant_isolation ON audit_events
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
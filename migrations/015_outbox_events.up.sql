@@ -0,0 +1,23 @@
+-- File: migrations/015_outbox_events.up.sql
+-- Transactional outbox: domen hadisələri vəziyyət dəyişikliyi ilə eyni tranzaksiyada yazılır,
+-- worker relay onları abunəçilərə çatdırıb published_at qeyd edir (ən azı bir dəfə).
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id            UUID PRIMARY KEY,
+    event_type    VARCHAR(100) NOT NULL,
+    business_id   UUID NOT NULL,
+    aggregate_id  UUID NOT NULL,
+    payload       JSONB NOT NULL,
+    trace_context JSONB,
+    occurred_at   TIMESTAMPTZ NOT NULL,
+    attempts      INT NOT NULL DEFAULT 0,
+    available_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_until  TIMESTAMPTZ,
+    last_error    TEXT,
+    published_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending
+    ON outbox_events(available_at, occurred_at) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published
+    ON outbox_events(published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,61 @@
+-- File: migrations/016_webhooks.up.sql
+-- Biznesin xarici sistemlərə hadisə göndərdiyi webhook endpoint-ləri və çatdırılma jurnalı.
+-- secret FieldCipher ilə şifrəli saxlanır; çatdırılmalar endpoint silinəndə onunla birlikdə silinir.
+
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id          UUID PRIMARY KEY,
+    business_id UUID NOT NULL REFERENCES businesses(id) ON DELETE CASCADE,
+    url         VARCHAR(2048) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    event_types JSONB NOT NULL,
+    secret_enc  TEXT NOT NULL,
+    is_active   BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_business ON webhook_endpoints(business_id, created_at DESC, id DESC);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              UUID PRIMARY KEY,
+    endpoint_id     UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    business_id     UUID NOT NULL,
+    event_id        UUID NOT NULL,
+    event_type      VARCHAR(100) NOT NULL,
+    payload         JSONB NOT NULL,
+    status          VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts        INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ,
+    locked_until    TIMESTAMPTZ,
+    response_status INT,
+    response_body   TEXT,
+    last_error      TEXT,
+    duration_ms     BIGINT,
+    redelivery_of   UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    delivered_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhook_deliveries_status_check CHECK (status IN ('pending', 'succeeded', 'failed'))
+);
+
+-- Outbox ən azı bir dəfə çatdırır: eyni hadisə eyni endpoint üçün ikinci dəfə növbəyə düşməməlidir
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_event
+    ON webhook_deliveries(endpoint_id, event_id) WHERE redelivery_of IS NULL;
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint
+    ON webhook_deliveries(endpoint_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+    ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+
+ALTER TABLE webhook_endpoints ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_endpoints FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON webhook_endpoints;
+CREATE POLICY tenant_isolation ON webhook_endpoints
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+ALTER TABLE webhook_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON webhook_deliveries;
+CREATE POLICY tenant_isolation ON webhook_deliveries
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
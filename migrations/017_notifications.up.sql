@@ -0,0 +1,39 @@
+-- File: migrations/017_notifications.up.sql
+-- Bildiriş jurnalı (email, SMS) və istifadəçinin kanal seçimləri ilə sakit saatları.
+-- recipient və data FieldCipher ilə şifrəli saxlanır; data göndərildikdən sonra silinir.
+-- Bildirişlər istifadəçiyə aiddir (user_id dəvətlərdə boş ola bilər), tenant RLS tətbiq olunmur.
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id            UUID PRIMARY KEY,
+    user_id       UUID REFERENCES users(id) ON DELETE CASCADE,
+    template      VARCHAR(100) NOT NULL,
+    channel       VARCHAR(20) NOT NULL,
+    locale        VARCHAR(10) NOT NULL,
+    recipient_enc TEXT NOT NULL,
+    data_enc      TEXT,
+    status        VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts      INT NOT NULL DEFAULT 0,
+    scheduled_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_until  TIMESTAMPTZ,
+    last_error    TEXT,
+    sent_at       TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT notifications_channel_check CHECK (channel IN ('email', 'sms')),
+    CONSTRAINT notifications_status_check CHECK (status IN ('pending', 'sent', 'failed', 'suppressed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user
+    ON notifications(user_id, created_at DESC, id DESC) WHERE user_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_notifications_due
+    ON notifications(scheduled_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id           UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email_enabled     BOOLEAN NOT NULL DEFAULT TRUE,
+    sms_enabled       BOOLEAN NOT NULL DEFAULT TRUE,
+    quiet_hours_start VARCHAR(5),
+    quiet_hours_end   VARCHAR(5),
+    timezone          VARCHAR(64) NOT NULL DEFAULT 'Asia/Baku',
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone_bidx VARCHAR(64);
+CREATE INDEX IF NOT EXISTS idx_users_phone_bidx ON users(phone_bidx);
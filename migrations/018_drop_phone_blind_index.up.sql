@@ -0,0 +1,5 @@
+-- File: migrations/018_drop_phone_blind_index.up.sql
+-- phone_bidx heç bir sorğuda istifadə olunmurdu; telefonla axtarış lazım olanda yenidən əlavə edilə bilər
+
+DROP INDEX IF EXISTS idx_users_phone_bidx;
+ALTER TABLE users DROP COLUMN IF EXISTS phone_bidx;
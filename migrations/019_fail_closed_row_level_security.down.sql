@@ -0,0 +1,58 @@
+-- File: migrations/019_fail_closed_row_level_security.down.sql
+
+DROP POLICY IF EXISTS tenant_isolation ON webhook_deliveries;
+CREATE POLICY tenant_isolation ON webhook_deliveries
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON webhook_endpoints;
+CREATE POLICY tenant_isolation ON webhook_endpoints
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON audit_events;
+CREATE POLICY tenant_isolation ON audit_events
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON staff_services;
+CREATE POLICY tenant_isolation ON staff_services
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON services;
+CREATE POLICY tenant_isolation ON services
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON business_invites;
+CREATE POLICY tenant_isolation ON business_invites
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON staff_profiles;
+CREATE POLICY tenant_isolation ON staff_profiles
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON locations;
+CREATE POLICY tenant_isolation ON locations
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON ownership_transfers;
+CREATE POLICY tenant_isolation ON ownership_transfers
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON business_owners;
+CREATE POLICY tenant_isolation ON business_owners
+    USING (app_current_business_id() IS NULL OR business_id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON businesses;
+CREATE POLICY tenant_isolation ON businesses
+    USING (app_current_business_id() IS NULL OR id = app_current_business_id())
+    WITH CHECK (app_current_business_id() IS NULL OR id = app_current_business_id());
+
+DROP FUNCTION IF EXISTS app_rls_bypassed();
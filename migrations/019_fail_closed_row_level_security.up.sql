@@ -0,0 +1,64 @@
+-- File: migrations/019_fail_closed_row_level_security.up.sql
+-- Policy-lər fail-closed olur: app.business_id qurulmayıbsa heç bir sətir görünmür və yazılmır.
+-- Bütün bizneslər üzrə işləyən axınlar (worker, token ilə icazə, hesab ixracı/silinməsi) app.bypass_rls = 'on'
+-- qurur (tenant.Unscoped). Parametr tranzaksiya səviyyəlidir, pool-dakı bağlantıda qalmır.
+
+CREATE OR REPLACE FUNCTION app_rls_bypassed() RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT COALESCE(current_setting('app.bypass_rls', true), '') = 'on'
+$$;
+
+DROP POLICY IF EXISTS tenant_isolation ON businesses;
+CREATE POLICY tenant_isolation ON businesses
+    USING (app_rls_bypassed() OR id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON business_owners;
+CREATE POLICY tenant_isolation ON business_owners
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON ownership_transfers;
+CREATE POLICY tenant_isolation ON ownership_transfers
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON locations;
+CREATE POLICY tenant_isolation ON locations
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON staff_profiles;
+CREATE POLICY tenant_isolation ON staff_profiles
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON business_invites;
+CREATE POLICY tenant_isolation ON business_invites
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON services;
+CREATE POLICY tenant_isolation ON services
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON staff_services;
+CREATE POLICY tenant_isolation ON staff_services
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON audit_events;
+CREATE POLICY tenant_isolation ON audit_events
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON webhook_endpoints;
+CREATE POLICY tenant_isolation ON webhook_endpoints
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
+
+DROP POLICY IF EXISTS tenant_isolation ON webhook_deliveries;
+CREATE POLICY tenant_isolation ON webhook_deliveries
+    USING (app_rls_bypassed() OR business_id = app_current_business_id())
+    WITH CHECK (app_rls_bypassed() OR business_id = app_current_business_id());
@@ -0,0 +1,5 @@
+-- File: migrations/020_idempotency_response_encryption.down.sql
+-- Şifrəli cavablar geri açılmır; açarlar 24 saatlıqdır, sətirlər silinir ki, köhnə kod boş cavab təkrarlamasın.
+
+DELETE FROM idempotency_keys WHERE response_body_enc IS NOT NULL;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS response_body_enc;
@@ -0,0 +1,5 @@
+-- File: migrations/020_idempotency_response_encryption.up.sql
+-- Saxlanmış cavablarda access/refresh token ola bilər: gövdə FieldCipher ilə response_body_enc-də saxlanır.
+-- Mövcud açıq response_body dəyərləri worker-dəki re-encryption job tərəfindən köçürülür.
+
+ALTER TABLE idempotency_keys ADD COLUMN response_body_enc TEXT;
@@ -0,0 +1,9 @@
+// File: migrations/embed.go
+package migrations
+
+import "embed"
+
+// FS - miqrasiya faylları binary-yə daxil edilir, işləmə qovluğundan asılı deyil
+//
+//go:embed *.sql
+var FS embed.FS